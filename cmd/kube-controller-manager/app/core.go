@@ -277,15 +277,16 @@ func startPersistentVolumeBinderController(ctx context.Context, controllerContex
 	}
 
 	params := persistentvolumecontroller.ControllerParameters{
-		KubeClient:                controllerContext.ClientBuilder.ClientOrDie("persistent-volume-binder"),
-		SyncPeriod:                controllerContext.ComponentConfig.PersistentVolumeBinderController.PVClaimBinderSyncPeriod.Duration,
-		VolumePlugins:             plugins,
-		VolumeInformer:            controllerContext.InformerFactory.Core().V1().PersistentVolumes(),
-		ClaimInformer:             controllerContext.InformerFactory.Core().V1().PersistentVolumeClaims(),
-		ClassInformer:             controllerContext.InformerFactory.Storage().V1().StorageClasses(),
-		PodInformer:               controllerContext.InformerFactory.Core().V1().Pods(),
-		NodeInformer:              controllerContext.InformerFactory.Core().V1().Nodes(),
-		EnableDynamicProvisioning: controllerContext.ComponentConfig.PersistentVolumeBinderController.VolumeConfiguration.EnableDynamicProvisioning,
+		KubeClient:                         controllerContext.ClientBuilder.ClientOrDie("persistent-volume-binder"),
+		SyncPeriod:                         controllerContext.ComponentConfig.PersistentVolumeBinderController.PVClaimBinderSyncPeriod.Duration,
+		VolumePlugins:                      plugins,
+		VolumeInformer:                     controllerContext.InformerFactory.Core().V1().PersistentVolumes(),
+		ClaimInformer:                      controllerContext.InformerFactory.Core().V1().PersistentVolumeClaims(),
+		ClassInformer:                      controllerContext.InformerFactory.Storage().V1().StorageClasses(),
+		PodInformer:                        controllerContext.InformerFactory.Core().V1().Pods(),
+		NodeInformer:                       controllerContext.InformerFactory.Core().V1().Nodes(),
+		EnableDynamicProvisioning:          controllerContext.ComponentConfig.PersistentVolumeBinderController.VolumeConfiguration.EnableDynamicProvisioning,
+		RetroactiveClassExcludedNamespaces: controllerContext.ComponentConfig.PersistentVolumeBinderController.VolumeConfiguration.RetroactiveDefaultStorageClassExcludedNamespaces,
 	}
 	volumeController, volumeControllerErr := persistentvolumecontroller.NewController(ctx, params)
 	if volumeControllerErr != nil {
@@ -330,6 +331,8 @@ func startPersistentVolumeAttachDetachController(ctx context.Context, controller
 			controllerContext.ComponentConfig.AttachDetachController.DisableAttachDetachReconcilerSync,
 			controllerContext.ComponentConfig.AttachDetachController.ReconcilerSyncLoopPeriod.Duration,
 			controllerContext.ComponentConfig.AttachDetachController.DisableForceDetachOnTimeout,
+			controllerContext.ComponentConfig.AttachDetachController.ReconcilerDryRun,
+			controllerContext.ComponentConfig.AttachDetachController.DriverRateLimits,
 			attachdetach.DefaultTimerConfig,
 		)
 	if attachDetachControllerErr != nil {
@@ -664,7 +667,7 @@ func startPersistentVolumeClaimProtectionController(ctx context.Context, control
 	if err != nil {
 		return nil, true, fmt.Errorf("failed to start the pvc protection controller: %v", err)
 	}
-	go pvcProtectionController.Run(ctx, 1)
+	go pvcProtectionController.Run(ctx, int(controllerContext.ComponentConfig.PVCProtectionController.ConcurrentPVCProtectionSyncs))
 	return nil, true, nil
 }
 
@@ -38,6 +38,8 @@ func (o *AttachDetachControllerOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&o.DisableAttachDetachReconcilerSync, "disable-attach-detach-reconcile-sync", false, "Disable volume attach detach reconciler sync. Disabling this may cause volumes to be mismatched with pods. Use wisely.")
 	fs.DurationVar(&o.ReconcilerSyncLoopPeriod.Duration, "attach-detach-reconcile-sync-period", o.ReconcilerSyncLoopPeriod.Duration, "The reconciler sync wait time between volume attach detach. This duration must be larger than one second, and increasing this value from the default may allow for volumes to be mismatched with pods.")
 	fs.BoolVar(&o.DisableForceDetachOnTimeout, "disable-force-detach-on-timeout", false, "Prevent force detaching volumes based on maximum unmount time and node status. If this flag is set to true, the non-graceful node shutdown feature must be used to recover from node failure. See https://k8s.io/docs/storage-disable-force-detach-on-timeout/.")
+	fs.BoolVar(&o.ReconcilerDryRun, "attach-detach-dry-run", false, "Log the attach and detach operations the reconciler would execute without starting them and without updating node status. Use to validate migration or failover plans before letting the controller act.")
+	fs.StringVar(&o.DriverRateLimits, "attach-detach-driver-rate-limits", o.DriverRateLimits, "Comma-separated list of <driver>=<qps>/<burst> entries limiting how fast attach and detach operations are started per driver, e.g. 'kubernetes.io/csi:ebs.csi.aws.com=10/20'. The entry '*' applies to drivers without an explicit entry; drivers without a matching entry are not limited.")
 }
 
 // ApplyTo fills up AttachDetachController config with options.
@@ -49,6 +51,8 @@ func (o *AttachDetachControllerOptions) ApplyTo(cfg *attachdetachconfig.AttachDe
 	cfg.DisableAttachDetachReconcilerSync = o.DisableAttachDetachReconcilerSync
 	cfg.ReconcilerSyncLoopPeriod = o.ReconcilerSyncLoopPeriod
 	cfg.DisableForceDetachOnTimeout = o.DisableForceDetachOnTimeout
+	cfg.ReconcilerDryRun = o.ReconcilerDryRun
+	cfg.DriverRateLimits = o.DriverRateLimits
 
 	return nil
 }
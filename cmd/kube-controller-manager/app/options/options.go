@@ -88,6 +88,7 @@ type KubeControllerManagerOptions struct {
 	NodeIPAMController                        *NodeIPAMControllerOptions
 	NodeLifecycleController                   *NodeLifecycleControllerOptions
 	PersistentVolumeBinderController          *PersistentVolumeBinderControllerOptions
+	PVCProtectionController                   *PVCProtectionControllerOptions
 	PodGCController                           *PodGCControllerOptions
 	ReplicaSetController                      *ReplicaSetControllerOptions
 	ReplicationController                     *ReplicationControllerOptions
@@ -185,6 +186,9 @@ func NewKubeControllerManagerOptions() (*KubeControllerManagerOptions, error) {
 		PersistentVolumeBinderController: &PersistentVolumeBinderControllerOptions{
 			&componentConfig.PersistentVolumeBinderController,
 		},
+		PVCProtectionController: &PVCProtectionControllerOptions{
+			&componentConfig.PVCProtectionController,
+		},
 		PodGCController: &PodGCControllerOptions{
 			&componentConfig.PodGCController,
 		},
@@ -276,6 +280,7 @@ func (s *KubeControllerManagerOptions) Flags(allControllers []string, disabledBy
 	s.NodeIPAMController.AddFlags(fss.FlagSet(names.NodeIpamController))
 	s.NodeLifecycleController.AddFlags(fss.FlagSet(names.NodeLifecycleController))
 	s.PersistentVolumeBinderController.AddFlags(fss.FlagSet(names.PersistentVolumeBinderController))
+	s.PVCProtectionController.AddFlags(fss.FlagSet(names.PersistentVolumeClaimProtectionController))
 	s.PodGCController.AddFlags(fss.FlagSet(names.PodGarbageCollectorController))
 	s.ReplicaSetController.AddFlags(fss.FlagSet(names.ReplicaSetController))
 	s.ReplicationController.AddFlags(fss.FlagSet(names.ReplicationControllerController))
@@ -373,6 +378,9 @@ func (s *KubeControllerManagerOptions) ApplyTo(c *kubecontrollerconfig.Config, a
 	if err := s.PersistentVolumeBinderController.ApplyTo(&c.ComponentConfig.PersistentVolumeBinderController); err != nil {
 		return err
 	}
+	if err := s.PVCProtectionController.ApplyTo(&c.ComponentConfig.PVCProtectionController); err != nil {
+		return err
+	}
 	if err := s.PodGCController.ApplyTo(&c.ComponentConfig.PodGCController); err != nil {
 		return err
 	}
@@ -441,6 +449,7 @@ func (s *KubeControllerManagerOptions) Validate(allControllers []string, disable
 	errs = append(errs, s.NodeIPAMController.Validate()...)
 	errs = append(errs, s.NodeLifecycleController.Validate()...)
 	errs = append(errs, s.PersistentVolumeBinderController.Validate()...)
+	errs = append(errs, s.PVCProtectionController.Validate()...)
 	errs = append(errs, s.PodGCController.Validate()...)
 	errs = append(errs, s.ReplicaSetController.Validate()...)
 	errs = append(errs, s.ReplicationController.Validate()...)
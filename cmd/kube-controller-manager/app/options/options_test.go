@@ -76,12 +76,15 @@ import (
 	attachdetachconfig "k8s.io/kubernetes/pkg/controller/volume/attachdetach/config"
 	ephemeralvolumeconfig "k8s.io/kubernetes/pkg/controller/volume/ephemeral/config"
 	persistentvolumeconfig "k8s.io/kubernetes/pkg/controller/volume/persistentvolume/config"
+	pvcprotectionconfig "k8s.io/kubernetes/pkg/controller/volume/pvcprotection/config"
 	netutils "k8s.io/utils/net"
 )
 
 var args = []string{
 	"--allocate-node-cidrs=true",
 	"--attach-detach-reconcile-sync-period=30s",
+	"--attach-detach-dry-run=true",
+	"--attach-detach-driver-rate-limits=kubernetes.io/csi:ebs.csi.aws.com=10/20",
 	"--cidr-allocator-type=CloudAllocator",
 	"--cloud-config=/cloud-config",
 	"--cloud-provider=gce",
@@ -103,6 +106,7 @@ var args = []string{
 	"--concurrent-statefulset-syncs=15",
 	"--concurrent-endpoint-syncs=10",
 	"--concurrent-ephemeralvolume-syncs=10",
+	"--concurrent-pvcprotection-syncs=10",
 	"--concurrent-service-endpoint-syncs=10",
 	"--concurrent-gc-syncs=30",
 	"--concurrent-namespace-syncs=20",
@@ -128,6 +132,8 @@ var args = []string{
 	"--horizontal-pod-autoscaler-downscale-stabilization=3m",
 	"--horizontal-pod-autoscaler-cpu-initialization-period=90s",
 	"--horizontal-pod-autoscaler-initial-readiness-delay=50s",
+	"--hostpath-provisioner-root=/mnt/hostpath-pvs",
+	"--retroactive-storageclass-excluded-namespaces=kube-system",
 	"--http2-max-streams-per-connection=47",
 	"--kube-api-burst=100",
 	"--kube-api-content-type=application/json",
@@ -238,6 +244,8 @@ func TestAddFlags(t *testing.T) {
 			&attachdetachconfig.AttachDetachControllerConfiguration{
 				ReconcilerSyncLoopPeriod:          metav1.Duration{Duration: 30 * time.Second},
 				DisableAttachDetachReconcilerSync: true,
+				ReconcilerDryRun:                  true,
+				DriverRateLimits:                  "kubernetes.io/csi:ebs.csi.aws.com=10/20",
 			},
 		},
 		CSRSigningController: &CSRSigningControllerOptions{
@@ -360,9 +368,11 @@ func TestAddFlags(t *testing.T) {
 			&persistentvolumeconfig.PersistentVolumeBinderControllerConfiguration{
 				PVClaimBinderSyncPeriod: metav1.Duration{Duration: 30 * time.Second},
 				VolumeConfiguration: persistentvolumeconfig.VolumeConfiguration{
-					EnableDynamicProvisioning:  false,
-					EnableHostPathProvisioning: true,
-					FlexVolumePluginDir:        "/flex-volume-plugin",
+					EnableDynamicProvisioning:                        false,
+					EnableHostPathProvisioning:                       true,
+					HostPathProvisioningRoot:                         "/mnt/hostpath-pvs",
+					FlexVolumePluginDir:                              "/flex-volume-plugin",
+					RetroactiveDefaultStorageClassExcludedNamespaces: "kube-system",
 					PersistentVolumeRecyclerConfiguration: persistentvolumeconfig.PersistentVolumeRecyclerConfiguration{
 						MaximumRetry:             3,
 						MinimumTimeoutNFS:        200,
@@ -373,6 +383,11 @@ func TestAddFlags(t *testing.T) {
 				},
 			},
 		},
+		PVCProtectionController: &PVCProtectionControllerOptions{
+			&pvcprotectionconfig.PVCProtectionControllerConfiguration{
+				ConcurrentPVCProtectionSyncs: 10,
+			},
+		},
 		PodGCController: &PodGCControllerOptions{
 			&podgcconfig.PodGCControllerConfiguration{
 				TerminatedPodGCThreshold: 12000,
@@ -594,6 +609,8 @@ func TestApplyTo(t *testing.T) {
 			AttachDetachController: attachdetachconfig.AttachDetachControllerConfiguration{
 				ReconcilerSyncLoopPeriod:          metav1.Duration{Duration: 30 * time.Second},
 				DisableAttachDetachReconcilerSync: true,
+				ReconcilerDryRun:                  true,
+				DriverRateLimits:                  "kubernetes.io/csi:ebs.csi.aws.com=10/20",
 			},
 			CSRSigningController: csrsigningconfig.CSRSigningControllerConfiguration{
 				ClusterSigningCertFile: "/cluster-signing-cert",
@@ -682,9 +699,11 @@ func TestApplyTo(t *testing.T) {
 			PersistentVolumeBinderController: persistentvolumeconfig.PersistentVolumeBinderControllerConfiguration{
 				PVClaimBinderSyncPeriod: metav1.Duration{Duration: 30 * time.Second},
 				VolumeConfiguration: persistentvolumeconfig.VolumeConfiguration{
-					EnableDynamicProvisioning:  false,
-					EnableHostPathProvisioning: true,
-					FlexVolumePluginDir:        "/flex-volume-plugin",
+					EnableDynamicProvisioning:                        false,
+					EnableHostPathProvisioning:                       true,
+					HostPathProvisioningRoot:                         "/mnt/hostpath-pvs",
+					FlexVolumePluginDir:                              "/flex-volume-plugin",
+					RetroactiveDefaultStorageClassExcludedNamespaces: "kube-system",
 					PersistentVolumeRecyclerConfiguration: persistentvolumeconfig.PersistentVolumeRecyclerConfiguration{
 						MaximumRetry:             3,
 						MinimumTimeoutNFS:        200,
@@ -694,6 +713,9 @@ func TestApplyTo(t *testing.T) {
 					},
 				},
 			},
+			PVCProtectionController: pvcprotectionconfig.PVCProtectionControllerConfiguration{
+				ConcurrentPVCProtectionSyncs: 10,
+			},
 			PodGCController: podgcconfig.PodGCControllerConfiguration{
 				TerminatedPodGCThreshold: 12000,
 			},
@@ -1195,6 +1217,16 @@ func TestValidateControllersOptions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:                   "PVCProtectionControllerOptions ConcurrentPVCProtectionSyncs equal 0",
+			expectErrors:           true,
+			expectedErrorSubString: "concurrent-pvcprotection-syncs must be greater than 0",
+			options: &PVCProtectionControllerOptions{
+				&pvcprotectionconfig.PVCProtectionControllerConfiguration{
+					ConcurrentPVCProtectionSyncs: 0,
+				},
+			},
+		},
 		{
 			name:                   "HPAControllerOptions ConcurrentHorizontalPodAutoscalerSyncs equal 0",
 			expectErrors:           true,
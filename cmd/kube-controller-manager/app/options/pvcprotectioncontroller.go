@@ -0,0 +1,63 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	pvcprotectionconfig "k8s.io/kubernetes/pkg/controller/volume/pvcprotection/config"
+)
+
+// PVCProtectionControllerOptions holds the PVCProtectionController options.
+type PVCProtectionControllerOptions struct {
+	*pvcprotectionconfig.PVCProtectionControllerConfiguration
+}
+
+// AddFlags adds flags related to PVCProtectionController for controller manager to the specified FlagSet.
+func (o *PVCProtectionControllerOptions) AddFlags(fs *pflag.FlagSet) {
+	if o == nil {
+		return
+	}
+
+	fs.Int32Var(&o.ConcurrentPVCProtectionSyncs, "concurrent-pvcprotection-syncs", o.ConcurrentPVCProtectionSyncs, "The number of PVC protection workers that process namespaces concurrently. Larger number = faster finalizer removal when many pods are deleted at once, but more CPU (and network) load")
+}
+
+// ApplyTo fills up PVCProtectionController config with options.
+func (o *PVCProtectionControllerOptions) ApplyTo(cfg *pvcprotectionconfig.PVCProtectionControllerConfiguration) error {
+	if o == nil {
+		return nil
+	}
+
+	cfg.ConcurrentPVCProtectionSyncs = o.ConcurrentPVCProtectionSyncs
+
+	return nil
+}
+
+// Validate checks validation of PVCProtectionControllerOptions.
+func (o *PVCProtectionControllerOptions) Validate() []error {
+	if o == nil {
+		return nil
+	}
+
+	errs := []error{}
+	if o.ConcurrentPVCProtectionSyncs < 1 {
+		errs = append(errs, fmt.Errorf("concurrent-pvcprotection-syncs must be greater than 0, but got %d", o.ConcurrentPVCProtectionSyncs))
+	}
+	return errs
+}
@@ -103,6 +103,7 @@ func probeControllerVolumePlugins(logger klog.Logger, config persistentvolumecon
 		RecyclerTimeoutIncrement: int(config.PersistentVolumeRecyclerConfiguration.IncrementTimeoutHostPath),
 		RecyclerPodTemplate:      volume.NewPersistentVolumeRecyclerPodTemplate(),
 		ProvisioningEnabled:      config.EnableHostPathProvisioning,
+		ProvisioningRootDir:      config.HostPathProvisioningRoot,
 	}
 	if err := AttemptToLoadRecycler(config.PersistentVolumeRecyclerConfiguration.PodTemplateFilePathHostPath, &hostPathConfig); err != nil {
 		logger.Error(err, "Could not create hostpath recycler pod from file", "path", config.PersistentVolumeRecyclerConfiguration.PodTemplateFilePathHostPath)
@@ -0,0 +1,264 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"k8s.io/kubernetes/pkg/kubelet/config"
+	"k8s.io/mount-utils"
+	utilstrings "k8s.io/utils/strings"
+)
+
+const csiPluginName = "kubernetes.io/csi"
+
+// newCheckVolumesCommand returns the check-volumes subcommand. It inspects
+// the volume state a kubelet left on disk under its root directory and
+// cross-checks it against the mount table and CSI driver sockets, printing a
+// health report with remediation suggestions. It needs neither API access
+// nor a running kubelet, so it is usable during incident response.
+func newCheckVolumesCommand() *cobra.Command {
+	var rootDir string
+	cmd := &cobra.Command{
+		Use:   "check-volumes",
+		Short: "Check the node's volume state and print a health report",
+		Long: `Check the volume state the kubelet keeps on disk against the actual state of
+the node: every pod volume directory is cross-checked with the mount table,
+CSI volumes additionally with their persisted volume data and the driver's
+registration socket. The report lists problems found together with
+remediation suggestions. The command reads only from the kubelet root
+directory and /proc, so it is safe to run next to a live kubelet and works
+without API access.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			report, err := runVolumeCheck(rootDir, mount.New(""))
+			if err != nil {
+				return err
+			}
+			fmt.Fprint(cmd.OutOrStdout(), report)
+			return nil
+		},
+	}
+	cmd.Flags().StringVar(&rootDir, "root-dir", "/var/lib/kubelet", "Directory path for managed kubelet files")
+
+	// The root command replaces the help and usage functions with ones bound
+	// to its own flag set; restore plain help for this subcommand.
+	cmd.SetUsageFunc(func(c *cobra.Command) error {
+		fmt.Fprintf(c.OutOrStderr(), "Usage:\n  %s\n\nFlags:\n%s", c.UseLine(), c.Flags().FlagUsagesWrapped(2))
+		return nil
+	})
+	cmd.SetHelpFunc(func(c *cobra.Command, args []string) {
+		fmt.Fprintf(c.OutOrStdout(), "%s\n\nUsage:\n  %s\n\nFlags:\n%s", c.Long, c.UseLine(), c.Flags().FlagUsagesWrapped(2))
+	})
+	return cmd
+}
+
+// volumeCheckFinding is one problem found during the volume check, together
+// with a suggestion how to remediate it.
+type volumeCheckFinding struct {
+	subject     string
+	problem     string
+	remediation string
+}
+
+// runVolumeCheck scans the pod volume directories under the kubelet root
+// directory, cross-checks them with the mount table and CSI driver sockets,
+// and renders the health report.
+func runVolumeCheck(rootDir string, mounter mount.Interface) (string, error) {
+	podsDir := filepath.Join(rootDir, config.DefaultKubeletPodsDirName)
+	podDirs, err := os.ReadDir(podsDir)
+	if err != nil {
+		return "", fmt.Errorf("cannot read pods directory %s: %w", podsDir, err)
+	}
+	mountPoints, err := mounter.List()
+	if err != nil {
+		return "", fmt.Errorf("cannot list mount points: %w", err)
+	}
+	mountedPaths := make(map[string]bool, len(mountPoints))
+	for _, mountPoint := range mountPoints {
+		mountedPaths[mountPoint.Path] = true
+	}
+
+	var findings []volumeCheckFinding
+	podsWithVolumes := 0
+	volumesChecked := 0
+	csiDrivers := map[string]bool{}
+
+	for _, podDir := range podDirs {
+		if !podDir.IsDir() {
+			continue
+		}
+		podUID := podDir.Name()
+		volumesDir := filepath.Join(podsDir, podUID, config.DefaultKubeletVolumesDirName)
+		pluginDirs, err := os.ReadDir(volumesDir)
+		if err != nil {
+			// Pods without filesystem volumes have no volumes directory.
+			continue
+		}
+		podHasVolumes := false
+		for _, pluginDir := range pluginDirs {
+			if !pluginDir.IsDir() {
+				continue
+			}
+			pluginName := utilstrings.UnescapeQualifiedName(pluginDir.Name())
+			volumeDirs, err := os.ReadDir(filepath.Join(volumesDir, pluginDir.Name()))
+			if err != nil {
+				findings = append(findings, volumeCheckFinding{
+					subject:     fmt.Sprintf("pod %s", podUID),
+					problem:     fmt.Sprintf("cannot read volume plugin directory %s: %v", filepath.Join(volumesDir, pluginDir.Name()), err),
+					remediation: "check directory permissions; the kubelet cannot reconstruct these volumes either",
+				})
+				continue
+			}
+			for _, volumeDir := range volumeDirs {
+				podHasVolumes = true
+				volumesChecked++
+				volumePath := filepath.Join(volumesDir, pluginDir.Name(), volumeDir.Name())
+				subject := fmt.Sprintf("volume %q of pod %s (plugin %s)", volumeDir.Name(), podUID, pluginName)
+				if pluginName == csiPluginName {
+					findings = append(findings, checkCSIVolume(subject, volumePath, mountedPaths, csiDrivers)...)
+				} else if strings.HasPrefix(pluginName, "kubernetes.io/") && mountBasedPlugins[pluginName] && !mountedPaths[volumePath] {
+					findings = append(findings, volumeCheckFinding{
+						subject:     subject,
+						problem:     "volume directory exists but nothing is mounted on it",
+						remediation: "pod containers see an empty directory; restart the kubelet to remount, or delete the pod if it is already gone from the API server",
+					})
+				}
+			}
+		}
+		if podHasVolumes {
+			podsWithVolumes++
+		}
+	}
+
+	findings = append(findings, checkCSIDriverSockets(rootDir, csiDrivers)...)
+
+	return renderVolumeCheckReport(rootDir, podsWithVolumes, volumesChecked, findings), nil
+}
+
+// mountBasedPlugins lists the in-tree plugins whose pod volume directory is
+// always a mount point while the volume is set up. Directory-based plugins
+// like configMap or downwardAPI, and emptyDir with its default medium, are
+// plain directories and cannot be checked against the mount table.
+var mountBasedPlugins = map[string]bool{
+	"kubernetes.io/nfs":   true,
+	"kubernetes.io/iscsi": true,
+	"kubernetes.io/fc":    true,
+}
+
+// checkCSIVolume cross-checks one CSI pod volume directory with its
+// persisted volume data and the mount table, and records the volume's driver
+// for the socket check.
+func checkCSIVolume(subject string, volumePath string, mountedPaths map[string]bool, csiDrivers map[string]bool) []volumeCheckFinding {
+	var findings []volumeCheckFinding
+
+	data, err := os.ReadFile(filepath.Join(volumePath, "vol_data.json"))
+	if err != nil {
+		return append(findings, volumeCheckFinding{
+			subject:     subject,
+			problem:     fmt.Sprintf("persisted volume data is missing or unreadable: %v", err),
+			remediation: "the kubelet cannot reconstruct this volume after a restart; if the pod no longer exists, unmount and remove the directory manually",
+		})
+	}
+	volData := map[string]string{}
+	if err := json.Unmarshal(data, &volData); err != nil {
+		return append(findings, volumeCheckFinding{
+			subject:     subject,
+			problem:     fmt.Sprintf("persisted volume data is corrupt: %v", err),
+			remediation: "the kubelet cannot reconstruct this volume after a restart; if the pod no longer exists, unmount and remove the directory manually",
+		})
+	}
+	if driver := volData["driverName"]; driver != "" {
+		csiDrivers[driver] = true
+	}
+
+	if !mountedPaths[filepath.Join(volumePath, "mount")] {
+		findings = append(findings, volumeCheckFinding{
+			subject:     subject,
+			problem:     "volume directory exists but nothing is mounted on it",
+			remediation: "pod containers see an empty directory; restart the kubelet to remount, or delete the pod if it is already gone from the API server",
+		})
+	}
+	return findings
+}
+
+// checkCSIDriverSockets verifies that every CSI driver referenced by a pod
+// volume on disk still has a registration socket under plugins_registry.
+func checkCSIDriverSockets(rootDir string, csiDrivers map[string]bool) []volumeCheckFinding {
+	var findings []volumeCheckFinding
+	registryDir := filepath.Join(rootDir, config.DefaultKubeletPluginsRegistrationDirName)
+	drivers := make([]string, 0, len(csiDrivers))
+	for driver := range csiDrivers {
+		drivers = append(drivers, driver)
+	}
+	sort.Strings(drivers)
+	for _, driver := range drivers {
+		if hasSocketForDriver(registryDir, driver) {
+			continue
+		}
+		findings = append(findings, volumeCheckFinding{
+			subject:     fmt.Sprintf("CSI driver %s", driver),
+			problem:     fmt.Sprintf("no registration socket under %s", registryDir),
+			remediation: "mounts and unmounts for this driver's volumes will fail; check that the driver's node pod is running on this node and re-registers with the kubelet",
+		})
+	}
+	return findings
+}
+
+// hasSocketForDriver reports whether the plugin registration directory
+// contains a socket whose name carries the driver name. Drivers register
+// under names like <driverName>-reg.sock.
+func hasSocketForDriver(registryDir string, driver string) bool {
+	entries, err := os.ReadDir(registryDir)
+	if err != nil {
+		return false
+	}
+	for _, entry := range entries {
+		if !strings.Contains(entry.Name(), driver) || !strings.HasSuffix(entry.Name(), ".sock") {
+			continue
+		}
+		if info, err := os.Stat(filepath.Join(registryDir, entry.Name())); err == nil && info.Mode()&os.ModeSocket != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// renderVolumeCheckReport renders the findings as the human-readable report
+// printed by the check-volumes subcommand.
+func renderVolumeCheckReport(rootDir string, podsWithVolumes, volumesChecked int, findings []volumeCheckFinding) string {
+	var report strings.Builder
+	fmt.Fprintf(&report, "Volume health report for %s\n", rootDir)
+	fmt.Fprintf(&report, "Pods with volumes on disk: %d\n", podsWithVolumes)
+	fmt.Fprintf(&report, "Volumes checked: %d\n", volumesChecked)
+	if len(findings) == 0 {
+		report.WriteString("\nNo problems found.\n")
+		return report.String()
+	}
+	fmt.Fprintf(&report, "\nProblems found: %d\n", len(findings))
+	for _, finding := range findings {
+		fmt.Fprintf(&report, "\n  %s:\n    %s\n    remediation: %s\n", finding.subject, finding.problem, finding.remediation)
+	}
+	return report.String()
+}
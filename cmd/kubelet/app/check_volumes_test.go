@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"k8s.io/mount-utils"
+)
+
+func writeVolData(t *testing.T, volumePath, driverName string) {
+	t.Helper()
+	if err := os.MkdirAll(volumePath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	data := `{"driverName":"` + driverName + `","volumeHandle":"vol-1"}`
+	if err := os.WriteFile(filepath.Join(volumePath, "vol_data.json"), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRunVolumeCheck(t *testing.T) {
+	rootDir := t.TempDir()
+
+	// A healthy CSI volume: persisted volume data, a mount, a registered
+	// driver socket.
+	healthyPath := filepath.Join(rootDir, "pods", "pod1", "volumes", "kubernetes.io~csi", "pv-healthy")
+	writeVolData(t, healthyPath, "csi.example.com")
+
+	// A CSI volume whose mount is gone and whose driver is not registered.
+	unmountedPath := filepath.Join(rootDir, "pods", "pod2", "volumes", "kubernetes.io~csi", "pv-unmounted")
+	writeVolData(t, unmountedPath, "missing.example.com")
+
+	// A CSI volume directory without persisted volume data.
+	noDataPath := filepath.Join(rootDir, "pods", "pod3", "volumes", "kubernetes.io~csi", "pv-no-data")
+	if err := os.MkdirAll(noDataPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	// An NFS volume directory that is not mounted.
+	nfsPath := filepath.Join(rootDir, "pods", "pod4", "volumes", "kubernetes.io~nfs", "share")
+	if err := os.MkdirAll(nfsPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	registryDir := filepath.Join(rootDir, "plugins_registry")
+	if err := os.MkdirAll(registryDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	listener, err := net.Listen("unix", filepath.Join(registryDir, "csi.example.com-reg.sock"))
+	if err != nil {
+		t.Skipf("cannot create unix socket: %v", err)
+	}
+	defer listener.Close()
+
+	mounter := mount.NewFakeMounter([]mount.MountPoint{
+		{Device: "csi.example.com", Path: filepath.Join(healthyPath, "mount")},
+	})
+
+	report, err := runVolumeCheck(rootDir, mounter)
+	if err != nil {
+		t.Fatalf("runVolumeCheck failed: %v", err)
+	}
+
+	if !strings.Contains(report, "Volumes checked: 4") {
+		t.Errorf("expected 4 checked volumes in report:\n%s", report)
+	}
+	if strings.Contains(report, "pv-healthy") {
+		t.Errorf("expected no findings for the healthy volume:\n%s", report)
+	}
+	for _, expected := range []string{
+		`volume "pv-unmounted" of pod pod2`,
+		`volume "pv-no-data" of pod pod3`,
+		`volume "share" of pod pod4`,
+		"CSI driver missing.example.com",
+		"nothing is mounted",
+		"remediation:",
+	} {
+		if !strings.Contains(report, expected) {
+			t.Errorf("expected report to contain %q:\n%s", expected, report)
+		}
+	}
+	if strings.Contains(report, "CSI driver csi.example.com") {
+		t.Errorf("expected no socket finding for the registered driver:\n%s", report)
+	}
+}
+
+func TestRunVolumeCheckNoProblems(t *testing.T) {
+	rootDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(rootDir, "pods"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	report, err := runVolumeCheck(rootDir, mount.NewFakeMounter(nil))
+	if err != nil {
+		t.Fatalf("runVolumeCheck failed: %v", err)
+	}
+	if !strings.Contains(report, "No problems found.") {
+		t.Errorf("expected a clean report:\n%s", report)
+	}
+}
@@ -37,6 +37,7 @@ import (
 	"k8s.io/kubernetes/pkg/volume/local"
 	"k8s.io/kubernetes/pkg/volume/nfs"
 	"k8s.io/kubernetes/pkg/volume/projected"
+	"k8s.io/kubernetes/pkg/volume/removed"
 	"k8s.io/kubernetes/pkg/volume/secret"
 )
 
@@ -70,6 +71,9 @@ func ProbeVolumePlugins(featureGate featuregate.FeatureGate) ([]volume.VolumePlu
 	if featureGate.Enabled(features.ImageVolume) {
 		allPlugins = append(allPlugins, image.ProbeVolumePlugins()...)
 	}
+	// Shims for removed in-tree plugins, so legacy volume sources fail with
+	// migration guidance instead of "no volume plugin matched".
+	allPlugins = append(allPlugins, removed.ProbeVolumePlugins()...)
 	return allPlugins, nil
 }
 
@@ -120,6 +120,7 @@ import (
 	"k8s.io/kubernetes/pkg/util/rlimit"
 	"k8s.io/kubernetes/pkg/volume/util/hostutil"
 	"k8s.io/kubernetes/pkg/volume/util/subpath"
+	"k8s.io/kubernetes/pkg/volume/util/systemdmounter"
 	"k8s.io/utils/cpuset"
 	"k8s.io/utils/exec"
 	netutils "k8s.io/utils/net"
@@ -304,6 +305,8 @@ is checked every 20 seconds (also configurable with a flag).`,
 		fmt.Fprintf(cmd.OutOrStdout(), "%s\n\n"+usageFmt, cmd.Long, cmd.UseLine(), cleanFlagSet.FlagUsagesWrapped(2))
 	})
 
+	cmd.AddCommand(newCheckVolumesCommand())
+
 	return cmd
 }
 
@@ -471,6 +474,9 @@ func UnsecuredDependencies(s *options.KubeletServer, featureGate featuregate.Fea
 	}
 
 	mounter := mount.New(s.ExperimentalMounterPath)
+	if systemdmounter.Enabled() {
+		mounter = systemdmounter.New(mounter, exec.New())
+	}
 	subpather := subpath.New(mounter)
 	hu := hostutil.NewHostUtil()
 	pluginRunner := exec.New()
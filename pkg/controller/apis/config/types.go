@@ -45,6 +45,7 @@ import (
 	attachdetachconfig "k8s.io/kubernetes/pkg/controller/volume/attachdetach/config"
 	ephemeralvolumeconfig "k8s.io/kubernetes/pkg/controller/volume/ephemeral/config"
 	persistentvolumeconfig "k8s.io/kubernetes/pkg/controller/volume/persistentvolume/config"
+	pvcprotectionconfig "k8s.io/kubernetes/pkg/controller/volume/pvcprotection/config"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -113,6 +114,9 @@ type KubeControllerManagerConfiguration struct {
 	// PersistentVolumeBinderControllerConfiguration holds configuration for
 	// PersistentVolumeBinderController related features.
 	PersistentVolumeBinderController persistentvolumeconfig.PersistentVolumeBinderControllerConfiguration
+	// PVCProtectionControllerConfiguration holds configuration for
+	// PVCProtectionController related features.
+	PVCProtectionController pvcprotectionconfig.PVCProtectionControllerConfiguration
 	// PodGCControllerConfiguration holds configuration for PodGCController
 	// related features.
 	PodGCController podgcconfig.PodGCControllerConfiguration
@@ -45,6 +45,7 @@ import (
 	attachdetachconfigv1alpha1 "k8s.io/kubernetes/pkg/controller/volume/attachdetach/config/v1alpha1"
 	ephemeralvolumeconfigv1alpha1 "k8s.io/kubernetes/pkg/controller/volume/ephemeral/config/v1alpha1"
 	persistentvolumeconfigv1alpha1 "k8s.io/kubernetes/pkg/controller/volume/persistentvolume/config/v1alpha1"
+	pvcprotectionconfigv1alpha1 "k8s.io/kubernetes/pkg/controller/volume/pvcprotection/config/v1alpha1"
 )
 
 func addDefaultingFuncs(scheme *kruntime.Scheme) error {
@@ -113,6 +114,9 @@ func SetDefaults_KubeControllerManagerConfiguration(obj *kubectrlmgrconfigv1alph
 	ttlafterfinishedconfigv1alpha1.RecommendedDefaultTTLAfterFinishedControllerConfiguration(&obj.TTLAfterFinishedController)
 	// Use the default RecommendedDefaultPersistentVolumeBinderControllerConfiguration options
 	persistentvolumeconfigv1alpha1.RecommendedDefaultPersistentVolumeBinderControllerConfiguration(&obj.PersistentVolumeBinderController)
+
+	// Use the default RecommendedDefaultPVCProtectionControllerConfiguration options
+	pvcprotectionconfigv1alpha1.RecommendedDefaultPVCProtectionControllerConfiguration(&obj.PVCProtectionController)
 	// Use the default RecommendedDefaultValidatingAdmissionPolicyStatusControllerConfiguration options
 	validatingadmissionpolicystatusv1alpha1.RecommendedDefaultValidatingAdmissionPolicyStatusControllerConfiguration(&obj.ValidatingAdmissionPolicyStatusController)
 }
@@ -54,6 +54,7 @@ import (
 	attachdetachconfigv1alpha1 "k8s.io/kubernetes/pkg/controller/volume/attachdetach/config/v1alpha1"
 	ephemeralconfigv1alpha1 "k8s.io/kubernetes/pkg/controller/volume/ephemeral/config/v1alpha1"
 	persistentvolumeconfigv1alpha1 "k8s.io/kubernetes/pkg/controller/volume/persistentvolume/config/v1alpha1"
+	pvcprotectionconfigv1alpha1 "k8s.io/kubernetes/pkg/controller/volume/pvcprotection/config/v1alpha1"
 )
 
 func init() {
@@ -200,6 +201,9 @@ func autoConvert_v1alpha1_KubeControllerManagerConfiguration_To_config_KubeContr
 	if err := persistentvolumeconfigv1alpha1.Convert_v1alpha1_PersistentVolumeBinderControllerConfiguration_To_config_PersistentVolumeBinderControllerConfiguration(&in.PersistentVolumeBinderController, &out.PersistentVolumeBinderController, s); err != nil {
 		return err
 	}
+	if err := pvcprotectionconfigv1alpha1.Convert_v1alpha1_PVCProtectionControllerConfiguration_To_config_PVCProtectionControllerConfiguration(&in.PVCProtectionController, &out.PVCProtectionController, s); err != nil {
+		return err
+	}
 	if err := podgcconfigv1alpha1.Convert_v1alpha1_PodGCControllerConfiguration_To_config_PodGCControllerConfiguration(&in.PodGCController, &out.PodGCController, s); err != nil {
 		return err
 	}
@@ -296,6 +300,9 @@ func autoConvert_config_KubeControllerManagerConfiguration_To_v1alpha1_KubeContr
 	if err := persistentvolumeconfigv1alpha1.Convert_config_PersistentVolumeBinderControllerConfiguration_To_v1alpha1_PersistentVolumeBinderControllerConfiguration(&in.PersistentVolumeBinderController, &out.PersistentVolumeBinderController, s); err != nil {
 		return err
 	}
+	if err := pvcprotectionconfigv1alpha1.Convert_config_PVCProtectionControllerConfiguration_To_v1alpha1_PVCProtectionControllerConfiguration(&in.PVCProtectionController, &out.PVCProtectionController, s); err != nil {
+		return err
+	}
 	if err := podgcconfigv1alpha1.Convert_config_PodGCControllerConfiguration_To_v1alpha1_PodGCControllerConfiguration(&in.PodGCController, &out.PodGCController, s); err != nil {
 		return err
 	}
@@ -118,6 +118,8 @@ func NewAttachDetachController(
 	disableReconciliationSync bool,
 	reconcilerSyncDuration time.Duration,
 	disableForceDetachOnTimeout bool,
+	reconcilerDryRun bool,
+	driverRateLimits string,
 	timerConfig TimerConfig) (AttachDetachController, error) {
 
 	logger := klog.FromContext(ctx)
@@ -174,11 +176,15 @@ func NewAttachDetachController(
 		reconcilerSyncDuration,
 		disableReconciliationSync,
 		disableForceDetachOnTimeout,
+		reconcilerDryRun,
+		driverRateLimits,
 		adc.desiredStateOfWorld,
 		adc.actualStateOfWorld,
 		adc.attacherDetacher,
 		adc.nodeStatusUpdater,
 		adc.nodeLister,
+		&adc.volumePluginMgr,
+		kubeClient,
 		recorder)
 
 	csiTranslator := csitrans.New()
@@ -63,6 +63,8 @@ func createADC(t testing.TB, tCtx ktesting.TContext, fakeKubeClient *fake.Client
 		false,
 		1*time.Second,
 		false,
+		false,
+		"",
 		DefaultTimerConfig,
 	)
 
@@ -304,6 +306,8 @@ func attachDetachRecoveryTestCase(t *testing.T, extraPods1 []*v1.Pod, extraPods2
 		false,
 		1*time.Second,
 		false,
+		false,
+		"",
 		DefaultTimerConfig,
 	)
 
@@ -33,4 +33,13 @@ type AttachDetachControllerConfiguration struct {
 	// time is exceeded. Is false by default, and thus force detach on unmount is
 	// enabled.
 	DisableForceDetachOnTimeout bool
+	// ReconcilerDryRun makes the reconciler log the attach and detach
+	// operations it would execute without starting them and without updating
+	// node status. Is false by default.
+	ReconcilerDryRun bool
+	// DriverRateLimits is a comma-separated list of <driver>=<qps>/<burst>
+	// entries limiting how fast attach and detach operations are started per
+	// driver. The entry "*" applies to drivers without an explicit entry;
+	// drivers without a matching entry are not limited.
+	DriverRateLimits string
 }
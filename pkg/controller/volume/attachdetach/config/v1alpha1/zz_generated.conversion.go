@@ -63,6 +63,8 @@ func autoConvert_v1alpha1_AttachDetachControllerConfiguration_To_config_AttachDe
 	out.DisableAttachDetachReconcilerSync = in.DisableAttachDetachReconcilerSync
 	out.ReconcilerSyncLoopPeriod = in.ReconcilerSyncLoopPeriod
 	out.DisableForceDetachOnTimeout = in.DisableForceDetachOnTimeout
+	out.ReconcilerDryRun = in.ReconcilerDryRun
+	out.DriverRateLimits = in.DriverRateLimits
 	return nil
 }
 
@@ -70,6 +72,8 @@ func autoConvert_config_AttachDetachControllerConfiguration_To_v1alpha1_AttachDe
 	out.DisableAttachDetachReconcilerSync = in.DisableAttachDetachReconcilerSync
 	out.ReconcilerSyncLoopPeriod = in.ReconcilerSyncLoopPeriod
 	out.DisableForceDetachOnTimeout = in.DisableForceDetachOnTimeout
+	out.ReconcilerDryRun = in.ReconcilerDryRun
+	out.DriverRateLimits = in.DriverRateLimits
 	return nil
 }
 
@@ -19,6 +19,7 @@ package metrics
 import (
 	"errors"
 	"sync"
+	"time"
 
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
@@ -43,6 +44,21 @@ const (
 	attachDetachController        = "attach_detach_controller"
 )
 
+const (
+	// StuckAttachReasonQuota indicates the attach is blocked by an exhausted
+	// quota or attachment limit.
+	StuckAttachReasonQuota = "quota"
+	// StuckAttachReasonNotFound indicates the attach fails because the volume
+	// or its backing resource cannot be found.
+	StuckAttachReasonNotFound = "not-found"
+	// StuckAttachReasonThrottled indicates the attach is being throttled or
+	// rate limited by the storage backend.
+	StuckAttachReasonThrottled = "throttled"
+	// StuckAttachReasonUnknown is used when the attach failure cannot be
+	// classified.
+	StuckAttachReasonUnknown = "unknown"
+)
+
 var (
 	inUseVolumeMetricDesc = metrics.NewDesc(
 		metrics.BuildFQName("", "storage_count", "attachable_volumes_in_use"),
@@ -65,6 +81,36 @@ var (
 		},
 		[]string{"reason"},
 	)
+
+	MissedPodUpdatesMetricCounter = metrics.NewCounter(
+		&metrics.CounterOpts{
+			Subsystem:      attachDetachController,
+			Name:           "attachdetach_controller_missed_pod_updates",
+			Help:           "Number of pods the desired state of world populator backstop re-list added because the pod informer handlers missed them",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+
+	AttachLatencyMetricHistogram = metrics.NewHistogramVec(
+		&metrics.HistogramOpts{
+			Subsystem:      attachDetachController,
+			Name:           "attachdetach_controller_attach_latency_seconds",
+			Help:           "Time between the A/D Controller first desiring an attach and the attach completing",
+			Buckets:        metrics.ExponentialBuckets(1, 2, 12),
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"plugin_name"},
+	)
+
+	StuckAttachMetricGauge = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Subsystem:      attachDetachController,
+			Name:           "attachdetach_controller_stuck_attaches",
+			Help:           "Number of attaches that have been pending longer than the stuck-attach threshold",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"plugin_name", "reason"},
+	)
 )
 var registerMetrics sync.Once
 
@@ -87,6 +133,9 @@ func Register(pvcLister corelisters.PersistentVolumeClaimLister,
 			csiMigratedPluginManager,
 			intreeToCSITranslator))
 		legacyregistry.MustRegister(ForceDetachMetricCounter)
+		legacyregistry.MustRegister(MissedPodUpdatesMetricCounter)
+		legacyregistry.MustRegister(AttachLatencyMetricHistogram)
+		legacyregistry.MustRegister(StuckAttachMetricGauge)
 	})
 }
 
@@ -223,3 +272,31 @@ func (collector *attachDetachStateCollector) getTotalVolumesCount() volumeCount
 func RecordForcedDetachMetric(forceDetachReason string) {
 	ForceDetachMetricCounter.WithLabelValues(forceDetachReason).Inc()
 }
+
+// RecordAttachLatencyMetric registers the time a completed attach spent
+// pending, from the controller first desiring the attach to it completing.
+func RecordAttachLatencyMetric(pluginName string, latency time.Duration) {
+	AttachLatencyMetricHistogram.WithLabelValues(pluginName).Observe(latency.Seconds())
+}
+
+// StuckAttach identifies a bucket of attach operations that have been pending
+// longer than the stuck-attach threshold.
+type StuckAttach struct {
+	PluginName string
+	Reason     string
+}
+
+// UpdateStuckAttachMetric replaces the stuck-attach gauge with the given
+// per-plugin, per-reason counts.
+func UpdateStuckAttachMetric(stuck map[StuckAttach]int) {
+	StuckAttachMetricGauge.Reset()
+	for key, count := range stuck {
+		StuckAttachMetricGauge.WithLabelValues(key.PluginName, key.Reason).Set(float64(count))
+	}
+}
+
+// RecordMissedPodUpdates registers pods that the desired state of world
+// populator backstop re-list added because the informer handlers missed them.
+func RecordMissedPodUpdates(count int) {
+	MissedPodUpdatesMetricCounter.Add(float64(count))
+}
@@ -28,21 +28,25 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/labels"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	corelisters "k8s.io/client-go/listers/core/v1"
 	kcache "k8s.io/client-go/tools/cache"
 	"k8s.io/kubernetes/pkg/controller/volume/attachdetach/cache"
+	"k8s.io/kubernetes/pkg/controller/volume/attachdetach/metrics"
 	"k8s.io/kubernetes/pkg/controller/volume/attachdetach/util"
 	"k8s.io/kubernetes/pkg/volume"
 	"k8s.io/kubernetes/pkg/volume/csimigration"
 	volutil "k8s.io/kubernetes/pkg/volume/util"
+	"k8s.io/kubernetes/pkg/volume/util/types"
 )
 
 // DesiredStateOfWorldPopulator periodically verifies that the pods in the
 // desired state of the world still exist, if not, it removes them.
-// It also loops through the list of active pods and ensures that
-// each one exists in the desired state of the world cache
-// if it has volumes.
+// Additions are informer-driven: the attach/detach controller's pod event
+// handlers keep the desired state of the world up to date. The populator only
+// re-lists pods at a slow cadence as a backstop for missed updates and
+// records how many pods the backstop had to add.
 type DesiredStateOfWorldPopulator interface {
 	Run(ctx context.Context)
 }
@@ -86,32 +90,21 @@ type desiredStateOfWorldPopulator struct {
 	pvcLister                corelisters.PersistentVolumeClaimLister
 	pvLister                 corelisters.PersistentVolumeLister
 	listPodsRetryDuration    time.Duration
-	timeOfLastListPods       time.Time
 	csiMigratedPluginManager csimigration.PluginManager
 	intreeToCSITranslator    csimigration.InTreeToCSITranslator
 }
 
 func (dswp *desiredStateOfWorldPopulator) Run(ctx context.Context) {
-	wait.UntilWithContext(ctx, dswp.populatorLoopFunc(ctx), dswp.loopSleepDuration)
-}
-
-func (dswp *desiredStateOfWorldPopulator) populatorLoopFunc(ctx context.Context) func(ctx context.Context) {
-	return func(ctx context.Context) {
-		logger := klog.FromContext(ctx)
-		dswp.findAndRemoveDeletedPods(logger)
-
-		// findAndAddActivePods is called periodically, independently of the main
-		// populator loop.
-		if time.Since(dswp.timeOfLastListPods) < dswp.listPodsRetryDuration {
-			logger.V(5).Info(
-				"Skipping findAndAddActivePods(). Not permitted until the retry time is reached",
-				"retryTime", dswp.timeOfLastListPods.Add(dswp.listPodsRetryDuration),
-				"retryDuration", dswp.listPodsRetryDuration)
-
-			return
-		}
-		dswp.findAndAddActivePods(logger)
-	}
+	// Prune deleted pods at the fast cadence; pod additions arrive through
+	// the informer event handlers of the attach/detach controller, so the
+	// expensive re-list only runs at the slow backstop cadence to catch
+	// missed updates.
+	go wait.UntilWithContext(ctx, func(ctx context.Context) {
+		dswp.findAndRemoveDeletedPods(klog.FromContext(ctx))
+	}, dswp.loopSleepDuration)
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		dswp.findAndAddActivePods(klog.FromContext(ctx))
+	}, dswp.listPodsRetryDuration)
 }
 
 // Iterate through all pods in desired state of world, and remove if they no
@@ -176,14 +169,18 @@ func (dswp *desiredStateOfWorldPopulator) findAndRemoveDeletedPods(logger klog.L
 	}
 }
 
+// findAndAddActivePods re-lists pods as a backstop for missed informer
+// updates. Every pod the re-list adds to the desired state of the world
+// should already have been added by the pod event handlers, so such pods are
+// counted as detected missed updates.
 func (dswp *desiredStateOfWorldPopulator) findAndAddActivePods(logger klog.Logger) {
 	pods, err := dswp.podLister.List(labels.Everything())
 	if err != nil {
 		logger.Error(err, "PodLister List failed")
 		return
 	}
-	dswp.timeOfLastListPods = time.Now()
 
+	podsBefore := dswp.podsInDesiredStateOfWorld()
 	for _, pod := range pods {
 		if volutil.IsPodTerminated(pod, pod.Status) {
 			// Do not add volumes for terminated pods
@@ -194,4 +191,24 @@ func (dswp *desiredStateOfWorldPopulator) findAndAddActivePods(logger klog.Logge
 
 	}
 
+	missedUpdates := 0
+	for podUID := range dswp.podsInDesiredStateOfWorld() {
+		if !podsBefore.Has(podUID) {
+			logger.Info("Backstop re-list added pod missed by the pod informer handlers", "podUID", podUID)
+			missedUpdates++
+		}
+	}
+	if missedUpdates > 0 {
+		metrics.RecordMissedPodUpdates(missedUpdates)
+	}
+}
+
+// podsInDesiredStateOfWorld returns the unique names of all pods currently
+// tracked in the desired state of the world.
+func (dswp *desiredStateOfWorldPopulator) podsInDesiredStateOfWorld() sets.Set[types.UniquePodName] {
+	pods := sets.New[types.UniquePodName]()
+	for podUID := range dswp.desiredStateOfWorld.GetPodToAdd() {
+		pods.Insert(podUID)
+	}
+	return pods
 }
@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"k8s.io/klog/v2"
+)
+
+// In dry-run mode the controller computes and logs the attach and detach
+// operations it would execute without starting them and without updating node
+// status. Operators use it to validate CSI migration or failover plans
+// against a production-like cluster before letting the controller act.
+
+const (
+	dryRunActionAttach = "attach"
+	dryRunActionDetach = "detach"
+)
+
+// dryRunSkipOperation returns true if the reconciler runs in dry-run mode and
+// the operation must therefore be skipped. The intended operation is logged
+// the first time it is seen for the volume/node pair so that the periodic
+// reconciliation loop does not flood the log; subsequent iterations log at a
+// high verbosity only. The deduplication map is kept for the lifetime of the
+// controller and is bounded by the number of volume/node pairs.
+func (rc *reconciler) dryRunSkipOperation(logger klog.Logger, action string, key attachKey, keysAndValues ...interface{}) bool {
+	if !rc.dryRun {
+		return false
+	}
+	keysAndValues = append([]interface{}{
+		"action", action,
+		"volumeName", key.volumeName,
+		"nodeName", key.nodeName,
+	}, keysAndValues...)
+	if rc.dryRunReported[key] != action {
+		rc.dryRunReported[key] = action
+		logger.Info("Dry run: skipping operation the controller would execute", keysAndValues...)
+	} else {
+		logger.V(10).Info("Dry run: skipping operation the controller would execute", keysAndValues...)
+	}
+	return true
+}
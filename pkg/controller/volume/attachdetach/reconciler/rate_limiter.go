@@ -0,0 +1,197 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/controller/volume/attachdetach/metrics"
+)
+
+const (
+	// throttleBackoffInitial is the delay imposed on a driver after the
+	// first throttling error from its attach/detach API.
+	throttleBackoffInitial = 10 * time.Second
+
+	// throttleBackoffMax caps the adaptive backoff delay for a driver whose
+	// attach/detach API keeps returning throttling errors.
+	throttleBackoffMax = 5 * time.Minute
+)
+
+// driverRateLimit is the parsed rate limit of one driver.
+type driverRateLimit struct {
+	qps   float32
+	burst int
+}
+
+// throttleBackoff tracks the adaptive backoff of a driver whose attach/detach
+// API returned throttling errors. The delay doubles with every further
+// throttling error and is cleared by the first successfully started
+// operation.
+type throttleBackoff struct {
+	delay time.Duration
+	until time.Time
+}
+
+// driverRateLimiter limits how fast the reconciler starts attach and detach
+// operations per driver. On top of the configured token bucket it applies an
+// adaptive backoff when the driver's API reports throttling. It is only
+// accessed from the reconciler loop goroutine and therefore needs no locking.
+type driverRateLimiter struct {
+	limits   map[string]driverRateLimit
+	buckets  map[string]flowcontrol.RateLimiter
+	backoffs map[string]*throttleBackoff
+}
+
+// newConfiguredDriverRateLimiter returns the rate limiter for the given
+// configuration value, or nil if the value is empty or cannot be parsed. A
+// nil limiter admits all operations.
+func newConfiguredDriverRateLimiter(value string) *driverRateLimiter {
+	if value == "" {
+		return nil
+	}
+	limits, err := parseDriverRateLimits(value)
+	if err != nil {
+		// The reconciler must keep working with a bad configuration, so fall
+		// back to unlimited operation.
+		klog.Background().Error(err, "Ignoring invalid driver rate limit configuration", "value", value)
+		return nil
+	}
+	return newDriverRateLimiter(limits)
+}
+
+func newDriverRateLimiter(limits map[string]driverRateLimit) *driverRateLimiter {
+	return &driverRateLimiter{
+		limits:   limits,
+		buckets:  make(map[string]flowcontrol.RateLimiter),
+		backoffs: make(map[string]*throttleBackoff),
+	}
+}
+
+// parseDriverRateLimits parses a comma-separated list of
+// <driver>=<qps>/<burst> entries, where <driver> is the full qualified plugin
+// name used by the controller metrics, e.g.
+// "kubernetes.io/csi:ebs.csi.aws.com=10/20". The entry "*" applies to drivers
+// without an explicit entry; drivers without a matching entry are not
+// limited.
+func parseDriverRateLimits(value string) (map[string]driverRateLimit, error) {
+	limits := make(map[string]driverRateLimit)
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		driver, limitStr, found := strings.Cut(entry, "=")
+		if !found || driver == "" {
+			return nil, fmt.Errorf("invalid rate limit entry %q, expected <driver>=<qps>/<burst>", entry)
+		}
+		qpsStr, burstStr, found := strings.Cut(limitStr, "/")
+		if !found {
+			return nil, fmt.Errorf("invalid rate limit entry %q, expected <driver>=<qps>/<burst>", entry)
+		}
+		qps, err := strconv.ParseFloat(qpsStr, 32)
+		if err != nil || qps <= 0 {
+			return nil, fmt.Errorf("invalid QPS in rate limit entry %q", entry)
+		}
+		burst, err := strconv.Atoi(burstStr)
+		if err != nil || burst < 1 {
+			return nil, fmt.Errorf("invalid burst in rate limit entry %q", entry)
+		}
+		limits[driver] = driverRateLimit{qps: float32(qps), burst: burst}
+	}
+	if len(limits) == 0 {
+		return nil, fmt.Errorf("no rate limit entries in %q", value)
+	}
+	return limits, nil
+}
+
+// limitFor returns the driver whose limit applies to the given plugin and
+// whether any limit applies at all.
+func (l *driverRateLimiter) limitFor(pluginName string) (string, bool) {
+	if _, ok := l.limits[pluginName]; ok {
+		return pluginName, true
+	}
+	if _, ok := l.limits["*"]; ok {
+		return "*", true
+	}
+	return "", false
+}
+
+// admitOperation returns true if an attach or detach operation for the given
+// plugin may be started now. Deferred operations are retried by the next
+// reconciler loop iteration.
+func (l *driverRateLimiter) admitOperation(pluginName string) bool {
+	if l == nil {
+		return true
+	}
+	driver, limited := l.limitFor(pluginName)
+	if !limited {
+		return true
+	}
+	if backoff, ok := l.backoffs[driver]; ok && time.Now().Before(backoff.until) {
+		return false
+	}
+	bucket, ok := l.buckets[driver]
+	if !ok {
+		limit := l.limits[driver]
+		bucket = flowcontrol.NewTokenBucketRateLimiter(limit.qps, limit.burst)
+		l.buckets[driver] = bucket
+	}
+	return bucket.TryAccept()
+}
+
+// observeOperationError records the result of starting an attach or detach
+// operation for the given plugin. Throttling errors extend the adaptive
+// backoff of the driver; other errors are ignored.
+func (l *driverRateLimiter) observeOperationError(pluginName string, err error) {
+	if l == nil {
+		return
+	}
+	driver, limited := l.limitFor(pluginName)
+	if !limited {
+		return
+	}
+	if classifyAttachFailure(err) != metrics.StuckAttachReasonThrottled {
+		return
+	}
+	backoff, ok := l.backoffs[driver]
+	if !ok {
+		backoff = &throttleBackoff{delay: throttleBackoffInitial}
+		l.backoffs[driver] = backoff
+	} else if time.Now().After(backoff.until) {
+		// Only grow the delay for errors observed after the previous backoff
+		// expired; errors from operations started before it say nothing new.
+		backoff.delay = min(backoff.delay*2, throttleBackoffMax)
+	}
+	backoff.until = time.Now().Add(backoff.delay)
+}
+
+// observeOperationStarted records that an attach or detach operation for the
+// given plugin was started successfully and clears its adaptive backoff.
+func (l *driverRateLimiter) observeOperationStarted(pluginName string) {
+	if l == nil {
+		return
+	}
+	if driver, limited := l.limitFor(pluginName); limited {
+		delete(l.backoffs, driver)
+	}
+}
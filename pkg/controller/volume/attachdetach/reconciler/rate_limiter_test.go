@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestParseDriverRateLimits(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		expectErr bool
+		expected  map[string]driverRateLimit
+	}{
+		{
+			name:  "single driver",
+			value: "kubernetes.io/csi:ebs.csi.aws.com=10/20",
+			expected: map[string]driverRateLimit{
+				"kubernetes.io/csi:ebs.csi.aws.com": {qps: 10, burst: 20},
+			},
+		},
+		{
+			name:  "multiple drivers with default",
+			value: "kubernetes.io/csi:ebs.csi.aws.com=10/20, *=1/5",
+			expected: map[string]driverRateLimit{
+				"kubernetes.io/csi:ebs.csi.aws.com": {qps: 10, burst: 20},
+				"*":                                 {qps: 1, burst: 5},
+			},
+		},
+		{
+			name:  "fractional QPS",
+			value: "*=0.5/1",
+			expected: map[string]driverRateLimit{
+				"*": {qps: 0.5, burst: 1},
+			},
+		},
+		{
+			name:      "missing burst",
+			value:     "driver=10",
+			expectErr: true,
+		},
+		{
+			name:      "missing driver",
+			value:     "=10/20",
+			expectErr: true,
+		},
+		{
+			name:      "non-positive QPS",
+			value:     "driver=0/20",
+			expectErr: true,
+		},
+		{
+			name:      "non-positive burst",
+			value:     "driver=10/0",
+			expectErr: true,
+		},
+		{
+			name:      "empty list",
+			value:     " , ",
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			limits, err := parseDriverRateLimits(test.value)
+			if test.expectErr {
+				if err == nil {
+					t.Fatalf("Expected error parsing %q, got %v", test.value, limits)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected success parsing %q: %v", test.value, err)
+			}
+			if len(limits) != len(test.expected) {
+				t.Fatalf("Expected %v, got %v", test.expected, limits)
+			}
+			for driver, expected := range test.expected {
+				if limits[driver] != expected {
+					t.Errorf("Expected limit %v for driver %q, got %v", expected, driver, limits[driver])
+				}
+			}
+		})
+	}
+}
+
+func TestDriverRateLimiterAdmitOperation(t *testing.T) {
+	limiter := newDriverRateLimiter(map[string]driverRateLimit{
+		"limited-driver": {qps: 0.001, burst: 2},
+	})
+
+	// Unlimited drivers and the nil limiter always admit.
+	var nilLimiter *driverRateLimiter
+	if !nilLimiter.admitOperation("limited-driver") {
+		t.Errorf("Expected the nil limiter to admit all operations")
+	}
+	for i := 0; i < 10; i++ {
+		if !limiter.admitOperation("other-driver") {
+			t.Errorf("Expected operations for a driver without a limit to be admitted")
+		}
+	}
+
+	// The burst admits two operations, then the bucket is exhausted.
+	for i := 0; i < 2; i++ {
+		if !limiter.admitOperation("limited-driver") {
+			t.Errorf("Expected operation %d within the burst to be admitted", i)
+		}
+	}
+	if limiter.admitOperation("limited-driver") {
+		t.Errorf("Expected operation beyond the burst to be deferred")
+	}
+}
+
+func TestDriverRateLimiterThrottleBackoff(t *testing.T) {
+	limiter := newDriverRateLimiter(map[string]driverRateLimit{
+		"driver": {qps: 100, burst: 100},
+	})
+	throttled := fmt.Errorf("RequestLimitExceeded: too many requests")
+
+	// Non-throttling errors do not cause backoff.
+	limiter.observeOperationError("driver", fmt.Errorf("volume not found"))
+	if !limiter.admitOperation("driver") {
+		t.Errorf("Expected no backoff after a non-throttling error")
+	}
+
+	// A throttling error defers further operations for the driver.
+	limiter.observeOperationError("driver", throttled)
+	if limiter.admitOperation("driver") {
+		t.Errorf("Expected backoff after a throttling error")
+	}
+	backoff := limiter.backoffs["driver"]
+	if backoff.delay != throttleBackoffInitial {
+		t.Errorf("Expected initial backoff delay %v, got %v", throttleBackoffInitial, backoff.delay)
+	}
+
+	// A throttling error observed after the backoff expired doubles the delay.
+	backoff.until = time.Now().Add(-time.Second)
+	limiter.observeOperationError("driver", throttled)
+	if backoff.delay != 2*throttleBackoffInitial {
+		t.Errorf("Expected doubled backoff delay %v, got %v", 2*throttleBackoffInitial, backoff.delay)
+	}
+
+	// A successfully started operation clears the backoff.
+	limiter.observeOperationStarted("driver")
+	if !limiter.admitOperation("driver") {
+		t.Errorf("Expected no backoff after an operation started successfully")
+	}
+}
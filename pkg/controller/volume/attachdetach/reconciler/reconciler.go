@@ -22,12 +22,16 @@ package reconciler
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
+	clientset "k8s.io/client-go/kubernetes"
 	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
@@ -38,10 +42,29 @@ import (
 	"k8s.io/kubernetes/pkg/util/goroutinemap/exponentialbackoff"
 	nodeutil "k8s.io/kubernetes/pkg/util/node"
 	"k8s.io/kubernetes/pkg/util/taints"
+	"k8s.io/kubernetes/pkg/volume"
 	"k8s.io/kubernetes/pkg/volume/util"
 	"k8s.io/kubernetes/pkg/volume/util/operationexecutor"
 )
 
+// PersistentVolumeClaimMultiAttachBlocked is set on a PVC whose volume cannot
+// be attached to the node its pod is scheduled to because the volume is still
+// attached to another node. The condition message names the blocking node(s)
+// and pod(s) so the user can resolve the conflict.
+const PersistentVolumeClaimMultiAttachBlocked v1.PersistentVolumeClaimConditionType = "MultiAttachBlocked"
+
+// multiAttachBlockedReason is the reason of the MultiAttachBlocked PVC condition.
+const multiAttachBlockedReason = "VolumeAttachedToAnotherNode"
+
+// stuckAttachThreshold is how long an attach may stay pending before the
+// controller reports it as stuck via the stuck-attach gauge and a warning
+// event on the scheduled pods.
+const stuckAttachThreshold = 10 * time.Minute
+
+// pluginNameNotAvailable is used as the plugin metric label when the plugin
+// for a volume spec cannot be determined.
+const pluginNameNotAvailable = "N/A"
+
 // Reconciler runs a periodic loop to reconcile the desired state of the world with
 // the actual state of the world by triggering attach detach operations.
 // Note: This is distinct from the Reconciler implemented by the kubelet volume
@@ -70,11 +93,15 @@ func NewReconciler(
 	syncDuration time.Duration,
 	disableReconciliationSync bool,
 	disableForceDetachOnTimeout bool,
+	dryRun bool,
+	driverRateLimits string,
 	desiredStateOfWorld cache.DesiredStateOfWorld,
 	actualStateOfWorld cache.ActualStateOfWorld,
 	attacherDetacher operationexecutor.OperationExecutor,
 	nodeStatusUpdater statusupdater.NodeStatusUpdater,
 	nodeLister corelisters.NodeLister,
+	volumePluginMgr *volume.VolumePluginMgr,
+	kubeClient clientset.Interface,
 	recorder record.EventRecorder) Reconciler {
 	return &reconciler{
 		loopPeriod:                  loopPeriod,
@@ -87,7 +114,14 @@ func NewReconciler(
 		attacherDetacher:            attacherDetacher,
 		nodeStatusUpdater:           nodeStatusUpdater,
 		nodeLister:                  nodeLister,
+		volumePluginMgr:             volumePluginMgr,
+		kubeClient:                  kubeClient,
 		timeOfLastSync:              time.Now(),
+		attachesPending:             make(map[attachKey]*pendingAttach),
+		multiAttachBlockedClaims:    make(map[attachKey]*v1.ObjectReference),
+		driverRateLimiter:           newConfiguredDriverRateLimiter(driverRateLimits),
+		dryRun:                      dryRun,
+		dryRunReported:              make(map[attachKey]string),
 		recorder:                    recorder,
 	}
 }
@@ -101,10 +135,54 @@ type reconciler struct {
 	attacherDetacher            operationexecutor.OperationExecutor
 	nodeStatusUpdater           statusupdater.NodeStatusUpdater
 	nodeLister                  corelisters.NodeLister
+	volumePluginMgr             *volume.VolumePluginMgr
+	kubeClient                  clientset.Interface
 	timeOfLastSync              time.Time
 	disableReconciliationSync   bool
 	disableForceDetachOnTimeout bool
-	recorder                    record.EventRecorder
+	// attachesPending tracks, per volume and node, since when the controller
+	// has been trying to attach the volume. It is only accessed from the
+	// reconciler loop goroutine.
+	attachesPending map[attachKey]*pendingAttach
+	// multiAttachBlockedClaims tracks, per desired volume and node, the PVC
+	// that currently carries the MultiAttachBlocked condition, so that the
+	// condition is cleared when the volume stops being desired (e.g. the
+	// blocked pod was deleted before the attach could proceed). It is only
+	// accessed from the reconciler loop goroutine.
+	multiAttachBlockedClaims map[attachKey]*v1.ObjectReference
+	// driverRateLimiter limits how fast attach and detach operations are
+	// started per driver, see parseDriverRateLimits. It is nil when no limits
+	// are configured and is only accessed from the reconciler loop goroutine.
+	driverRateLimiter *driverRateLimiter
+	// dryRun makes the reconciler log intended attach/detach operations
+	// instead of executing them. dryRunReported deduplicates the dry-run log
+	// messages per volume/node pair and last intended action. Both are only
+	// accessed from the reconciler loop goroutine.
+	dryRun         bool
+	dryRunReported map[attachKey]string
+	recorder       record.EventRecorder
+}
+
+// attachKey identifies a desired attachment of a volume to a node.
+type attachKey struct {
+	volumeName v1.UniqueVolumeName
+	nodeName   types.NodeName
+}
+
+// pendingAttach records the state of an attach that has not completed yet.
+type pendingAttach struct {
+	// since is when the reconciler first saw the volume as desired but not
+	// attached.
+	since time.Time
+	// pluginName is the full qualified plugin name of the volume, used as the
+	// metric label.
+	pluginName string
+	// lastErr is the most recent non-backoff error returned when starting the
+	// attach, if any.
+	lastErr error
+	// eventReported indicates the stuck-attach warning event has already been
+	// sent for this attach.
+	eventReported bool
 }
 
 func (rc *reconciler) Run(ctx context.Context) {
@@ -166,9 +244,13 @@ func (rc *reconciler) reconcile(ctx context.Context) {
 	// Detaches are triggered before attaches so that volumes referenced by
 	// pods that are rescheduled to a different node are detached first.
 
-	// Ensure volumes that should be detached are detached.
+	// Ensure volumes that should be detached are detached. Volumes whose
+	// pods were already rescheduled to another node are detached first, see
+	// prioritizeDetachOrder.
 	logger := klog.FromContext(ctx)
-	for _, attachedVolume := range rc.actualStateOfWorld.GetAttachedVolumes() {
+	attachedVolumes := rc.actualStateOfWorld.GetAttachedVolumes()
+	prioritizeDetachOrder(attachedVolumes, rc.desiredStateOfWorld.GetVolumesToAttach())
+	for _, attachedVolume := range attachedVolumes {
 		if !rc.desiredStateOfWorld.VolumeExists(
 			attachedVolume.VolumeName, attachedVolume.NodeName) {
 
@@ -238,6 +320,30 @@ func (rc *reconciler) reconcile(ctx context.Context) {
 				continue
 			}
 
+			// In dry-run mode the detach decision is logged and nothing is
+			// executed, including the node status update below.
+			if rc.dryRunSkipOperation(logger, dryRunActionDetach,
+				attachKey{volumeName: attachedVolume.VolumeName, nodeName: attachedVolume.NodeName},
+				"forceDetach", forceDetach,
+				"outOfServiceTaint", hasOutOfServiceTaint) {
+				continue
+			}
+
+			// Defer the detach when the rate limit of the driver is exhausted
+			// or the driver is backing off after throttling errors, see
+			// configured driver rate limits. The check happens before the node status
+			// update so that a deferred detach has no side effects.
+			detachPluginName := pluginNameNotAvailable
+			if rc.driverRateLimiter != nil {
+				if plugin, findErr := rc.volumePluginMgr.FindPluginBySpec(attachedVolume.VolumeSpec); findErr == nil && plugin != nil {
+					detachPluginName = util.GetFullQualifiedPluginNameForVolume(plugin.GetPluginName(), attachedVolume.VolumeSpec)
+				}
+				if !rc.driverRateLimiter.admitOperation(detachPluginName) {
+					logger.V(4).Info("Deferring detach because of the driver rate limit", "node", klog.KRef("", string(attachedVolume.NodeName)), "volumeName", attachedVolume.VolumeName, "driver", detachPluginName)
+					continue
+				}
+			}
+
 			// Before triggering volume detach, mark volume as detached and update the node status
 			// If it fails to update node status, skip detach volume
 			// If volume detach operation fails, the volume needs to be added back to report as attached so that node status
@@ -271,6 +377,7 @@ func (rc *reconciler) reconcile(ctx context.Context) {
 			verifySafeToDetach := !(forceDetatchTimeoutExpired || hasOutOfServiceTaint)
 			err = rc.attacherDetacher.DetachVolume(logger, attachedVolume.AttachedVolume, verifySafeToDetach, rc.actualStateOfWorld)
 			if err == nil {
+				rc.driverRateLimiter.observeOperationStarted(detachPluginName)
 				if verifySafeToDetach { // normal detach
 					logger.Info("attacherDetacher.DetachVolume started", "node", klog.KRef("", string(attachedVolume.NodeName)), "volumeName", attachedVolume.VolumeName)
 				} else { // force detach
@@ -297,6 +404,7 @@ func (rc *reconciler) reconcile(ctx context.Context) {
 				if !exponentialbackoff.IsExponentialBackoff(err) {
 					// Ignore exponentialbackoff.IsExponentialBackoff errors, they are expected.
 					// Log all other errors.
+					rc.driverRateLimiter.observeOperationError(detachPluginName, err)
 					logger.Error(err, "attacherDetacher.DetachVolume failed to start", "node", klog.KRef("", string(attachedVolume.NodeName)), "volumeName", attachedVolume.VolumeName)
 				}
 			}
@@ -312,9 +420,38 @@ func (rc *reconciler) reconcile(ctx context.Context) {
 	}
 }
 
+// prioritizeDetachOrder orders the detach candidates so that volumes that are
+// already waiting to attach to another node — i.e. their pods have been
+// rescheduled, for example after a zone failure — come first. When many nodes
+// fail at once and the detach backlog is long, this shortens the failover
+// time of stateful workloads: their volumes cannot attach to the new node
+// before the old attachment is gone. The order is stable so that volumes of
+// equal priority keep their relative order.
+func prioritizeDetachOrder(attachedVolumes []cache.AttachedVolume, volumesToAttach []cache.VolumeToAttach) {
+	desiredNodes := make(map[v1.UniqueVolumeName][]types.NodeName, len(volumesToAttach))
+	for _, volumeToAttach := range volumesToAttach {
+		desiredNodes[volumeToAttach.VolumeName] = append(desiredNodes[volumeToAttach.VolumeName], volumeToAttach.NodeName)
+	}
+	neededElsewhere := func(attachedVolume cache.AttachedVolume) bool {
+		for _, nodeName := range desiredNodes[attachedVolume.VolumeName] {
+			if nodeName != attachedVolume.NodeName {
+				return true
+			}
+		}
+		return false
+	}
+	sort.SliceStable(attachedVolumes, func(i, j int) bool {
+		return neededElsewhere(attachedVolumes[i]) && !neededElsewhere(attachedVolumes[j])
+	})
+}
+
 func (rc *reconciler) attachDesiredVolumes(logger klog.Logger) {
+	desired := make(map[attachKey]bool)
 	// Ensure volumes that should be attached are attached.
 	for _, volumeToAttach := range rc.desiredStateOfWorld.GetVolumesToAttach() {
+		key := attachKey{volumeName: volumeToAttach.VolumeName, nodeName: volumeToAttach.NodeName}
+		desired[key] = true
+
 		if util.IsMultiAttachAllowed(volumeToAttach.VolumeSpec) {
 			// Don't even try to start an operation if there is already one running for the given volume and node.
 			if rc.attacherDetacher.IsOperationPending(volumeToAttach.VolumeName, "" /* podName */, volumeToAttach.NodeName) {
@@ -339,9 +476,22 @@ func (rc *reconciler) attachDesiredVolumes(logger klog.Logger) {
 			// Volume/Node exists, touch it to reset detachRequestedTime
 			logger.V(10).Info("Volume attached--touching", "volume", volumeToAttach)
 			rc.actualStateOfWorld.ResetDetachRequestTime(logger, volumeToAttach.VolumeName, volumeToAttach.NodeName)
+			// An attach the reconciler was waiting on has completed; observe
+			// its latency exactly once, on the pending to attached
+			// transition. Volumes that were already attached when they
+			// became desired have no pending record and produce no sample.
+			if pending, ok := rc.attachesPending[key]; ok {
+				metrics.RecordAttachLatencyMetric(pending.pluginName, time.Since(pending.since))
+				delete(rc.attachesPending, key)
+			}
 			continue
 		}
 
+		// The volume is desired but not attached; start tracking the attach
+		// so its latency and stuck state can be reported.
+		pending := rc.trackPendingAttach(key, volumeToAttach)
+		rc.reportStuckAttach(logger, pending, volumeToAttach)
+
 		if !util.IsMultiAttachAllowed(volumeToAttach.VolumeSpec) {
 			nodes := rc.actualStateOfWorld.GetNodesForAttachedVolume(volumeToAttach.VolumeName)
 			if len(nodes) > 0 {
@@ -353,18 +503,130 @@ func (rc *reconciler) attachDesiredVolumes(logger klog.Logger) {
 			}
 		}
 
+		// In dry-run mode the attach decision is logged and nothing is
+		// executed.
+		if rc.dryRunSkipOperation(logger, dryRunActionAttach, key,
+			"scheduledPods", klog.KObjSlice(volumeToAttach.ScheduledPods)) {
+			continue
+		}
+
+		// Defer the attach when the rate limit of the driver is exhausted or
+		// the driver is backing off after throttling errors, see
+		// the configured driver rate limits. The next loop iteration retries.
+		if !rc.driverRateLimiter.admitOperation(pending.pluginName) {
+			logger.V(4).Info("Deferring attach because of the driver rate limit", "volumeName", volumeToAttach.VolumeName, "nodeName", volumeToAttach.NodeName, "driver", pending.pluginName)
+			continue
+		}
+
 		// Volume/Node doesn't exist, spawn a goroutine to attach it
 		logger.V(5).Info("Starting attacherDetacher.AttachVolume", "volume", volumeToAttach)
 		err := rc.attacherDetacher.AttachVolume(logger, volumeToAttach.VolumeToAttach, rc.actualStateOfWorld)
 		if err == nil {
+			rc.driverRateLimiter.observeOperationStarted(pending.pluginName)
 			logger.Info("attacherDetacher.AttachVolume started", "volumeName", volumeToAttach.VolumeName, "nodeName", volumeToAttach.NodeName, "scheduledPods", klog.KObjSlice(volumeToAttach.ScheduledPods))
+			if volumeToAttach.MultiAttachErrorReported {
+				// The volume is no longer blocked by another node; drop the
+				// remediation condition from its PVC.
+				rc.clearMultiAttachBlockedCondition(logger, volumeToAttach)
+			}
 		}
 		if err != nil && !exponentialbackoff.IsExponentialBackoff(err) {
 			// Ignore exponentialbackoff.IsExponentialBackoff errors, they are expected.
 			// Log all other errors.
+			pending.lastErr = err
+			rc.driverRateLimiter.observeOperationError(pending.pluginName, err)
 			logger.Error(err, "attacherDetacher.AttachVolume failed to start", "volumeName", volumeToAttach.VolumeName, "nodeName", volumeToAttach.NodeName, "scheduledPods", klog.KObjSlice(volumeToAttach.ScheduledPods))
 		}
 	}
+
+	rc.clearBlockedConditionsNoLongerDesired(logger, desired)
+	rc.updateStuckAttachMetric(desired)
+}
+
+// clearBlockedConditionsNoLongerDesired clears the MultiAttachBlocked
+// condition from the PVCs of volumes that stopped being desired while
+// blocked, e.g. because the blocked pod was deleted before the attach could
+// proceed. Without this the condition would stay on the claim forever.
+func (rc *reconciler) clearBlockedConditionsNoLongerDesired(logger klog.Logger, desired map[attachKey]bool) {
+	for key, claimRef := range rc.multiAttachBlockedClaims {
+		if desired[key] {
+			continue
+		}
+		rc.clearMultiAttachBlockedConditionForClaim(logger, claimRef)
+		delete(rc.multiAttachBlockedClaims, key)
+	}
+}
+
+// trackPendingAttach returns the pending attach record for the given volume
+// and node, creating it the first time the reconciler sees the attachment as
+// desired but not yet attached.
+func (rc *reconciler) trackPendingAttach(key attachKey, volumeToAttach cache.VolumeToAttach) *pendingAttach {
+	if pending, ok := rc.attachesPending[key]; ok {
+		return pending
+	}
+	pluginName := pluginNameNotAvailable
+	if plugin, err := rc.volumePluginMgr.FindPluginBySpec(volumeToAttach.VolumeSpec); err == nil && plugin != nil {
+		pluginName = util.GetFullQualifiedPluginNameForVolume(plugin.GetPluginName(), volumeToAttach.VolumeSpec)
+	}
+	pending := &pendingAttach{
+		since:      time.Now(),
+		pluginName: pluginName,
+	}
+	rc.attachesPending[key] = pending
+	return pending
+}
+
+// reportStuckAttach sends a one-time warning event to the scheduled pods of an
+// attach that has been pending longer than stuckAttachThreshold.
+func (rc *reconciler) reportStuckAttach(logger klog.Logger, pending *pendingAttach, volumeToAttach cache.VolumeToAttach) {
+	if pending.eventReported || time.Since(pending.since) < stuckAttachThreshold {
+		return
+	}
+	reason := classifyAttachFailure(pending.lastErr)
+	simpleMsg, _ := volumeToAttach.GenerateMsg("Attach stuck", fmt.Sprintf("Volume has been waiting to attach for more than %v (failure classification: %s)", stuckAttachThreshold, reason))
+	for _, pod := range volumeToAttach.ScheduledPods {
+		rc.recorder.Eventf(pod, v1.EventTypeWarning, kevents.FailedAttachVolume, simpleMsg)
+	}
+	logger.Info("Attach has been pending longer than the stuck-attach threshold", "volumeName", volumeToAttach.VolumeName, "nodeName", volumeToAttach.NodeName, "pendingSince", pending.since, "reason", reason)
+	pending.eventReported = true
+}
+
+// updateStuckAttachMetric prunes pending attach records that are no longer
+// desired and republishes the stuck-attach gauge.
+func (rc *reconciler) updateStuckAttachMetric(desired map[attachKey]bool) {
+	stuck := make(map[metrics.StuckAttach]int)
+	for key, pending := range rc.attachesPending {
+		if !desired[key] {
+			delete(rc.attachesPending, key)
+			continue
+		}
+		if time.Since(pending.since) >= stuckAttachThreshold {
+			stuck[metrics.StuckAttach{PluginName: pending.pluginName, Reason: classifyAttachFailure(pending.lastErr)}]++
+		}
+	}
+	metrics.UpdateStuckAttachMetric(stuck)
+}
+
+// classifyAttachFailure maps an attach error to one of the coarse failure
+// reasons used by the stuck-attach gauge. Plugin errors are free-form, so the
+// classification is based on common substrings.
+func classifyAttachFailure(err error) string {
+	if err == nil {
+		return metrics.StuckAttachReasonUnknown
+	}
+	msg := strings.ToLower(err.Error())
+	switch {
+	// Throttling is checked first: messages like "request limit exceeded"
+	// would otherwise match the quota classification.
+	case strings.Contains(msg, "throttl") || strings.Contains(msg, "rate limit") || strings.Contains(msg, "too many requests") || strings.Contains(msg, "request limit"):
+		return metrics.StuckAttachReasonThrottled
+	case strings.Contains(msg, "quota") || strings.Contains(msg, "limit exceeded") || strings.Contains(msg, "attachment limit"):
+		return metrics.StuckAttachReasonQuota
+	case strings.Contains(msg, "not found") || strings.Contains(msg, "notfound") || strings.Contains(msg, "does not exist"):
+		return metrics.StuckAttachReasonNotFound
+	default:
+		return metrics.StuckAttachReasonUnknown
+	}
 }
 
 // reportMultiAttachError sends events and logs situation that a volume that
@@ -387,12 +649,19 @@ func (rc *reconciler) reportMultiAttachError(logger klog.Logger, volumeToAttach
 
 	// Get list of pods that use the volume on the other nodes.
 	pods := rc.desiredStateOfWorld.GetVolumePodsOnNodes(otherNodes, volumeToAttach.VolumeName)
+	forceDetachMsg := rc.forceDetachEstimateMsg(volumeToAttach.VolumeName, otherNodes)
 	if len(pods) == 0 {
 		// We did not find any pods that requests the volume. The pod must have been deleted already.
-		simpleMsg, _ := volumeToAttach.GenerateMsg("Multi-Attach error", "Volume is already exclusively attached to one node and can't be attached to another")
+		attachedTo := "one node"
+		if len(otherNodesStr) != 0 {
+			attachedTo = fmt.Sprintf("node(s) %s", strings.Join(otherNodesStr, ", "))
+		}
+		msg := fmt.Sprintf("Volume is already exclusively attached to %s and can't be attached to another. %s", attachedTo, forceDetachMsg)
+		simpleMsg, _ := volumeToAttach.GenerateMsg("Multi-Attach error", msg)
 		for _, pod := range volumeToAttach.ScheduledPods {
 			rc.recorder.Eventf(pod, v1.EventTypeWarning, kevents.FailedAttachVolume, simpleMsg)
 		}
+		rc.updateMultiAttachBlockedCondition(logger, volumeToAttach, msg)
 		// Log detailed message to system admin
 		logger.Info("Multi-Attach error: volume is already exclusively attached and can't be attached to another node", "attachedTo", otherNodesStr, "volume", volumeToAttach)
 		return
@@ -417,18 +686,145 @@ func (rc *reconciler) reportMultiAttachError(logger klog.Logger, volumeToAttach
 
 		var msg string
 		if len(localPodNames) > 0 {
-			msg = fmt.Sprintf("Volume is already used by pod(s) %s", strings.Join(localPodNames, ", "))
+			msg = fmt.Sprintf("Volume is already used by pod(s) %s on node(s) %s", strings.Join(localPodNames, ", "), strings.Join(otherNodesStr, ", "))
 			if otherPods > 0 {
 				msg = fmt.Sprintf("%s and %d pod(s) in different namespaces", msg, otherPods)
 			}
 		} else {
 			// No local pods, there are pods only in different namespaces.
-			msg = fmt.Sprintf("Volume is already used by %d pod(s) in different namespaces", otherPods)
+			msg = fmt.Sprintf("Volume is already used by %d pod(s) in different namespaces on node(s) %s", otherPods, strings.Join(otherNodesStr, ", "))
 		}
+		msg = fmt.Sprintf("%s. %s", msg, forceDetachMsg)
 		simpleMsg, _ := volumeToAttach.GenerateMsg("Multi-Attach error", msg)
 		rc.recorder.Eventf(scheduledPod, v1.EventTypeWarning, kevents.FailedAttachVolume, simpleMsg)
 	}
 
+	// The PVC condition may name pods in other namespaces; unlike the
+	// per-pod events above it is only readable by users who can already
+	// read the claim itself.
+	podNames := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		podNames = append(podNames, pod.Namespace+"/"+pod.Name)
+	}
+	conditionMsg := fmt.Sprintf("Volume is attached to node(s) %s and used by pod(s) %s. %s", strings.Join(otherNodesStr, ", "), strings.Join(podNames, ", "), forceDetachMsg)
+	rc.updateMultiAttachBlockedCondition(logger, volumeToAttach, conditionMsg)
+
 	// Log all pods for system admin
 	logger.Info("Multi-Attach error: volume is already used by pods", "pods", klog.KObjSlice(pods), "attachedTo", otherNodesStr, "volume", volumeToAttach)
 }
+
+// forceDetachEstimateMsg returns a human readable estimate of when the
+// attach/detach controller may force-detach the volume from the node(s) that
+// currently block the attach.
+func (rc *reconciler) forceDetachEstimateMsg(volumeName v1.UniqueVolumeName, otherNodes []types.NodeName) string {
+	if rc.disableForceDetachOnTimeout {
+		return "Force detach on timeout is disabled; delete the pod(s) using the volume or detach it manually"
+	}
+	remaining := rc.maxWaitForUnmountDuration
+	for _, attachedVolume := range rc.actualStateOfWorld.GetAttachedVolumes() {
+		if attachedVolume.VolumeName != volumeName || attachedVolume.DetachRequestedTime.IsZero() {
+			continue
+		}
+		for _, node := range otherNodes {
+			if attachedVolume.NodeName == node {
+				if left := rc.maxWaitForUnmountDuration - time.Since(attachedVolume.DetachRequestedTime); left < remaining {
+					remaining = left
+				}
+			}
+		}
+	}
+	if remaining < 0 {
+		remaining = 0
+	}
+	return fmt.Sprintf("The volume may be force-detached from the blocking node(s) in approximately %s if they become unhealthy or are tainted out-of-service", remaining.Round(time.Second))
+}
+
+// updateMultiAttachBlockedCondition sets the MultiAttachBlocked condition on
+// the PVC backing the given volume, if the volume is claim-based. Failures are
+// logged and ignored; the condition is best-effort remediation data on top of
+// the events sent to the scheduled pods.
+func (rc *reconciler) updateMultiAttachBlockedCondition(logger klog.Logger, volumeToAttach cache.VolumeToAttach, message string) {
+	claimRef := claimForVolume(volumeToAttach)
+	if claimRef == nil || rc.kubeClient == nil {
+		return
+	}
+	pvc, err := rc.kubeClient.CoreV1().PersistentVolumeClaims(claimRef.Namespace).Get(context.TODO(), claimRef.Name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			logger.Error(err, "Failed to get PVC to report Multi-Attach error", "PVC", klog.KRef(claimRef.Namespace, claimRef.Name))
+		}
+		return
+	}
+	rc.multiAttachBlockedClaims[attachKey{volumeName: volumeToAttach.VolumeName, nodeName: volumeToAttach.NodeName}] = claimRef
+	condition := v1.PersistentVolumeClaimCondition{
+		Type:               PersistentVolumeClaimMultiAttachBlocked,
+		Status:             v1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             multiAttachBlockedReason,
+		Message:            message,
+	}
+	for i, existing := range pvc.Status.Conditions {
+		if existing.Type == PersistentVolumeClaimMultiAttachBlocked {
+			if existing.Status == condition.Status {
+				condition.LastTransitionTime = existing.LastTransitionTime
+			}
+			pvc.Status.Conditions[i] = condition
+			if _, err := rc.kubeClient.CoreV1().PersistentVolumeClaims(pvc.Namespace).UpdateStatus(context.TODO(), pvc, metav1.UpdateOptions{}); err != nil {
+				logger.Error(err, "Failed to update MultiAttachBlocked condition on PVC", "PVC", klog.KObj(pvc))
+			}
+			return
+		}
+	}
+	pvc.Status.Conditions = append(pvc.Status.Conditions, condition)
+	if _, err := rc.kubeClient.CoreV1().PersistentVolumeClaims(pvc.Namespace).UpdateStatus(context.TODO(), pvc, metav1.UpdateOptions{}); err != nil {
+		logger.Error(err, "Failed to update MultiAttachBlocked condition on PVC", "PVC", klog.KObj(pvc))
+	}
+}
+
+// clearMultiAttachBlockedCondition removes the MultiAttachBlocked condition
+// from the PVC backing the given volume, if it is present.
+func (rc *reconciler) clearMultiAttachBlockedCondition(logger klog.Logger, volumeToAttach cache.VolumeToAttach) {
+	claimRef := claimForVolume(volumeToAttach)
+	if claimRef == nil {
+		return
+	}
+	delete(rc.multiAttachBlockedClaims, attachKey{volumeName: volumeToAttach.VolumeName, nodeName: volumeToAttach.NodeName})
+	rc.clearMultiAttachBlockedConditionForClaim(logger, claimRef)
+}
+
+// clearMultiAttachBlockedConditionForClaim removes the MultiAttachBlocked
+// condition from the given claim, if it is present.
+func (rc *reconciler) clearMultiAttachBlockedConditionForClaim(logger klog.Logger, claimRef *v1.ObjectReference) {
+	if rc.kubeClient == nil {
+		return
+	}
+	pvc, err := rc.kubeClient.CoreV1().PersistentVolumeClaims(claimRef.Namespace).Get(context.TODO(), claimRef.Name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			logger.Error(err, "Failed to get PVC to clear Multi-Attach error", "PVC", klog.KRef(claimRef.Namespace, claimRef.Name))
+		}
+		return
+	}
+	conditions := make([]v1.PersistentVolumeClaimCondition, 0, len(pvc.Status.Conditions))
+	for _, existing := range pvc.Status.Conditions {
+		if existing.Type != PersistentVolumeClaimMultiAttachBlocked {
+			conditions = append(conditions, existing)
+		}
+	}
+	if len(conditions) == len(pvc.Status.Conditions) {
+		return
+	}
+	pvc.Status.Conditions = conditions
+	if _, err := rc.kubeClient.CoreV1().PersistentVolumeClaims(pvc.Namespace).UpdateStatus(context.TODO(), pvc, metav1.UpdateOptions{}); err != nil {
+		logger.Error(err, "Failed to clear MultiAttachBlocked condition on PVC", "PVC", klog.KObj(pvc))
+	}
+}
+
+// claimForVolume returns the ClaimRef of the PV backing the volume to attach,
+// or nil if the volume is not claim-based.
+func claimForVolume(volumeToAttach cache.VolumeToAttach) *v1.ObjectReference {
+	if volumeToAttach.VolumeSpec == nil || volumeToAttach.VolumeSpec.PersistentVolume == nil {
+		return nil
+	}
+	return volumeToAttach.VolumeSpec.PersistentVolume.Spec.ClaimRef
+}
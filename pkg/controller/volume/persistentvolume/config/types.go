@@ -41,6 +41,11 @@ type VolumeConfiguration struct {
 	// provisioning is not supported in any way, won't work in a multi-node cluster, and
 	// should not be used for anything other than testing or development.
 	EnableHostPathProvisioning bool
+	// hostPathProvisioningRoot is the directory under which HostPath PVs are
+	// dynamically provisioned when enableHostPathProvisioning is set. Volumes
+	// are created as per-PV subdirectories of this root and removed again on
+	// reclaim. Defaults to /tmp/hostpath_pv.
+	HostPathProvisioningRoot string
 	// enableDynamicProvisioning enables the provisioning of volumes when running within an environment
 	// that supports dynamic provisioning. Defaults to true.
 	EnableDynamicProvisioning bool
@@ -49,6 +54,10 @@ type VolumeConfiguration struct {
 	// volumePluginDir is the full path of the directory in which the flex
 	// volume plugin should search for additional third party volume plugins
 	FlexVolumePluginDir string
+	// retroactiveDefaultStorageClassExcludedNamespaces is a comma-separated
+	// list of namespaces whose claims are excluded from retroactive default
+	// storage class assignment.
+	RetroactiveDefaultStorageClassExcludedNamespaces string
 }
 
 // PersistentVolumeRecyclerConfiguration contains elements describing persistent volume plugins.
@@ -55,6 +55,9 @@ func RecommendedDefaultVolumeConfiguration(obj *kubectrlmgrconfigv1alpha1.Volume
 	if obj.EnableHostPathProvisioning == nil {
 		obj.EnableHostPathProvisioning = pointer.Bool(false)
 	}
+	if obj.HostPathProvisioningRoot == "" {
+		obj.HostPathProvisioningRoot = "/tmp/hostpath_pv"
+	}
 	if obj.EnableDynamicProvisioning == nil {
 		obj.EnableDynamicProvisioning = pointer.Bool(true)
 	}
@@ -153,6 +153,7 @@ func autoConvert_v1alpha1_VolumeConfiguration_To_config_VolumeConfiguration(in *
 	if err := v1.Convert_Pointer_bool_To_bool(&in.EnableHostPathProvisioning, &out.EnableHostPathProvisioning, s); err != nil {
 		return err
 	}
+	out.HostPathProvisioningRoot = in.HostPathProvisioningRoot
 	if err := v1.Convert_Pointer_bool_To_bool(&in.EnableDynamicProvisioning, &out.EnableDynamicProvisioning, s); err != nil {
 		return err
 	}
@@ -160,6 +161,7 @@ func autoConvert_v1alpha1_VolumeConfiguration_To_config_VolumeConfiguration(in *
 		return err
 	}
 	out.FlexVolumePluginDir = in.FlexVolumePluginDir
+	out.RetroactiveDefaultStorageClassExcludedNamespaces = in.RetroactiveDefaultStorageClassExcludedNamespaces
 	return nil
 }
 
@@ -172,6 +174,7 @@ func autoConvert_config_VolumeConfiguration_To_v1alpha1_VolumeConfiguration(in *
 	if err := v1.Convert_bool_To_Pointer_bool(&in.EnableHostPathProvisioning, &out.EnableHostPathProvisioning, s); err != nil {
 		return err
 	}
+	out.HostPathProvisioningRoot = in.HostPathProvisioningRoot
 	if err := v1.Convert_bool_To_Pointer_bool(&in.EnableDynamicProvisioning, &out.EnableDynamicProvisioning, s); err != nil {
 		return err
 	}
@@ -179,6 +182,7 @@ func autoConvert_config_VolumeConfiguration_To_v1alpha1_VolumeConfiguration(in *
 		return err
 	}
 	out.FlexVolumePluginDir = in.FlexVolumePluginDir
+	out.RetroactiveDefaultStorageClassExcludedNamespaces = in.RetroactiveDefaultStorageClassExcludedNamespaces
 	return nil
 }
 
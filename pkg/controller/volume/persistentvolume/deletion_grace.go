@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistentvolume
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/controller/volume/persistentvolume/metrics"
+)
+
+const (
+	// AnnDeletionGracePeriod is a storage class annotation holding the
+	// duration the controller waits between the release of a volume with
+	// reclaim policy Delete and the actual delete operation. During the
+	// window an admin can cancel the delete, e.g. after an accidental claim
+	// deletion. The value is parsed by time.ParseDuration, e.g. "15m".
+	AnnDeletionGracePeriod = "pv.kubernetes.io/deletion-grace-period"
+
+	// AnnDeletionDeadline is set by the controller on a released volume
+	// whose storage class requests a deletion grace period. It records when
+	// the grace period ends and the delete operation starts.
+	AnnDeletionDeadline = "pv.kubernetes.io/deletion-deadline"
+
+	// AnnCancelDeletion cancels a pending volume delete when an admin sets
+	// it to "true" on the volume. The controller then leaves the volume
+	// alone, like with reclaim policy Retain; removing the annotation makes
+	// the grace period start over.
+	AnnCancelDeletion = "pv.kubernetes.io/cancel-deletion"
+)
+
+// eventReasonVolumeDeletionDelayed and eventReasonVolumeDeletionCancelled are
+// the reasons of the events emitted when a volume delete enters the grace
+// period or is cancelled by an admin.
+const (
+	eventReasonVolumeDeletionDelayed   = "VolumeDeletionDelayed"
+	eventReasonVolumeDeletionCancelled = "VolumeDeletionCancelled"
+)
+
+// delayVolumeDeletion returns true if the delete of the given released volume
+// must not start yet: either its storage class requests a deletion grace
+// period that has not elapsed, or an admin cancelled the delete via
+// AnnCancelDeletion. The volume is re-evaluated by the periodic sync, so the
+// caller only needs to skip the delete operation for this sync.
+func (ctrl *PersistentVolumeController) delayVolumeDeletion(ctx context.Context, volume *v1.PersistentVolume) bool {
+	logger := klog.FromContext(ctx)
+
+	if volume.Annotations[AnnCancelDeletion] == "true" {
+		if _, pending := volume.Annotations[AnnDeletionDeadline]; pending {
+			// First sync after the admin cancelled the delete: drop the
+			// deadline so a later removal of the cancel annotation starts a
+			// fresh grace period.
+			volumeClone := volume.DeepCopy()
+			delete(volumeClone.Annotations, AnnDeletionDeadline)
+			if _, err := ctrl.kubeClient.CoreV1().PersistentVolumes().Update(ctx, volumeClone, metav1.UpdateOptions{}); err != nil {
+				logger.V(4).Info("Failed to clear deletion deadline of cancelled volume delete, will retry", "volumeName", volume.Name, "err", err)
+				return true
+			}
+			metrics.RecordVolumeDeletionCancelledMetric()
+			ctrl.eventRecorder.Event(volume, v1.EventTypeNormal, eventReasonVolumeDeletionCancelled, "Volume deletion was cancelled by an admin, the volume is kept")
+			logger.V(2).Info("Volume deletion cancelled by admin", "volumeName", volume.Name)
+		}
+		logger.V(4).Info("ReclaimVolume: delete is cancelled via annotation, nothing to do", "volumeName", volume.Name)
+		return true
+	}
+
+	gracePeriod := ctrl.deletionGracePeriod(logger, volume)
+	if gracePeriod <= 0 {
+		return false
+	}
+
+	deadlineStr, ok := volume.Annotations[AnnDeletionDeadline]
+	if !ok {
+		// First sync after the volume was released: stamp the deadline and
+		// tell the admin how to cancel.
+		deadline := time.Now().Add(gracePeriod)
+		volumeClone := volume.DeepCopy()
+		metav1.SetMetaDataAnnotation(&volumeClone.ObjectMeta, AnnDeletionDeadline, deadline.Format(time.RFC3339))
+		if _, err := ctrl.kubeClient.CoreV1().PersistentVolumes().Update(ctx, volumeClone, metav1.UpdateOptions{}); err != nil {
+			logger.V(4).Info("Failed to set deletion deadline on volume, will retry", "volumeName", volume.Name, "err", err)
+			return true
+		}
+		metrics.RecordVolumeDeletionDelayedMetric()
+		ctrl.eventRecorder.Event(volume, v1.EventTypeNormal, eventReasonVolumeDeletionDelayed,
+			fmt.Sprintf("Volume deletion is delayed until %s by the deletion grace period of its storage class; annotate the volume with %s=true to cancel", deadline.Format(time.RFC3339), AnnCancelDeletion))
+		logger.V(2).Info("Volume deletion delayed by storage class grace period", "volumeName", volume.Name, "deadline", deadline)
+		return true
+	}
+
+	deadline, err := time.Parse(time.RFC3339, deadlineStr)
+	if err != nil {
+		// An unparseable deadline would delay the delete forever; proceed
+		// instead.
+		logger.V(2).Info("Ignoring unparseable deletion deadline on volume", "volumeName", volume.Name, "deadline", deadlineStr, "err", err)
+		return false
+	}
+	if time.Now().Before(deadline) {
+		logger.V(4).Info("ReclaimVolume: delete is delayed by the deletion grace period", "volumeName", volume.Name, "deadline", deadline)
+		return true
+	}
+	return false
+}
+
+// deletionGracePeriod returns the deletion grace period requested by the
+// storage class of the volume via AnnDeletionGracePeriod, or zero if the
+// class does not exist or requests none.
+func (ctrl *PersistentVolumeController) deletionGracePeriod(logger klog.Logger, volume *v1.PersistentVolume) time.Duration {
+	className := volume.Spec.StorageClassName
+	if className == "" {
+		return 0
+	}
+	class, err := ctrl.classLister.Get(className)
+	if err != nil {
+		return 0
+	}
+	value, ok := class.Annotations[AnnDeletionGracePeriod]
+	if !ok {
+		return 0
+	}
+	gracePeriod, err := time.ParseDuration(value)
+	if err != nil || gracePeriod < 0 {
+		logger.V(2).Info("Ignoring invalid deletion grace period on storage class", "storageClassName", className, "value", value, "err", err)
+		return 0
+	}
+	return gracePeriod
+}
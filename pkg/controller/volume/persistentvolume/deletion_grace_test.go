@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistentvolume
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	storagelisters "k8s.io/client-go/listers/storage/v1"
+	clienttesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2/ktesting"
+)
+
+// Tests the deletion grace period evaluation of released volumes whose
+// storage class requests one via the AnnDeletionGracePeriod annotation.
+func TestDelayVolumeDeletion(t *testing.T) {
+	gracedVolume := func(annotations map[string]string) *v1.PersistentVolume {
+		volume := newVolumeArray("volume20-1", "1Gi", "uid20-1", "claim20-1", v1.VolumeReleased, v1.PersistentVolumeReclaimDelete, classGold)[0]
+		for key, value := range annotations {
+			metav1.SetMetaDataAnnotation(&volume.ObjectMeta, key, value)
+		}
+		return volume
+	}
+	futureDeadline := time.Now().Add(time.Hour).Format(time.RFC3339)
+	pastDeadline := time.Now().Add(-time.Hour).Format(time.RFC3339)
+
+	tests := []struct {
+		name            string
+		classAnnotation string
+		volume          *v1.PersistentVolume
+		expectDelayed   bool
+		expectUpdate    bool
+	}{
+		{
+			name:          "class without grace period does not delay",
+			volume:        gracedVolume(nil),
+			expectDelayed: false,
+		},
+		{
+			name:            "invalid grace period does not delay",
+			classAnnotation: "soon",
+			volume:          gracedVolume(nil),
+			expectDelayed:   false,
+		},
+		{
+			name:            "first sync stamps the deadline",
+			classAnnotation: "1h",
+			volume:          gracedVolume(nil),
+			expectDelayed:   true,
+			expectUpdate:    true,
+		},
+		{
+			name:            "pending deadline delays without further updates",
+			classAnnotation: "1h",
+			volume:          gracedVolume(map[string]string{AnnDeletionDeadline: futureDeadline}),
+			expectDelayed:   true,
+		},
+		{
+			name:            "expired deadline lets the delete proceed",
+			classAnnotation: "1h",
+			volume:          gracedVolume(map[string]string{AnnDeletionDeadline: pastDeadline}),
+			expectDelayed:   false,
+		},
+		{
+			name:            "cancellation clears the deadline and keeps the volume",
+			classAnnotation: "1h",
+			volume:          gracedVolume(map[string]string{AnnDeletionDeadline: futureDeadline, AnnCancelDeletion: "true"}),
+			expectDelayed:   true,
+			expectUpdate:    true,
+		},
+		{
+			name:            "cancelled volume stays cancelled",
+			classAnnotation: "1h",
+			volume:          gracedVolume(map[string]string{AnnCancelDeletion: "true"}),
+			expectDelayed:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			client := &fake.Clientset{}
+			ctrl, err := newTestController(ctx, client, nil, true)
+			if err != nil {
+				t.Fatalf("construct persistent volume controller failed: %v", err)
+			}
+			class := makeStorageClass(classGold, &modeImmediate)
+			if test.classAnnotation != "" {
+				metav1.SetMetaDataAnnotation(&class.ObjectMeta, AnnDeletionGracePeriod, test.classAnnotation)
+			}
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			indexer.Add(class)
+			ctrl.classLister = storagelisters.NewStorageClassLister(indexer)
+
+			delayed := ctrl.delayVolumeDeletion(ctx, test.volume)
+			if delayed != test.expectDelayed {
+				t.Errorf("expected delayed=%v, got %v", test.expectDelayed, delayed)
+			}
+
+			var updated *v1.PersistentVolume
+			for _, action := range client.Actions() {
+				if action.Matches("update", "persistentvolumes") {
+					updated = action.(clienttesting.UpdateAction).GetObject().(*v1.PersistentVolume)
+				}
+			}
+			if test.expectUpdate && updated == nil {
+				t.Fatalf("expected the volume to be updated")
+			}
+			if !test.expectUpdate && updated != nil {
+				t.Fatalf("expected no volume update, got %+v", updated)
+			}
+			if updated != nil {
+				_, hasDeadline := updated.Annotations[AnnDeletionDeadline]
+				cancelled := updated.Annotations[AnnCancelDeletion] == "true"
+				if cancelled && hasDeadline {
+					t.Errorf("expected the deadline to be cleared on cancellation, got %v", updated.Annotations)
+				}
+				if !cancelled && !hasDeadline {
+					t.Errorf("expected a deadline to be stamped, got %v", updated.Annotations)
+				}
+			}
+		})
+	}
+}
@@ -70,6 +70,10 @@ func Register(pvLister PVLister, pvcLister PVCLister, pluginMgr *volume.VolumePl
 		legacyregistry.MustRegister(volumeOperationErrorsMetric)
 		legacyregistry.MustRegister(retroactiveStorageClassMetric)
 		legacyregistry.MustRegister(retroactiveStorageClassErrorMetric)
+		legacyregistry.MustRegister(volumeDeletionDelayedMetric)
+		legacyregistry.MustRegister(volumeDeletionCancelledMetric)
+		legacyregistry.MustRegister(releasedVolumeCleanupMetric)
+		legacyregistry.MustRegister(orphanedVolumesMetric)
 	})
 }
 
@@ -154,6 +158,36 @@ var (
 			Help:           "Total number of failed retroactive StorageClass assignments to persistent volume claim",
 			StabilityLevel: metrics.ALPHA,
 		})
+
+	volumeDeletionDelayedMetric = metrics.NewCounter(
+		&metrics.CounterOpts{
+			Name:           "volume_deletion_delayed_total",
+			Help:           "Total number of persistent volume deletions delayed by the deletion grace period of the storage class",
+			StabilityLevel: metrics.ALPHA,
+		})
+
+	volumeDeletionCancelledMetric = metrics.NewCounter(
+		&metrics.CounterOpts{
+			Name:           "volume_deletion_cancelled_total",
+			Help:           "Total number of pending persistent volume deletions cancelled by an admin during the deletion grace period",
+			StabilityLevel: metrics.ALPHA,
+		})
+
+	releasedVolumeCleanupMetric = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Name:           "released_volume_cleanup_total",
+			Help:           "Total number of persistent volumes handled by the released-volume cleanup policy of their storage class, partitioned by action",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"action"})
+
+	orphanedVolumesMetric = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Name:           "orphaned_volumes",
+			Help:           "Number of Released or Failed persistent volumes that are stuck without progress towards reclamation, partitioned by storage class and failure classification",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{storageClassLabel, "reason"})
 )
 
 // volumeCount counts by PluginName and VolumeMode.
@@ -275,6 +309,40 @@ func RecordRetroactiveStorageClassMetric(success bool) {
 	}
 }
 
+// RecordVolumeDeletionDelayedMetric increments the count of volume deletions
+// delayed by the deletion grace period of the storage class.
+func RecordVolumeDeletionDelayedMetric() {
+	volumeDeletionDelayedMetric.Inc()
+}
+
+// RecordVolumeDeletionCancelledMetric increments the count of pending volume
+// deletions cancelled by an admin.
+func RecordVolumeDeletionCancelledMetric() {
+	volumeDeletionCancelledMetric.Inc()
+}
+
+// RecordReleasedVolumeCleanupMetric increments the count of volumes handled
+// by the released-volume cleanup with the given action.
+func RecordReleasedVolumeCleanupMetric(action string) {
+	releasedVolumeCleanupMetric.WithLabelValues(action).Inc()
+}
+
+// OrphanedVolume identifies a bucket of orphaned volumes sharing a storage
+// class and failure classification.
+type OrphanedVolume struct {
+	StorageClassName string
+	Reason           string
+}
+
+// UpdateOrphanedVolumesMetric replaces the orphaned-volume gauge with the
+// given per-class, per-reason counts.
+func UpdateOrphanedVolumesMetric(orphaned map[OrphanedVolume]int) {
+	orphanedVolumesMetric.Reset()
+	for key, count := range orphaned {
+		orphanedVolumesMetric.WithLabelValues(key.StorageClassName, key.Reason).Set(float64(count))
+	}
+}
+
 // RecordVolumeOperationErrorMetric records error count into metric
 // volume_operation_total_errors for provisioning/deletion operations
 func RecordVolumeOperationErrorMetric(pluginName, opName string) {
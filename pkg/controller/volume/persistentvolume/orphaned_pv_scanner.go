@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistentvolume
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	storagehelpers "k8s.io/component-helpers/storage/volume"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/controller/volume/persistentvolume/metrics"
+	vol "k8s.io/kubernetes/pkg/volume"
+)
+
+const (
+	// orphanedPVScanInterval is how often the controller scans for orphaned
+	// volumes, i.e. Released or Failed volumes that make no progress towards
+	// reclamation.
+	orphanedPVScanInterval = 5 * time.Minute
+
+	// orphanedPVThreshold is how long a volume must have been Released or
+	// Failed before the scanner reports it. Volumes younger than this are
+	// usually still being reclaimed normally.
+	orphanedPVThreshold = 10 * time.Minute
+
+	// eventReasonOrphanedVolume is the reason of the warning event emitted
+	// for orphaned volumes.
+	eventReasonOrphanedVolume = "OrphanedVolume"
+)
+
+// Classifications of why a Released volume is stuck, used as the reason label
+// of the orphaned-volume gauge.
+const (
+	orphanReasonMissingProvisioner = "MissingExternalProvisioner"
+	orphanReasonFailedReclaim      = "FailedReclaim"
+	orphanReasonStuckReclaim       = "StuckReclaim"
+)
+
+// scanOrphanedVolumes identifies Released and Failed volumes that are stuck,
+// e.g. because the external provisioner responsible for deleting them is not
+// running or their delete keeps failing, classifies the failure, emits
+// warning events with remediation hints and republishes the orphaned-volume
+// gauge per storage class.
+func (ctrl *PersistentVolumeController) scanOrphanedVolumes(ctx context.Context) {
+	logger := klog.FromContext(ctx)
+	volumes, err := ctrl.volumeLister.List(labels.Everything())
+	if err != nil {
+		logger.Error(err, "Orphaned volume scan failed to list volumes")
+		return
+	}
+
+	orphaned := make(map[metrics.OrphanedVolume]int)
+	for _, volume := range volumes {
+		reason, hint := ctrl.classifyOrphanedVolume(volume)
+		if reason == "" {
+			continue
+		}
+		orphaned[metrics.OrphanedVolume{StorageClassName: storagehelpers.GetPersistentVolumeClass(volume), Reason: reason}]++
+		ctrl.eventRecorder.Event(volume, v1.EventTypeWarning, eventReasonOrphanedVolume, hint)
+		logger.V(4).Info("Found orphaned volume", "volumeName", volume.Name, "reason", reason)
+	}
+	metrics.UpdateOrphanedVolumesMetric(orphaned)
+}
+
+// classifyOrphanedVolume returns the orphan classification of the volume and
+// a remediation hint, or an empty classification if the volume is not
+// orphaned.
+func (ctrl *PersistentVolumeController) classifyOrphanedVolume(volume *v1.PersistentVolume) (string, string) {
+	switch volume.Status.Phase {
+	case v1.VolumeReleased, v1.VolumeFailed:
+	default:
+		return "", ""
+	}
+	if volume.Status.LastPhaseTransitionTime != nil && time.Since(volume.Status.LastPhaseTransitionTime.Time) < orphanedPVThreshold {
+		return "", ""
+	}
+	if volume.Spec.PersistentVolumeReclaimPolicy != v1.PersistentVolumeReclaimDelete {
+		// Released volumes with policy Retain are kept deliberately.
+		return "", ""
+	}
+	if _, pending := volume.Annotations[AnnDeletionDeadline]; pending {
+		// The delete is delayed by the deletion grace period, not stuck.
+		return "", ""
+	}
+
+	if volume.Status.Phase == v1.VolumeFailed {
+		return orphanReasonFailedReclaim, fmt.Sprintf(
+			"Volume reclamation failed: %s. Fix the reported problem, or delete the volume object and clean up its underlying storage manually.",
+			volume.Status.Message)
+	}
+
+	// A dynamically provisioned volume without a matching in-tree deleter
+	// waits for its external provisioner. If it waits too long, the
+	// provisioner is most likely not running.
+	if metav1.HasAnnotation(volume.ObjectMeta, storagehelpers.AnnDynamicallyProvisioned) {
+		provisioner := volume.Annotations[storagehelpers.AnnDynamicallyProvisioned]
+		if _, err := ctrl.volumePluginMgr.FindDeletablePluginByName(provisioner); err != nil {
+			if spec := vol.NewSpecFromPersistentVolume(volume, false); spec != nil {
+				if _, err := ctrl.volumePluginMgr.FindDeletablePluginBySpec(spec); err == nil {
+					// An in-tree plugin can delete the volume after all.
+					return orphanReasonStuckReclaim, stuckReclaimHint()
+				}
+			}
+			return orphanReasonMissingProvisioner, fmt.Sprintf(
+				"Volume has been Released for more than %v and is waiting for provisioner %q to delete it. Verify the provisioner is deployed and healthy.",
+				orphanedPVThreshold, provisioner)
+		}
+	}
+	return orphanReasonStuckReclaim, stuckReclaimHint()
+}
+
+func stuckReclaimHint() string {
+	return fmt.Sprintf(
+		"Volume has been Released for more than %v without being deleted. Check the controller logs for delete errors, or delete the volume object and clean up its underlying storage manually.",
+		orphanedPVThreshold)
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistentvolume
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	storagehelpers "k8s.io/component-helpers/storage/volume"
+	"k8s.io/klog/v2/ktesting"
+)
+
+func TestClassifyOrphanedVolume(t *testing.T) {
+	oldTransition := metav1.NewTime(time.Now().Add(-time.Hour))
+	recentTransition := metav1.NewTime(time.Now())
+
+	makeVolume := func(phase v1.PersistentVolumePhase, reclaimPolicy v1.PersistentVolumeReclaimPolicy, transition metav1.Time, annotations map[string]string) *v1.PersistentVolume {
+		volume := newVolumeArray("volume21-1", "1Gi", "uid21-1", "claim21-1", phase, reclaimPolicy, classEmpty)[0]
+		volume.Status.LastPhaseTransitionTime = &transition
+		for key, value := range annotations {
+			metav1.SetMetaDataAnnotation(&volume.ObjectMeta, key, value)
+		}
+		return volume
+	}
+
+	tests := []struct {
+		name           string
+		volume         *v1.PersistentVolume
+		expectedReason string
+		expectedHint   string
+	}{
+		{
+			name:   "bound volume is not orphaned",
+			volume: makeVolume(v1.VolumeBound, v1.PersistentVolumeReclaimDelete, oldTransition, nil),
+		},
+		{
+			name:   "retained volume is kept deliberately",
+			volume: makeVolume(v1.VolumeReleased, v1.PersistentVolumeReclaimRetain, oldTransition, nil),
+		},
+		{
+			name:   "recently released volume is still being reclaimed",
+			volume: makeVolume(v1.VolumeReleased, v1.PersistentVolumeReclaimDelete, recentTransition, nil),
+		},
+		{
+			name: "volume within the deletion grace period is not orphaned",
+			volume: makeVolume(v1.VolumeReleased, v1.PersistentVolumeReclaimDelete, oldTransition, map[string]string{
+				AnnDeletionDeadline: time.Now().Add(time.Hour).Format(time.RFC3339),
+			}),
+		},
+		{
+			name:           "failed reclaim is classified with the failure message",
+			volume:         makeVolume(v1.VolumeFailed, v1.PersistentVolumeReclaimDelete, oldTransition, nil),
+			expectedReason: orphanReasonFailedReclaim,
+			expectedHint:   "Volume reclamation failed",
+		},
+		{
+			name: "missing external provisioner",
+			volume: makeVolume(v1.VolumeReleased, v1.PersistentVolumeReclaimDelete, oldTransition, map[string]string{
+				storagehelpers.AnnDynamicallyProvisioned: "ebs.csi.aws.com",
+			}),
+			expectedReason: orphanReasonMissingProvisioner,
+			expectedHint:   "ebs.csi.aws.com",
+		},
+		{
+			name:           "stuck reclaim without provisioner annotation",
+			volume:         makeVolume(v1.VolumeReleased, v1.PersistentVolumeReclaimDelete, oldTransition, nil),
+			expectedReason: orphanReasonStuckReclaim,
+			expectedHint:   "without being deleted",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			ctrl, err := newTestController(ctx, &fake.Clientset{}, nil, true)
+			if err != nil {
+				t.Fatalf("construct persistent volume controller failed: %v", err)
+			}
+			reason, hint := ctrl.classifyOrphanedVolume(test.volume)
+			if reason != test.expectedReason {
+				t.Errorf("expected reason %q, got %q", test.expectedReason, reason)
+			}
+			if !strings.Contains(hint, test.expectedHint) {
+				t.Errorf("expected hint containing %q, got %q", test.expectedHint, hint)
+			}
+		})
+	}
+}
@@ -226,6 +226,11 @@ type PersistentVolumeController struct {
 
 	translator               CSINameTranslator
 	csiMigratedPluginManager CSIMigratedPluginManager
+
+	// retroactiveClassExcludedNamespaces are namespaces whose claims are
+	// never assigned the default storage class retroactively, see
+	// ControllerParameters.RetroactiveClassExcludedNamespaces.
+	retroactiveClassExcludedNamespaces sets.Set[string]
 }
 
 // syncClaim is the main controller method to decide what to do with a claim.
@@ -961,6 +966,22 @@ func (ctrl *PersistentVolumeController) updateVolumePhaseWithEvent(ctx context.C
 	return newVol, nil
 }
 
+// parseRetroactiveClassExcludedNamespaces parses a comma-separated list of
+// namespaces excluded from retroactive default storage class assignment.
+// When the cluster default storage class changes, pending claims without a
+// class are normally assigned the new default; claims in the listed
+// namespaces keep their empty class, e.g. because they wait for a specific
+// provisioner to be installed.
+func parseRetroactiveClassExcludedNamespaces(value string) sets.Set[string] {
+	excluded := sets.New[string]()
+	for _, namespace := range strings.Split(value, ",") {
+		if namespace = strings.TrimSpace(namespace); namespace != "" {
+			excluded.Insert(namespace)
+		}
+	}
+	return excluded
+}
+
 // assignDefaultStorageClass updates the claim storage class if there is any, the claim is updated to the API server.
 // Ignores claims that already have a storage class.
 // TODO: if resync is ever changed to a larger period, we might need to change how we set the default class on existing unbound claims
@@ -972,6 +993,11 @@ func (ctrl *PersistentVolumeController) assignDefaultStorageClass(ctx context.Co
 		return false, nil
 	}
 
+	if ctrl.retroactiveClassExcludedNamespaces.Has(claim.Namespace) {
+		logger.V(4).Info("Not assigning storage class to PersistentVolumeClaim: namespace is excluded from retroactive assignment", "PVC", klog.KObj(claim))
+		return false, nil
+	}
+
 	class, err := util.GetDefaultClass(ctrl.classLister)
 	if err != nil {
 		return false, err
@@ -1198,6 +1224,12 @@ func (ctrl *PersistentVolumeController) reclaimVolume(ctx context.Context, volum
 
 	case v1.PersistentVolumeReclaimDelete:
 		logger.V(4).Info("ReclaimVolume: policy is Delete", "volumeName", volume.Name)
+		if ctrl.delayVolumeDeletion(ctx, volume) {
+			// The storage class requests a deletion grace period that has
+			// not elapsed yet, or an admin cancelled the delete. The
+			// periodic sync re-evaluates the volume.
+			return nil
+		}
 		opName := fmt.Sprintf("delete-%s[%s]", volume.Name, string(volume.UID))
 		// create a start timestamp entry in cache for deletion operation if no one exists with
 		// key = volume.Name, pluginName = provisionerName, operation = "delete"
@@ -70,6 +70,10 @@ type ControllerParameters struct {
 	PodInformer               coreinformers.PodInformer
 	NodeInformer              coreinformers.NodeInformer
 	EnableDynamicProvisioning bool
+	// RetroactiveClassExcludedNamespaces is a comma-separated list of
+	// namespaces whose claims are excluded from retroactive default storage
+	// class assignment.
+	RetroactiveClassExcludedNamespaces string
 }
 
 // NewController creates a new PersistentVolume controller
@@ -91,6 +95,8 @@ func NewController(ctx context.Context, p ControllerParameters) (*PersistentVolu
 		volumeQueue:                   workqueue.NewTypedWithConfig(workqueue.TypedQueueConfig[string]{Name: "volumes"}),
 		resyncPeriod:                  p.SyncPeriod,
 		operationTimestamps:           metrics.NewOperationStartTimeCache(),
+
+		retroactiveClassExcludedNamespaces: parseRetroactiveClassExcludedNamespaces(p.RetroactiveClassExcludedNamespaces),
 	}
 
 	// Prober is nil because PV is not aware of Flexvolume.
@@ -317,6 +323,8 @@ func (ctrl *PersistentVolumeController) Run(ctx context.Context) {
 	go wait.Until(func() { ctrl.resync(ctx) }, ctrl.resyncPeriod, ctx.Done())
 	go wait.UntilWithContext(ctx, ctrl.volumeWorker, time.Second)
 	go wait.UntilWithContext(ctx, ctrl.claimWorker, time.Second)
+	go wait.UntilWithContext(ctx, ctrl.scanOrphanedVolumes, orphanedPVScanInterval)
+	go wait.UntilWithContext(ctx, ctrl.cleanupReleasedVolumes, releasedPVCleanupInterval)
 
 	metrics.Register(ctrl.volumes.store, ctrl.claims, &ctrl.volumePluginMgr)
 
@@ -886,3 +886,26 @@ func TestRetroactiveStorageClassAssignment(t *testing.T) {
 		runSyncTests(t, ctx, test.tests, test.storageClasses, nil)
 	}
 }
+
+func TestRetroactiveStorageClassAssignmentExcludedNamespaces(t *testing.T) {
+	storageClasses := []*storagev1.StorageClass{
+		makeDefaultStorageClass(classGold, &modeImmediate),
+	}
+	tests := []controllerTest{
+		{
+			name:            "15-8 - pvc storage class is not assigned retroactively in an excluded namespace",
+			initialVolumes:  novolumes,
+			expectedVolumes: novolumes,
+			initialClaims:   newClaimArray("claim15-8", "uid15-8", "1Gi", "", v1.ClaimPending, nil),
+			expectedClaims:  newClaimArray("claim15-8", "uid15-8", "1Gi", "", v1.ClaimPending, nil),
+			expectedEvents:  noevents,
+			errors:          noerrors,
+			test: func(ctrl *PersistentVolumeController, reactor *pvtesting.VolumeReactor, test controllerTest) error {
+				ctrl.retroactiveClassExcludedNamespaces = parseRetroactiveClassExcludedNamespaces("kube-system, " + testNamespace)
+				return testSyncClaim(ctrl, reactor, test)
+			},
+		},
+	}
+	_, ctx := ktesting.NewTestContext(t)
+	runSyncTests(t, ctx, tests, storageClasses, nil)
+}
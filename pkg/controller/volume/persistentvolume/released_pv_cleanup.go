@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistentvolume
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/controller/volume/persistentvolume/metrics"
+)
+
+const (
+	// AnnReleasedCleanupAfter is a storage class annotation holding the
+	// duration after which the controller cleans up volumes of the class
+	// that are stuck in phase Released, measured from the last phase
+	// transition of the volume. The value is parsed by time.ParseDuration,
+	// e.g. "24h". Without the annotation no cleanup happens.
+	AnnReleasedCleanupAfter = "pv.kubernetes.io/released-cleanup-after"
+
+	// AnnReleasedCleanupAction is a storage class annotation selecting what
+	// the cleanup does with a stuck Released volume: "delete" removes the
+	// volume object, "archive" switches its reclaim policy to Retain and
+	// stamps AnnArchivedAt so an admin can inspect it later. The default is
+	// "delete".
+	AnnReleasedCleanupAction = "pv.kubernetes.io/released-cleanup-action"
+
+	// AnnReleasedCleanupDryRun is a storage class annotation that, when set
+	// to "true", makes the cleanup only emit events and log what it would
+	// do without modifying any volume.
+	AnnReleasedCleanupDryRun = "pv.kubernetes.io/released-cleanup-dry-run"
+
+	// AnnArchivedAt is set by the controller on a volume archived by the
+	// released-volume cleanup. It records when the volume was archived and
+	// excludes the volume from further cleanup scans.
+	AnnArchivedAt = "pv.kubernetes.io/archived-at"
+)
+
+const (
+	// releasedPVCleanupInterval is how often the controller evaluates the
+	// released-volume cleanup policies of storage classes.
+	releasedPVCleanupInterval = 5 * time.Minute
+
+	// Cleanup actions accepted by AnnReleasedCleanupAction and used as the
+	// action label of the cleanup counter. cleanupActionDryRun is reported
+	// for volumes that would have been cleaned up in dry-run mode.
+	cleanupActionDelete  = "delete"
+	cleanupActionArchive = "archive"
+	cleanupActionDryRun  = "dry-run"
+
+	// Reasons of the events emitted for volumes handled by the
+	// released-volume cleanup.
+	eventReasonReleasedVolumeDeleted  = "ReleasedVolumeDeleted"
+	eventReasonReleasedVolumeArchived = "ReleasedVolumeArchived"
+	eventReasonReleasedVolumeCleanup  = "ReleasedVolumeCleanup"
+)
+
+// cleanupReleasedVolumes deletes or archives volumes that have been stuck in
+// phase Released longer than the cleanup duration requested by their storage
+// class via AnnReleasedCleanupAfter. Volumes of classes without the
+// annotation are left alone.
+func (ctrl *PersistentVolumeController) cleanupReleasedVolumes(ctx context.Context) {
+	logger := klog.FromContext(ctx)
+	volumes, err := ctrl.volumeLister.List(labels.Everything())
+	if err != nil {
+		logger.Error(err, "Released volume cleanup failed to list volumes")
+		return
+	}
+
+	for _, volume := range volumes {
+		action, ok := ctrl.releasedCleanupAction(logger, volume)
+		if !ok {
+			continue
+		}
+		if err := ctrl.cleanupReleasedVolume(ctx, volume, action); err != nil {
+			logger.V(2).Info("Released volume cleanup failed, will retry", "volumeName", volume.Name, "action", action, "err", err)
+			continue
+		}
+		metrics.RecordReleasedVolumeCleanupMetric(action)
+	}
+}
+
+// releasedCleanupAction returns the cleanup action to take on the given
+// volume, or false if the volume must be left alone: it is not stuck in
+// Released, its storage class requests no cleanup, or the cleanup duration
+// has not elapsed yet.
+func (ctrl *PersistentVolumeController) releasedCleanupAction(logger klog.Logger, volume *v1.PersistentVolume) (string, bool) {
+	if volume.Status.Phase != v1.VolumeReleased {
+		return "", false
+	}
+	if _, archived := volume.Annotations[AnnArchivedAt]; archived {
+		return "", false
+	}
+	if _, pending := volume.Annotations[AnnDeletionDeadline]; pending {
+		// The volume is inside the deletion grace period of its class; the
+		// regular reclaim deletes it once the deadline passes.
+		return "", false
+	}
+
+	className := volume.Spec.StorageClassName
+	if className == "" {
+		return "", false
+	}
+	class, err := ctrl.classLister.Get(className)
+	if err != nil {
+		return "", false
+	}
+	value, ok := class.Annotations[AnnReleasedCleanupAfter]
+	if !ok {
+		return "", false
+	}
+	cleanupAfter, err := time.ParseDuration(value)
+	if err != nil || cleanupAfter < 0 {
+		logger.V(2).Info("Ignoring invalid released-volume cleanup duration on storage class", "storageClassName", className, "value", value, "err", err)
+		return "", false
+	}
+	transition := volume.Status.LastPhaseTransitionTime
+	if transition == nil || time.Since(transition.Time) < cleanupAfter {
+		return "", false
+	}
+
+	action := class.Annotations[AnnReleasedCleanupAction]
+	if action == "" {
+		action = cleanupActionDelete
+	}
+	if action != cleanupActionDelete && action != cleanupActionArchive {
+		logger.V(2).Info("Ignoring invalid released-volume cleanup action on storage class", "storageClassName", className, "action", action)
+		return "", false
+	}
+	if class.Annotations[AnnReleasedCleanupDryRun] == "true" {
+		ctrl.eventRecorder.Event(volume, v1.EventTypeNormal, eventReasonReleasedVolumeCleanup,
+			fmt.Sprintf("Volume has been Released for more than %v and would be cleaned up with action %q; the storage class runs the cleanup in dry-run mode", cleanupAfter, action))
+		logger.V(2).Info("Released volume cleanup in dry-run mode, leaving volume alone", "volumeName", volume.Name, "action", action)
+		return cleanupActionDryRun, true
+	}
+	return action, true
+}
+
+// cleanupReleasedVolume applies the given cleanup action to the volume.
+// Dry-run is a no-op here; the event was already emitted during
+// classification.
+func (ctrl *PersistentVolumeController) cleanupReleasedVolume(ctx context.Context, volume *v1.PersistentVolume, action string) error {
+	logger := klog.FromContext(ctx)
+	switch action {
+	case cleanupActionDelete:
+		if err := ctrl.kubeClient.CoreV1().PersistentVolumes().Delete(ctx, volume.Name, metav1.DeleteOptions{}); err != nil {
+			return err
+		}
+		ctrl.eventRecorder.Event(volume, v1.EventTypeNormal, eventReasonReleasedVolumeDeleted,
+			"Volume was deleted by the released-volume cleanup policy of its storage class")
+		logger.V(2).Info("Deleted released volume by storage class cleanup policy", "volumeName", volume.Name)
+	case cleanupActionArchive:
+		volumeClone := volume.DeepCopy()
+		volumeClone.Spec.PersistentVolumeReclaimPolicy = v1.PersistentVolumeReclaimRetain
+		metav1.SetMetaDataAnnotation(&volumeClone.ObjectMeta, AnnArchivedAt, time.Now().Format(time.RFC3339))
+		if _, err := ctrl.kubeClient.CoreV1().PersistentVolumes().Update(ctx, volumeClone, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+		ctrl.eventRecorder.Event(volume, v1.EventTypeNormal, eventReasonReleasedVolumeArchived,
+			"Volume was archived by the released-volume cleanup policy of its storage class; its reclaim policy is now Retain")
+		logger.V(2).Info("Archived released volume by storage class cleanup policy", "volumeName", volume.Name)
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package persistentvolume
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	storagelisters "k8s.io/client-go/listers/storage/v1"
+	clienttesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2/ktesting"
+)
+
+// Tests the released-volume cleanup policy that storage classes request via
+// the AnnReleasedCleanupAfter annotation.
+func TestCleanupReleasedVolumes(t *testing.T) {
+	oldTransition := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+	recentTransition := metav1.NewTime(time.Now())
+
+	makeVolume := func(phase v1.PersistentVolumePhase, transition metav1.Time, annotations map[string]string) *v1.PersistentVolume {
+		volume := newVolumeArray("volume22-1", "1Gi", "uid22-1", "claim22-1", phase, v1.PersistentVolumeReclaimDelete, classGold)[0]
+		volume.Status.LastPhaseTransitionTime = &transition
+		for key, value := range annotations {
+			metav1.SetMetaDataAnnotation(&volume.ObjectMeta, key, value)
+		}
+		return volume
+	}
+
+	tests := []struct {
+		name             string
+		classAnnotations map[string]string
+		volume           *v1.PersistentVolume
+		expectDelete     bool
+		expectArchive    bool
+	}{
+		{
+			name:   "class without cleanup policy leaves the volume alone",
+			volume: makeVolume(v1.VolumeReleased, oldTransition, nil),
+		},
+		{
+			name:             "invalid cleanup duration leaves the volume alone",
+			classAnnotations: map[string]string{AnnReleasedCleanupAfter: "soon"},
+			volume:           makeVolume(v1.VolumeReleased, oldTransition, nil),
+		},
+		{
+			name:             "bound volume is not cleaned up",
+			classAnnotations: map[string]string{AnnReleasedCleanupAfter: "1h"},
+			volume:           makeVolume(v1.VolumeBound, oldTransition, nil),
+		},
+		{
+			name:             "recently released volume is not cleaned up yet",
+			classAnnotations: map[string]string{AnnReleasedCleanupAfter: "1h"},
+			volume:           makeVolume(v1.VolumeReleased, recentTransition, nil),
+		},
+		{
+			name:             "volume within the deletion grace period is not cleaned up",
+			classAnnotations: map[string]string{AnnReleasedCleanupAfter: "1h"},
+			volume: makeVolume(v1.VolumeReleased, oldTransition, map[string]string{
+				AnnDeletionDeadline: time.Now().Add(time.Hour).Format(time.RFC3339),
+			}),
+		},
+		{
+			name:             "expired volume is deleted by default",
+			classAnnotations: map[string]string{AnnReleasedCleanupAfter: "1h"},
+			volume:           makeVolume(v1.VolumeReleased, oldTransition, nil),
+			expectDelete:     true,
+		},
+		{
+			name: "archive action retains and annotates the volume",
+			classAnnotations: map[string]string{
+				AnnReleasedCleanupAfter:  "1h",
+				AnnReleasedCleanupAction: "archive",
+			},
+			volume:        makeVolume(v1.VolumeReleased, oldTransition, nil),
+			expectArchive: true,
+		},
+		{
+			name: "invalid action leaves the volume alone",
+			classAnnotations: map[string]string{
+				AnnReleasedCleanupAfter:  "1h",
+				AnnReleasedCleanupAction: "shred",
+			},
+			volume: makeVolume(v1.VolumeReleased, oldTransition, nil),
+		},
+		{
+			name: "dry-run mode does not modify the volume",
+			classAnnotations: map[string]string{
+				AnnReleasedCleanupAfter:  "1h",
+				AnnReleasedCleanupDryRun: "true",
+			},
+			volume: makeVolume(v1.VolumeReleased, oldTransition, nil),
+		},
+		{
+			name:             "archived volume is not cleaned up again",
+			classAnnotations: map[string]string{AnnReleasedCleanupAfter: "1h"},
+			volume: makeVolume(v1.VolumeReleased, oldTransition, map[string]string{
+				AnnArchivedAt: time.Now().Add(-time.Hour).Format(time.RFC3339),
+			}),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, ctx := ktesting.NewTestContext(t)
+			client := &fake.Clientset{}
+			ctrl, err := newTestController(ctx, client, nil, true)
+			if err != nil {
+				t.Fatalf("construct persistent volume controller failed: %v", err)
+			}
+			class := makeStorageClass(classGold, &modeImmediate)
+			for key, value := range test.classAnnotations {
+				metav1.SetMetaDataAnnotation(&class.ObjectMeta, key, value)
+			}
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			indexer.Add(class)
+			ctrl.classLister = storagelisters.NewStorageClassLister(indexer)
+			volumeIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			volumeIndexer.Add(test.volume)
+			ctrl.volumeLister = corelisters.NewPersistentVolumeLister(volumeIndexer)
+
+			ctrl.cleanupReleasedVolumes(ctx)
+
+			var deleted bool
+			var updated *v1.PersistentVolume
+			for _, action := range client.Actions() {
+				switch {
+				case action.Matches("delete", "persistentvolumes"):
+					deleted = true
+				case action.Matches("update", "persistentvolumes"):
+					updated = action.(clienttesting.UpdateAction).GetObject().(*v1.PersistentVolume)
+				}
+			}
+			if deleted != test.expectDelete {
+				t.Errorf("expected delete=%v, got %v", test.expectDelete, deleted)
+			}
+			if test.expectArchive {
+				if updated == nil {
+					t.Fatalf("expected the volume to be archived")
+				}
+				if updated.Spec.PersistentVolumeReclaimPolicy != v1.PersistentVolumeReclaimRetain {
+					t.Errorf("expected the archived volume to be retained, got %v", updated.Spec.PersistentVolumeReclaimPolicy)
+				}
+				if _, ok := updated.Annotations[AnnArchivedAt]; !ok {
+					t.Errorf("expected the archived volume to be annotated, got %v", updated.Annotations)
+				}
+			} else if updated != nil {
+				t.Fatalf("expected no volume update, got %+v", updated)
+			}
+		})
+	}
+}
@@ -0,0 +1,25 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+// PVCProtectionControllerConfiguration contains elements describing PVCProtectionController.
+type PVCProtectionControllerConfiguration struct {
+	// ConcurrentPVCProtectionSyncs is the number of PVC protection workers that
+	// process namespaces concurrently. Larger number = faster finalizer removal
+	// when many pods are deleted at once, but more CPU (and network) load.
+	ConcurrentPVCProtectionSyncs int32
+}
@@ -67,6 +67,13 @@ const (
 	// owner: @tallclair
 	AppArmorFields featuregate.Feature = "AppArmorFields"
 
+	// owner: @rnemeth90
+	//
+	// Makes the kubelet's atomic writer hardlink unchanged files from the
+	// previous payload version instead of rewriting them, reducing I/O
+	// spikes when projected volumes with many keys are updated.
+	AtomicWriterHardlinks featuregate.Feature = "AtomicWriterHardlinks"
+
 	// owner: @liggitt
 	// kep:
 	//
@@ -130,12 +137,33 @@ const (
 	// Enables coordinated leader election in the API server
 	CoordinatedLeaderElection featuregate.Feature = "CoordinatedLeaderElection"
 
+	// owner: @rnemeth90
+	//
+	// Sheds non-critical CSI node operations (e.g. remount verifications)
+	// while a driver already has a large number of node operations in
+	// flight, so critical mounts drain first.
+	CSIDriverLoadShedding featuregate.Feature = "CSIDriverLoadShedding"
+
+	// owner: @rnemeth90
+	//
+	// Enables client keepalive on kubelet connections to CSI driver sockets,
+	// so a driver socket that stops responding is torn down and re-dialed
+	// instead of blocking operations until their timeout.
+	CSIGRPCKeepalive featuregate.Feature = "CSIGRPCKeepalive"
+
 	// owner: @trierra
 	// kep:  http://kep.k8s.io/2589
 	//
 	// Enables the Portworx in-tree driver to Portworx migration feature.
 	CSIMigrationPortworx featuregate.Feature = "CSIMigrationPortworx"
 
+	// owner: @rnemeth90
+	//
+	// Makes the kubelet verify that a staging path the CSI driver reported
+	// as staged actually holds a mount, and retry the stage from scratch
+	// when it does not.
+	CSIStagingVerification featuregate.Feature = "CSIStagingVerification"
+
 	// owner: @fengzixu
 	//
 	// Enables kubelet to detect CSI volume condition and send the event of the abnormal volume to the corresponding pod that is using it.
@@ -159,6 +187,13 @@ const (
 	// Enable usage of Provision of PVCs from snapshots in other namespaces
 	CrossNamespaceVolumeDataSource featuregate.Feature = "CrossNamespaceVolumeDataSource"
 
+	// owner: @rnemeth90
+	//
+	// Makes the kubelet scan other mount namespaces for copies of a volume
+	// mount that survived a successful host unmount through mount
+	// propagation, and log the leaking processes.
+	DetectMountNamespaceLeaks featuregate.Feature = "DetectMountNamespaceLeaks"
+
 	// owner: @elezar
 	// kep: http://kep.k8s.io/4009
 	//
@@ -263,6 +298,14 @@ const (
 	// Make the kubelet use shutdown configuration based on pod priority values for graceful shutdown.
 	GracefulNodeShutdownBasedOnPodPriority featuregate.Feature = "GracefulNodeShutdownBasedOnPodPriority"
 
+	// owner: @rnemeth90
+	//
+	// Makes the kubelet inject nosuid, nodev and noexec into
+	// PersistentVolume and emptyDir mounts by default. The
+	// volume.kubernetes.io/hardened-mount-options PV annotation overrides
+	// the policy per volume, in either direction.
+	HardenedMountOptions featuregate.Feature = "HardenedMountOptions"
+
 	// owner: @dxist
 	//
 	// Enables support of HPA scaling to zero pods when an object or custom metric is configured.
@@ -336,6 +379,13 @@ const (
 	// based on the set of succeeded pods.
 	JobSuccessPolicy featuregate.Feature = "JobSuccessPolicy"
 
+	// owner: @rnemeth90
+	//
+	// Makes the kubelet kill pod-owned processes that keep a volume path
+	// busy after an unmount failed with EBUSY, so a leaked container
+	// process cannot wedge the pod's own unmount forever.
+	KillVolumeHolderProcesses featuregate.Feature = "KillVolumeHolderProcesses"
+
 	// owner: @marquiz
 	// kep: http://kep.k8s.io/4033
 	//
@@ -378,6 +428,13 @@ const (
 	// separate filesystems.
 	KubeletSeparateDiskGC featuregate.Feature = "KubeletSeparateDiskGC"
 
+	// owner: @rnemeth90
+	//
+	// Makes the kubelet perform volume mounts through transient systemd
+	// mount units, so systemd tears kubelet mounts down in order at node
+	// shutdown and every mount is inspectable with systemctl.
+	KubeletSystemdMountUnits featuregate.Feature = "KubeletSystemdMountUnits"
+
 	// owner: @sallyom
 	// kep: https://kep.k8s.io/2832
 	//
@@ -455,6 +512,21 @@ const (
 	// Permits kubelet to run with swap enabled.
 	NodeSwap featuregate.Feature = "NodeSwap"
 
+	// owner: @rnemeth90
+	//
+	// Makes the kubelet finish pending filesystem expansions for volumes
+	// that are attached to the node but not used by any pod. Without it
+	// node expansion only happens while a pod has the volume mounted.
+	OfflineVolumeExpansion featuregate.Feature = "OfflineVolumeExpansion"
+
+	// owner: @rnemeth90
+	//
+	// Makes the kubelet keep the volume directories of a deleted pod on
+	// disk for a grace period before the orphan cleanup path removes them,
+	// so forensic tools can inspect them briefly. The retention is skipped
+	// while the node is under disk pressure.
+	OrphanedPodVolumeRetention featuregate.Feature = "OrphanedPodVolumeRetention"
+
 	// owner: @mortent, @atiratree, @ravig
 	// kep: http://kep.k8s.io/3018
 	//
@@ -523,6 +595,13 @@ const (
 	// Enable users to specify when a Pod is ready for scheduling.
 	PodSchedulingReadiness featuregate.Feature = "PodSchedulingReadiness"
 
+	// owner: @rnemeth90
+	//
+	// Makes the kubelet honor per-volume fsGroup override annotations on
+	// pods, so individual volumes can skip the recursive ownership change
+	// or apply a different GID than the pod-level fsGroup.
+	PodVolumeFSGroupOverride featuregate.Feature = "PodVolumeFSGroupOverride"
+
 	// owner: @seans3
 	// kep: http://kep.k8s.io/4006
 	//
@@ -572,12 +651,26 @@ const (
 	// No effect for other cases such as using serverTLSbootstap.
 	ReloadKubeletServerCertificateFile featuregate.Feature = "ReloadKubeletServerCertificateFile"
 
+	// owner: @rnemeth90
+	//
+	// Makes the kubelet remount devices whose security-relevant mount
+	// options drifted from the PersistentVolume spec, instead of only
+	// warning about the drift.
+	RemountOnMountOptionDrift featuregate.Feature = "RemountOnMountOptionDrift"
+
 	// owner: @SergeyKanzhelev
 	// kep: https://kep.k8s.io/4680
 	//
 	// Adds the AllocatedResourcesStatus to the container status.
 	ResourceHealthStatus featuregate.Feature = "ResourceHealthStatus"
 
+	// owner: @rnemeth90
+	//
+	// Makes the kubelet kill pod-owned processes into whose mount namespace
+	// a volume mount leaked, so the container runtime restarts them with a
+	// fresh mount namespace. Implies the scan of DetectMountNamespaceLeaks.
+	RestartMountNamespaceLeakers featuregate.Feature = "RestartMountNamespaceLeakers"
+
 	// owner: @mikedanese
 	//
 	// Gets a server certificate for the kubelet from the Certificate Signing
@@ -674,6 +767,14 @@ const (
 	// Code can be removed in 1.35 without any consideration for emulated versions.
 	SizeMemoryBackedVolumes featuregate.Feature = "SizeMemoryBackedVolumes"
 
+	// owner: @rnemeth90
+	//
+	// Allows the kubelet to skip UnmountDevice during graceful node shutdown
+	// for volumes whose plugins declare this to be safe, after syncing
+	// filesystem buffers, so that shutdown under heavy storage load completes
+	// within the systemd inhibitor window.
+	SkipVolumeUnmountOnNodeShutdown featuregate.Feature = "SkipVolumeUnmountOnNodeShutdown"
+
 	// owner: @mattcary
 	//
 	// Enables policies controlling deletion of PVCs created by a StatefulSet.
@@ -695,6 +796,20 @@ const (
 	// Enables support for the StorageVersionMigrator controller.
 	StorageVersionMigrator featuregate.Feature = "StorageVersionMigrator"
 
+	// owner: @rnemeth90
+	//
+	// Makes the kubelet flush the filesystem of a volume with syncfs before
+	// tearing down its last mount, so the attach/detach controller never
+	// detaches a device with dirty pages.
+	SyncOnVolumeUnmount featuregate.Feature = "SyncOnVolumeUnmount"
+
+	// owner: @rnemeth90
+	//
+	// Makes the kubelet treat a deleted pod whose termination the container
+	// runtime cannot confirm as terminated for volume cleanup purposes
+	// after a timeout, instead of waiting for the runtime indefinitely.
+	TerminatedPodStatusTimeout featuregate.Feature = "TerminatedPodStatusTimeout"
+
 	// owner: @robscott
 	// kep: https://kep.k8s.io/2433
 	//
@@ -753,6 +868,36 @@ const (
 	// owner: @cofyc
 	VolumeCapacityPriority featuregate.Feature = "VolumeCapacityPriority"
 
+	// owner: @rnemeth90
+	//
+	// Adds a kubelet-owned VolumeMountsReady pod condition that reports the
+	// volume manager's per-volume mount status, so users can see per-volume
+	// progress with kubectl describe rather than scanning events.
+	VolumeMountsReadyCondition featuregate.Feature = "VolumeMountsReadyCondition"
+
+	// owner: @rnemeth90
+	//
+	// Makes the kubelet serve PVC and PV lookups from shared informer
+	// caches instead of issuing a GET per pod volume on every populator
+	// pass. The informers watch all PVCs and PVs, trading kubelet memory
+	// for API server load.
+	VolumeObjectInformers featuregate.Feature = "VolumeObjectInformers"
+
+	// owner: @rnemeth90
+	//
+	// Adds a kubelet-owned VolumeRecoveryDegraded node condition that turns
+	// true when volumes have remained in uncertain or skipped
+	// reconstruction state for too long after a kubelet restart.
+	VolumeRecoveryDegradedCondition featuregate.Feature = "VolumeRecoveryDegradedCondition"
+
+	// owner: @rnemeth90
+	//
+	// Keeps the node NotReady after a kubelet restart until the volumes
+	// reconstructed at startup have been verified, bounded by a timeout, so
+	// the scheduler does not place new stateful pods on a node that is
+	// still untangling its mounts.
+	VolumeStartupVerificationBarrier featuregate.Feature = "VolumeStartupVerificationBarrier"
+
 	// owner: @ksubrmnn
 	//
 	// Allows kube-proxy to create DSR loadbalancers for Windows
@@ -157,6 +157,7 @@ var (
 		"TLSPrivateKeyFile",
 		"ResolverConfig",
 		"PodLogsDir",
+		"VolumeTelemetryWebhookConfigFile",
 	)
 
 	// KubeletConfiguration fields that do not contain file paths.
@@ -303,5 +304,8 @@ var (
 		"LocalStorageCapacityIsolation",
 		"FailCgroupV1",
 		"CrashLoopBackOff.MaxContainerRestartPeriod",
+		"MaxCSIInlineVolumesPerPod",
+		"MaxCSIInlineVolumesPerNode",
+		"MaxCSIInlineVolumeSizePerPod",
 	)
 )
@@ -512,6 +512,24 @@ type KubeletConfiguration struct {
 	// +featureGate=KubeletCrashLoopBackoffMax
 	// +optional
 	CrashLoopBackOff CrashLoopBackOffConfig
+
+	// MaxCSIInlineVolumesPerPod is the maximum number of CSI ephemeral
+	// inline volumes a single pod may declare. Zero means no limit.
+	MaxCSIInlineVolumesPerPod int32
+	// MaxCSIInlineVolumesPerNode is the maximum total number of CSI
+	// ephemeral inline volumes across all pods on the node. Zero means no
+	// limit.
+	MaxCSIInlineVolumesPerNode int32
+	// MaxCSIInlineVolumeSizePerPod bounds the aggregate capacity a single
+	// pod may request through CSI ephemeral inline volumes, as a resource
+	// quantity (e.g. "10Gi"). Empty means no limit.
+	MaxCSIInlineVolumeSizePerPod string
+
+	// VolumeTelemetryWebhookConfigFile is the path to a kubeconfig file
+	// naming the webhook that receives volume lifecycle telemetry events
+	// and the TLS and authentication credentials the kubelet presents to
+	// it. Empty disables the webhook sink.
+	VolumeTelemetryWebhookConfigFile string
 }
 
 // KubeletAuthorizationMode denotes the authorization mode for the kubelet
@@ -563,6 +563,10 @@ func autoConvert_v1beta1_KubeletConfiguration_To_config_KubeletConfiguration(in
 	if err := v1.Convert_Pointer_bool_To_bool(&in.FailCgroupV1, &out.FailCgroupV1, s); err != nil {
 		return err
 	}
+	out.MaxCSIInlineVolumesPerPod = in.MaxCSIInlineVolumesPerPod
+	out.MaxCSIInlineVolumesPerNode = in.MaxCSIInlineVolumesPerNode
+	out.MaxCSIInlineVolumeSizePerPod = in.MaxCSIInlineVolumeSizePerPod
+	out.VolumeTelemetryWebhookConfigFile = in.VolumeTelemetryWebhookConfigFile
 	return nil
 }
 
@@ -763,6 +767,10 @@ func autoConvert_config_KubeletConfiguration_To_v1beta1_KubeletConfiguration(in
 	if err := Convert_config_CrashLoopBackOffConfig_To_v1beta1_CrashLoopBackOffConfig(&in.CrashLoopBackOff, &out.CrashLoopBackOff, s); err != nil {
 		return err
 	}
+	out.MaxCSIInlineVolumesPerPod = in.MaxCSIInlineVolumesPerPod
+	out.MaxCSIInlineVolumesPerNode = in.MaxCSIInlineVolumesPerNode
+	out.MaxCSIInlineVolumeSizePerPod = in.MaxCSIInlineVolumeSizePerPod
+	out.VolumeTelemetryWebhookConfigFile = in.VolumeTelemetryWebhookConfigFile
 	return nil
 }
 
@@ -21,6 +21,7 @@ import (
 	"time"
 	"unicode"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -116,6 +117,17 @@ func ValidateKubeletConfiguration(kc *kubeletconfig.KubeletConfiguration, featur
 	if kc.MaxOpenFiles < 0 {
 		allErrors = append(allErrors, fmt.Errorf("invalid configuration: maxOpenFiles (--max-open-files) %v must not be a negative number", kc.MaxOpenFiles))
 	}
+	if kc.MaxCSIInlineVolumesPerPod < 0 {
+		allErrors = append(allErrors, fmt.Errorf("invalid configuration: maxCSIInlineVolumesPerPod %v must not be a negative number", kc.MaxCSIInlineVolumesPerPod))
+	}
+	if kc.MaxCSIInlineVolumesPerNode < 0 {
+		allErrors = append(allErrors, fmt.Errorf("invalid configuration: maxCSIInlineVolumesPerNode %v must not be a negative number", kc.MaxCSIInlineVolumesPerNode))
+	}
+	if kc.MaxCSIInlineVolumeSizePerPod != "" {
+		if _, err := resource.ParseQuantity(kc.MaxCSIInlineVolumeSizePerPod); err != nil {
+			allErrors = append(allErrors, fmt.Errorf("invalid configuration: maxCSIInlineVolumeSizePerPod %q must be a resource quantity: %v", kc.MaxCSIInlineVolumeSizePerPod, err))
+		}
+	}
 	if kc.MaxPods < 0 {
 		allErrors = append(allErrors, fmt.Errorf("invalid configuration: maxPods (--max-pods) %v must not be a negative number", kc.MaxPods))
 	}
@@ -62,7 +62,11 @@ const (
 	VolumeResizeSuccess                  = "VolumeResizeSuccessful"
 	FileSystemResizeFailed               = "FileSystemResizeFailed"
 	FileSystemResizeSuccess              = "FileSystemResizeSuccessful"
+	FileSystemResizeCancelled            = "FileSystemResizeCancelled"
 	FailedMapVolume                      = "FailedMapVolume"
+	VolumeOwnershipChangeProgress        = "VolumeOwnershipChangeInProgress"
+	VolumeFSGroupApplied                 = "VolumeFSGroupApplied"
+	VolumeFSGroupSkipped                 = "VolumeFSGroupSkipped"
 	WarnAlreadyMountedVolume             = "AlreadyMountedVolume"
 	SuccessfulAttachVolume               = "SuccessfulAttachVolume"
 	SuccessfulMountVolume                = "SuccessfulMountVolume"
@@ -124,6 +124,7 @@ import (
 	"k8s.io/kubernetes/pkg/kubelet/util/queue"
 	"k8s.io/kubernetes/pkg/kubelet/util/sliceutils"
 	"k8s.io/kubernetes/pkg/kubelet/volumemanager"
+	"k8s.io/kubernetes/pkg/kubelet/volumemanager/populator"
 	"k8s.io/kubernetes/pkg/kubelet/watchdog"
 	httpprobe "k8s.io/kubernetes/pkg/probe/http"
 	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/tainttoleration"
@@ -133,6 +134,7 @@ import (
 	"k8s.io/kubernetes/pkg/volume/csi"
 	"k8s.io/kubernetes/pkg/volume/util/hostutil"
 	"k8s.io/kubernetes/pkg/volume/util/subpath"
+	volumetelemetry "k8s.io/kubernetes/pkg/volume/util/telemetry"
 	"k8s.io/kubernetes/pkg/volume/util/volumepathhandler"
 	"k8s.io/utils/clock"
 )
@@ -912,12 +914,14 @@ func NewMainKubelet(kubeCfg *kubeletconfiginternal.KubeletConfiguration,
 	}
 
 	// setup volumeManager
+	volumeObjectCache := populator.NewVolumeObjectCache(klet.kubeClient)
 	klet.volumeManager = volumemanager.NewVolumeManager(
 		kubeCfg.EnableControllerAttachDetach,
 		nodeName,
 		klet.podManager,
 		klet.podWorkers,
 		klet.kubeClient,
+		volumeObjectCache,
 		klet.volumePluginMgr,
 		klet.containerRuntime,
 		kubeDeps.Mounter,
@@ -926,6 +930,13 @@ func NewMainKubelet(kubeCfg *kubeletconfiginternal.KubeletConfiguration,
 		kubeDeps.Recorder,
 		volumepathhandler.NewBlockVolumePathHandler())
 
+	// Ship volume lifecycle telemetry to the configured webhook, if any.
+	if kubeCfg.VolumeTelemetryWebhookConfigFile != "" {
+		if err := volumetelemetry.InstallWebhookSink(kubeCfg.VolumeTelemetryWebhookConfigFile, wait.NeverStop); err != nil {
+			return nil, err
+		}
+	}
+
 	boMax := MaxContainerBackOff
 	base := containerBackOffPeriod
 	if utilfeature.DefaultFeatureGate.Enabled(features.KubeletCrashLoopBackOffMax) {
@@ -946,6 +957,8 @@ func NewMainKubelet(kubeCfg *kubeletconfiginternal.KubeletConfiguration,
 	klet.evictionManager = evictionManager
 	klet.admitHandlers.AddPodAdmitHandler(evictionAdmitHandler)
 
+	klet.podVolumeRetention = newPodVolumeRetentionTracker(podVolumeRetentionPeriod())
+
 	// Safe, allowed sysctls can always be used as unsafe sysctls in the spec.
 	// Hence, we concatenate those two lists.
 	safeAndUnsafeSysctls := append(sysctl.SafeSysctlAllowlist(), allowedUnsafeSysctls...)
@@ -967,6 +980,8 @@ func NewMainKubelet(kubeCfg *kubeletconfiginternal.KubeletConfiguration,
 
 	criticalPodAdmissionHandler := preemption.NewCriticalPodAdmissionHandler(klet.GetActivePods, killPodNow(klet.podWorkers, kubeDeps.Recorder), kubeDeps.Recorder)
 	klet.admitHandlers.AddPodAdmitHandler(lifecycle.NewPredicateAdmitHandler(klet.getNodeAnyWay, criticalPodAdmissionHandler, klet.containerManager.UpdatePluginResources))
+	klet.admitHandlers.AddPodAdmitHandler(volumemanager.NewAttachLimitAdmitHandler(klet.kubeClient, klet.nodeName, klet.volumeManager, volumeObjectCache))
+	klet.admitHandlers.AddPodAdmitHandler(volumemanager.NewInlineVolumeLimitAdmitHandler(klet.GetActivePods, kubeCfg.MaxCSIInlineVolumesPerPod, kubeCfg.MaxCSIInlineVolumesPerNode, kubeCfg.MaxCSIInlineVolumeSizePerPod))
 	// apply functional Option's
 	for _, opt := range kubeDeps.Options {
 		opt(klet)
@@ -1114,6 +1129,11 @@ type Kubelet struct {
 	// authoritative.
 	evictionManager eviction.Manager
 
+	// podVolumeRetention postpones the removal of deleted pods' volume
+	// directories by the configured retention period, unless the node is
+	// under disk pressure.
+	podVolumeRetention *podVolumeRetentionTracker
+
 	// probeManager tracks the set of running pods and ensures any user-defined periodic checks are
 	// run to introspect the state of each pod.  The probe manager acts on the actual state of the node
 	// and is notified of pods by the podWorker. The probe manager is the authoritative source of the
@@ -1579,6 +1599,7 @@ func (kl *Kubelet) initializeModules() error {
 	// Prometheus metrics.
 	metrics.Register(
 		collectors.NewVolumeStatsCollector(kl),
+		collectors.NewVolumePayloadCollector(),
 		collectors.NewLogMetricsCollector(kl.StatsProvider.ListPodStats),
 	)
 	metrics.SetNodeName(kl.nodeName)
@@ -2006,6 +2027,14 @@ func (kl *Kubelet) SyncPod(ctx context.Context, updateType kubetypes.SyncPodType
 
 	// Wait for volumes to attach/mount
 	if err := kl.volumeManager.WaitForAttachAndMount(ctx, pod); err != nil {
+		var deadlineExceeded *volumemanager.MountDeadlineExceededError
+		if errors.As(err, &deadlineExceeded) && deadlineExceeded.FailPod {
+			// The pod opted into failing when its mount deadline expires
+			// and its restart policy permits it. Reject it through the
+			// status manager, like an admission failure.
+			kl.rejectPod(pod, volumemanager.MountDeadlineExceededReason, deadlineExceeded.Message)
+			return false, err
+		}
 		if !wait.Interrupted(err) {
 			kl.recorder.Eventf(pod, v1.EventTypeWarning, events.FailedMountVolume, "Unable to attach or mount volumes: %v", err)
 			klog.ErrorS(err, "Unable to attach or mount volumes for pod; skipping pod", "pod", klog.KObj(pod))
@@ -2932,6 +2961,10 @@ func (kl *Kubelet) handlePodResourcesResize(pod *v1.Pod, podStatus *kubecontaine
 		}
 		allocatedPod = pod
 
+		// A changed memory allocation changes the size limit of the pod's
+		// memory-backed volumes; remount them so they track the new limit.
+		kl.resizeMemoryBackedVolumes(allocatedPod)
+
 		// Special case when the updated allocation matches the actual resources. This can occur
 		// when reverting a resize that hasn't been actuated, or when making an equivalent change
 		// (such as CPU requests below MinShares). This is an optimization to clear the resize
@@ -2956,6 +2989,22 @@ func (kl *Kubelet) handlePodResourcesResize(pod *v1.Pod, podStatus *kubecontaine
 	return allocatedPod, nil
 }
 
+// resizeMemoryBackedVolumes remounts the pod's memory-backed volumes so their
+// size limits track the pod's new memory allocation. Failures are logged and
+// left for the next resize attempt; the pod keeps running with the old limit.
+func (kl *Kubelet) resizeMemoryBackedVolumes(pod *v1.Pod) {
+	for i := range pod.Spec.Volumes {
+		spec := volume.NewSpecFromVolume(&pod.Spec.Volumes[i])
+		resizer, err := kl.volumePluginMgr.FindMemoryBackedVolumeResizerPluginBySpec(spec)
+		if err != nil || resizer == nil {
+			continue
+		}
+		if err := resizer.ResizeMemoryBackedVolume(spec, pod); err != nil {
+			klog.ErrorS(err, "Failed to resize memory-backed volume", "pod", klog.KObj(pod), "volumeName", spec.Name())
+		}
+	}
+}
+
 // LatestLoopEntryTime returns the last time in the sync loop monitor.
 func (kl *Kubelet) LatestLoopEntryTime() time.Time {
 	val := kl.syncLoopMonitor.Load()
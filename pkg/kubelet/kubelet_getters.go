@@ -37,6 +37,7 @@ import (
 	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
 	kubelettypes "k8s.io/kubernetes/pkg/kubelet/types"
 	utilnode "k8s.io/kubernetes/pkg/util/node"
+	volumepkg "k8s.io/kubernetes/pkg/volume"
 	"k8s.io/kubernetes/pkg/volume/csi"
 )
 
@@ -362,6 +363,11 @@ func (kl *Kubelet) getPodVolumePathListFromDisk(podUID types.UID) ([]string, err
 
 		if unescapePluginName != csi.CSIPluginName {
 			for _, volumeDir := range volumeDirs {
+				if volumepkg.IsOwnershipCheckpointFile(volumeDir) {
+					// Progress marker of an interrupted ownership change, not
+					// a volume.
+					continue
+				}
 				volumes = append(volumes, filepath.Join(volumePluginPath, volumeDir))
 			}
 		} else {
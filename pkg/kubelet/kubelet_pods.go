@@ -313,6 +313,9 @@ func makeMounts(pod *v1.Pod, podDir string, container *v1.Container, hostName, h
 
 			subPath := mount.SubPath
 			if mount.SubPathExpr != "" {
+				if err := checkSubPathExprDependencies(pod, container, mount); err != nil {
+					return nil, cleanupAction, err
+				}
 				subPath, err = kubecontainer.ExpandContainerVolumeMounts(mount, expandEnvs)
 
 				if err != nil {
@@ -336,6 +339,10 @@ func makeMounts(pod *v1.Pod, podDir string, container *v1.Container, hostName, h
 				if subPathExists, err := hu.PathExists(hostPath); err != nil {
 					klog.ErrorS(nil, "Could not determine if subPath exists, will not attempt to change its permissions", "path", hostPath)
 				} else if !subPathExists {
+					creationPolicy := subPathCreationPolicy(pod, mount.Name)
+					if creationPolicy == subPathCreatePolicyNever {
+						return nil, cleanupAction, fmt.Errorf("subPath of volumeMount %q of container %q does not exist and its creation policy is %q", mount.Name, container.Name, subPathCreatePolicyNever)
+					}
 					// Create the sub path now because if it's auto-created later when referenced, it may have an
 					// incorrect ownership and mode. For example, the sub path directory must have at least g+rwx
 					// when the pod specifies an fsGroup, and if the directory is not created here, Docker will
@@ -350,6 +357,12 @@ func makeMounts(pod *v1.Pod, podDir string, container *v1.Container, hostName, h
 						klog.ErrorS(err, "Failed to create subPath directory for volumeMount of the container", "containerName", container.Name, "volumeMountName", mount.Name)
 						return nil, cleanupAction, fmt.Errorf("failed to create subPath directory for volumeMount %q of container %q", mount.Name, container.Name)
 					}
+					if creationPolicy == subPathCreatePolicyOwned {
+						if err := applySubPathOwnership(hostPath, pod, container); err != nil {
+							klog.ErrorS(err, "Failed to apply ownership to subPath directory of the container", "containerName", container.Name, "volumeMountName", mount.Name)
+							return nil, cleanupAction, fmt.Errorf("failed to apply ownership to subPath directory for volumeMount %q of container %q", mount.Name, container.Name)
+						}
+					}
 				}
 				hostPath, cleanupAction, err = subpather.PrepareSafeSubpath(subpath.Subpath{
 					VolumeMountIndex: i,
@@ -1919,6 +1932,16 @@ func (kl *Kubelet) generateAPIPodStatus(pod *v1.Pod, podStatus *kubecontainer.Po
 	if utilfeature.DefaultFeatureGate.Enabled(features.PodReadyToStartContainersCondition) {
 		s.Conditions = append(s.Conditions, status.GeneratePodReadyToStartContainersCondition(pod, podStatus))
 	}
+	volumeMountStatuses := kl.volumeManager.GetVolumeMountStatusesForPod(pod)
+	if utilfeature.DefaultFeatureGate.Enabled(features.VolumeMountsReadyCondition) {
+		s.Conditions = append(s.Conditions, status.GenerateVolumeMountsReadyCondition(volumeMountStatuses))
+	}
+	if condition, blocked := status.GenerateVolumeMountsBlockedCondition(volumeMountStatuses); blocked {
+		s.Conditions = append(s.Conditions, condition)
+	}
+	if message, exceeded := kl.volumeManager.GetMountDeadlineExceededMessage(pod); exceeded {
+		s.Conditions = append(s.Conditions, status.GenerateVolumeMountDeadlineExceededCondition(message))
+	}
 	allContainerStatuses := append(s.InitContainerStatuses, s.ContainerStatuses...)
 	s.Conditions = append(s.Conditions, status.GeneratePodInitializedCondition(&pod.Spec, allContainerStatuses, s.Phase))
 	s.Conditions = append(s.Conditions, status.GeneratePodReadyCondition(&pod.Spec, s.Conditions, allContainerStatuses, s.Phase))
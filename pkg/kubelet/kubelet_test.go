@@ -392,6 +392,7 @@ func newTestKubeletWithImageList(
 		kubelet.podManager,
 		kubelet.podWorkers,
 		fakeKubeClient,
+		nil, /* volumeObjectCache */
 		kubelet.volumePluginMgr,
 		fakeRuntime,
 		kubelet.mounter,
@@ -21,6 +21,7 @@ import (
 	"os"
 	"path/filepath"
 	"syscall"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -29,6 +30,7 @@ import (
 	"k8s.io/klog/v2"
 	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
 	"k8s.io/kubernetes/pkg/kubelet/metrics"
+	"k8s.io/kubernetes/pkg/kubelet/volumemanager"
 	"k8s.io/kubernetes/pkg/util/removeall"
 	"k8s.io/kubernetes/pkg/volume"
 	volumetypes "k8s.io/kubernetes/pkg/volume/util/types"
@@ -73,6 +75,78 @@ func (kl *Kubelet) ListBlockVolumesForPod(podUID types.UID) (map[string]volume.B
 	return volumesToReturn, len(volumesToReturn) > 0
 }
 
+// QuiescePodVolumes freezes the mounted filesystem volumes of the given pod
+// so that an external snapshot controller can take consistent snapshots. The
+// volumes are thawed automatically after the safety timeout when no explicit
+// ThawPodVolumes call arrives.
+func (kl *Kubelet) QuiescePodVolumes(podUID types.UID, timeout time.Duration) error {
+	return kl.volumeManager.QuiescePodVolumes(volumetypes.UniquePodName(podUID), timeout)
+}
+
+// ThawPodVolumes unfreezes the quiesced volumes of the given pod.
+func (kl *Kubelet) ThawPodVolumes(podUID types.UID) error {
+	return kl.volumeManager.ThawPodVolumes(volumetypes.UniquePodName(podUID))
+}
+
+// GetVolumeConsistencyGroups returns the mounted volumes of the given pod
+// grouped by the driver serving them, for external group-snapshot
+// controllers.
+func (kl *Kubelet) GetVolumeConsistencyGroups(podUID types.UID) []volumemanager.VolumeConsistencyGroup {
+	return kl.volumeManager.GetVolumeConsistencyGroupsForPod(volumetypes.UniquePodName(podUID))
+}
+
+// QuiesceConsistencyGroup freezes the mounted filesystem volumes of the given
+// pod that are served by the given driver.
+func (kl *Kubelet) QuiesceConsistencyGroup(podUID types.UID, driver string, timeout time.Duration) error {
+	return kl.volumeManager.QuiesceConsistencyGroup(volumetypes.UniquePodName(podUID), driver, timeout)
+}
+
+// ThawConsistencyGroup unfreezes the quiesced volumes of the given pod that
+// are served by the given driver.
+func (kl *Kubelet) ThawConsistencyGroup(podUID types.UID, driver string) error {
+	return kl.volumeManager.ThawConsistencyGroup(volumetypes.UniquePodName(podUID), driver)
+}
+
+// GetPodsUsingPVC returns the pods on this node that use the persistent
+// volume claim with the given UID, according to the volume manager's desired
+// state of the world.
+func (kl *Kubelet) GetPodsUsingPVC(pvcUID types.UID) []volumemanager.PVCUser {
+	return kl.volumeManager.GetPodsUsingPVC(pvcUID)
+}
+
+// GetVolumeInventory returns a schema-versioned inventory of all mounts,
+// devices and CSI staging paths the volume manager believes it owns on this
+// node.
+func (kl *Kubelet) GetVolumeInventory() volumemanager.VolumeInventory {
+	return kl.volumeManager.GetVolumeInventory()
+}
+
+// ExportPodMountState returns a schema-versioned snapshot of the given pod's
+// volume mount state, as a building block for checkpoint/restore-based pod
+// migration.
+func (kl *Kubelet) ExportPodMountState(podUID types.UID) (volumemanager.PodMountState, error) {
+	return kl.volumeManager.ExportPodMountState(podUID)
+}
+
+// ImportPodMountState validates and records pod mount state exported from
+// another node.
+func (kl *Kubelet) ImportPodMountState(state volumemanager.PodMountState) error {
+	return kl.volumeManager.ImportPodMountState(state)
+}
+
+// SetStorageMaintenanceMode transitions the node into or out of storage
+// maintenance mode, in which the volume manager starts no new mounts and
+// drains its kubelet-owned mounts.
+func (kl *Kubelet) SetStorageMaintenanceMode(enabled bool) {
+	kl.volumeManager.SetStorageMaintenanceMode(enabled)
+}
+
+// GetStorageMaintenanceStatus returns the storage maintenance state of the
+// node, including whether the volume manager still owns any mounts.
+func (kl *Kubelet) GetStorageMaintenanceStatus() volumemanager.StorageMaintenanceStatus {
+	return kl.volumeManager.GetStorageMaintenanceStatus()
+}
+
 // podVolumesExist checks with the volume manager and returns true any of the
 // pods for the specified volume are mounted or are uncertain.
 func (kl *Kubelet) podVolumesExist(podUID types.UID) bool {
@@ -184,6 +258,7 @@ func (kl *Kubelet) cleanupOrphanedPodDirs(pods []*v1.Pod, runningPods []*kubecon
 
 	orphanRemovalErrors := []error{}
 	orphanVolumeErrors := []error{}
+	orphanedPods := map[types.UID]struct{}{}
 	var totalPods, errorPods int
 
 	for _, uid := range found {
@@ -192,6 +267,14 @@ func (kl *Kubelet) cleanupOrphanedPodDirs(pods []*v1.Pod, runningPods []*kubecon
 		}
 
 		totalPods++
+		orphanedPods[uid] = struct{}{}
+
+		// Keep the directories of freshly deleted pods around for the
+		// configured retention period so forensic tools can inspect them.
+		if kl.retainOrphanedPodVolumes(uid) {
+			klog.V(3).InfoS("Orphaned pod found, retaining its directories for the configured retention period", "podUID", uid)
+			continue
+		}
 
 		// If volumes have not been unmounted/detached, do not delete directory.
 		// Doing so may result in corruption of data.
@@ -266,6 +349,10 @@ func (kl *Kubelet) cleanupOrphanedPodDirs(pods []*v1.Pod, runningPods []*kubecon
 			}
 		}
 	}
+	if kl.podVolumeRetention != nil {
+		kl.podVolumeRetention.sweep(orphanedPods)
+	}
+
 	logSpew(orphanVolumeErrors)
 	logSpew(orphanRemovalErrors)
 	metrics.OrphanPodCleanedVolumes.Set(float64(totalPods))
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collectors
+
+import (
+	"k8s.io/component-base/metrics"
+	kubeletmetrics "k8s.io/kubernetes/pkg/kubelet/metrics"
+	volumeutil "k8s.io/kubernetes/pkg/volume/util"
+	"k8s.io/utils/clock"
+)
+
+var volumePayloadStalenessDesc = metrics.NewDesc(
+	metrics.BuildFQName("", kubeletmetrics.KubeletSubsystem, kubeletmetrics.VolumePayloadStalenessKey),
+	"Seconds since the payload of an API-object-backed volume (secret, configmap, projected) was last written to disk",
+	[]string{"namespace", "pod", "volume", "plugin"}, nil,
+	metrics.ALPHA, "",
+)
+
+type volumePayloadCollector struct {
+	metrics.BaseStableCollector
+
+	clock clock.Clock
+}
+
+// Check if volumePayloadCollector implements necessary interface
+var _ metrics.StableCollector = &volumePayloadCollector{}
+
+// NewVolumePayloadCollector creates a metrics.StableCollector exposing the
+// payload age of mounted secret, configmap and projected volumes.
+func NewVolumePayloadCollector() metrics.StableCollector {
+	return &volumePayloadCollector{clock: clock.RealClock{}}
+}
+
+// DescribeWithStability implements the metrics.StableCollector interface.
+func (collector *volumePayloadCollector) DescribeWithStability(ch chan<- *metrics.Desc) {
+	ch <- volumePayloadStalenessDesc
+}
+
+// CollectWithStability implements the metrics.StableCollector interface.
+func (collector *volumePayloadCollector) CollectWithStability(ch chan<- metrics.Metric) {
+	now := collector.clock.Now()
+	volumeutil.VisitPayloadRefreshes(func(key volumeutil.PayloadRefreshKey, refresh volumeutil.PayloadRefresh) {
+		ch <- metrics.NewLazyConstMetric(volumePayloadStalenessDesc, metrics.GaugeValue,
+			now.Sub(refresh.LastWrite).Seconds(),
+			refresh.PodNamespace, refresh.PodName, key.VolumeName, key.PluginName)
+	})
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collectors
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/component-base/metrics/testutil"
+	volumeutil "k8s.io/kubernetes/pkg/volume/util"
+	testingclock "k8s.io/utils/clock/testing"
+)
+
+func TestVolumePayloadCollector(t *testing.T) {
+	const metadata = `
+		# HELP kubelet_volume_payload_staleness_seconds [ALPHA] Seconds since the payload of an API-object-backed volume (secret, configmap, projected) was last written to disk
+		# TYPE kubelet_volume_payload_staleness_seconds gauge
+	`
+
+	now := time.Now()
+	secretKey := volumeutil.PayloadRefreshKey{PodUID: "uid1", VolumeName: "secret-vol", PluginName: "kubernetes.io/secret"}
+	configMapKey := volumeutil.PayloadRefreshKey{PodUID: "uid2", VolumeName: "config-vol", PluginName: "kubernetes.io/configmap"}
+	defer func() {
+		volumeutil.ForgetPayloadRefresh(secretKey)
+		volumeutil.ForgetPayloadRefresh(configMapKey)
+	}()
+	volumeutil.RecordPayloadRefresh(secretKey, "ns1", "pod1", now.Add(-30*time.Second))
+	volumeutil.RecordPayloadRefresh(configMapKey, "ns2", "pod2", now.Add(-5*time.Second))
+
+	want := metadata + `
+		kubelet_volume_payload_staleness_seconds{namespace="ns1",plugin="kubernetes.io/secret",pod="pod1",volume="secret-vol"} 30
+		kubelet_volume_payload_staleness_seconds{namespace="ns2",plugin="kubernetes.io/configmap",pod="pod2",volume="config-vol"} 5
+	`
+
+	collector := &volumePayloadCollector{clock: testingclock.NewFakeClock(now)}
+	if err := testutil.CustomCollectAndCompare(collector, strings.NewReader(want), "kubelet_volume_payload_staleness_seconds"); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
@@ -64,6 +64,7 @@ const (
 	VolumeStatsInodesFreeKey           = "volume_stats_inodes_free"
 	VolumeStatsInodesUsedKey           = "volume_stats_inodes_used"
 	VolumeStatsHealthStatusAbnormalKey = "volume_stats_health_status_abnormal"
+	VolumePayloadStalenessKey          = "volume_payload_staleness_seconds"
 	RunningPodsKey                     = "running_pods"
 	RunningContainersKey               = "running_containers"
 	DesiredPodCountKey                 = "desired_pods"
@@ -293,6 +293,13 @@ func (m *managerImpl) processShutdownEvent() error {
 	m.logger.V(1).Info("Shutdown manager processing shutdown event")
 	activePods := m.getPods()
 
+	if utilfeature.DefaultFeatureGate.Enabled(features.SkipVolumeUnmountOnNodeShutdown) {
+		// Let the volume manager skip device unmounts that plugins declare
+		// safe to skip, so that shutdown under heavy storage load completes
+		// within the inhibitor window.
+		m.podManager.volumeManager.MarkShutdownImminent()
+	}
+
 	defer func() {
 		m.dbusCon.ReleaseInhibitLock(m.inhibitLock)
 		m.logger.V(1).Info("Shutdown manager completed processing shutdown event, node will shutdown shortly")
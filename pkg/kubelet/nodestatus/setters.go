@@ -798,6 +798,72 @@ func DiskPressureCondition(nowFunc func() time.Time, // typically Kubelet.clock.
 	}
 }
 
+// VolumeRecoveryDegradedConditionType is the type of the node condition set
+// while volumes reconstructed at kubelet startup stay in uncertain or skipped
+// state for too long, so autoscalers and operators can avoid or remediate the
+// node.
+const VolumeRecoveryDegradedConditionType v1.NodeConditionType = "VolumeRecoveryDegraded"
+
+// VolumeRecoveryDegradedCondition returns a Setter that updates the
+// VolumeRecoveryDegraded condition on the node.
+func VolumeRecoveryDegradedCondition(nowFunc func() time.Time, // typically Kubelet.clock.Now
+	degradedFunc func() (bool, int), // typically Kubelet.volumeManager.VolumeRecoveryDegraded
+	recordEventFunc func(eventType, event string), // typically Kubelet.recordNodeStatusEvent
+) Setter {
+	return func(ctx context.Context, node *v1.Node) error {
+		currentTime := metav1.NewTime(nowFunc())
+		var condition *v1.NodeCondition
+
+		// Check if the condition already exists and if it does, just pick it up for update.
+		for i := range node.Status.Conditions {
+			if node.Status.Conditions[i].Type == VolumeRecoveryDegradedConditionType {
+				condition = &node.Status.Conditions[i]
+			}
+		}
+
+		newCondition := false
+		if condition == nil {
+			condition = &v1.NodeCondition{
+				Type:   VolumeRecoveryDegradedConditionType,
+				Status: v1.ConditionUnknown,
+			}
+			// cannot be appended to node.Status.Conditions here because it gets
+			// copied to the slice. So if we append to the slice here none of the
+			// updates we make below are reflected in the slice.
+			newCondition = true
+		}
+
+		// Update the heartbeat time
+		condition.LastHeartbeatTime = currentTime
+
+		degraded, unresolvedVolumes := degradedFunc()
+		if degraded {
+			message := fmt.Sprintf("%d volumes did not recover from kubelet startup reconstruction", unresolvedVolumes)
+			if condition.Status != v1.ConditionTrue {
+				condition.Status = v1.ConditionTrue
+				condition.Reason = "VolumeReconstructionUnresolved"
+				condition.LastTransitionTime = currentTime
+				recordEventFunc(v1.EventTypeWarning, "NodeVolumeRecoveryDegraded")
+			}
+			// Keep the volume count in the message current while degraded.
+			condition.Message = message
+		} else if condition.Status != v1.ConditionFalse {
+			condition.Status = v1.ConditionFalse
+			condition.Reason = "VolumeReconstructionResolved"
+			condition.Message = "all volumes reconstructed at kubelet startup recovered"
+			condition.LastTransitionTime = currentTime
+			if !newCondition {
+				recordEventFunc(v1.EventTypeNormal, "NodeVolumeRecoveryResolved")
+			}
+		}
+
+		if newCondition {
+			node.Status.Conditions = append(node.Status.Conditions, *condition)
+		}
+		return nil
+	}
+}
+
 // VolumesInUse returns a Setter that updates the volumes in use on the node.
 func VolumesInUse(syncedFunc func() bool, // typically Kubelet.volumeManager.ReconcilerStatesHasBeenSynced
 	volumesInUseFunc func() []v1.UniqueVolumeName, // typically Kubelet.volumeManager.GetVolumesInUse
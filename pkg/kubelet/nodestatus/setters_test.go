@@ -2380,3 +2380,134 @@ func makeDiskPressureCondition(pressure bool, transition, heartbeat time.Time) *
 		LastHeartbeatTime:  metav1.NewTime(heartbeat),
 	}
 }
+
+func TestVolumeRecoveryDegradedCondition(t *testing.T) {
+	now := time.Now()
+	before := now.Add(-time.Second)
+	nowFunc := func() time.Time { return now }
+
+	cases := []struct {
+		desc              string
+		node              *v1.Node
+		degraded          bool
+		unresolvedVolumes int
+		expectConditions  []v1.NodeCondition
+		expectEvents      []testEvent
+	}{
+		{
+			desc:             "new, not degraded",
+			node:             &v1.Node{},
+			degraded:         false,
+			expectConditions: []v1.NodeCondition{*makeVolumeRecoveryDegradedCondition(false, 0, now, now)},
+			expectEvents:     []testEvent{},
+		},
+		{
+			desc:              "new, degraded",
+			node:              &v1.Node{},
+			degraded:          true,
+			unresolvedVolumes: 3,
+			expectConditions:  []v1.NodeCondition{*makeVolumeRecoveryDegradedCondition(true, 3, now, now)},
+			expectEvents: []testEvent{
+				{
+					eventType: v1.EventTypeWarning,
+					event:     "NodeVolumeRecoveryDegraded",
+				},
+			},
+		},
+		{
+			desc: "transition to degraded",
+			node: &v1.Node{
+				Status: v1.NodeStatus{
+					Conditions: []v1.NodeCondition{*makeVolumeRecoveryDegradedCondition(false, 0, before, before)},
+				},
+			},
+			degraded:          true,
+			unresolvedVolumes: 2,
+			expectConditions:  []v1.NodeCondition{*makeVolumeRecoveryDegradedCondition(true, 2, now, now)},
+			expectEvents: []testEvent{
+				{
+					eventType: v1.EventTypeWarning,
+					event:     "NodeVolumeRecoveryDegraded",
+				},
+			},
+		},
+		{
+			desc: "degraded, no transition",
+			node: &v1.Node{
+				Status: v1.NodeStatus{
+					Conditions: []v1.NodeCondition{*makeVolumeRecoveryDegradedCondition(true, 2, before, before)},
+				},
+			},
+			degraded:          true,
+			unresolvedVolumes: 1,
+			expectConditions:  []v1.NodeCondition{*makeVolumeRecoveryDegradedCondition(true, 1, before, now)},
+			expectEvents:      []testEvent{},
+		},
+		{
+			desc: "transition to resolved",
+			node: &v1.Node{
+				Status: v1.NodeStatus{
+					Conditions: []v1.NodeCondition{*makeVolumeRecoveryDegradedCondition(true, 2, before, before)},
+				},
+			},
+			degraded:         false,
+			expectConditions: []v1.NodeCondition{*makeVolumeRecoveryDegradedCondition(false, 0, now, now)},
+			expectEvents: []testEvent{
+				{
+					eventType: v1.EventTypeNormal,
+					event:     "NodeVolumeRecoveryResolved",
+				},
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.desc, func(t *testing.T) {
+			ctx := context.Background()
+			events := []testEvent{}
+			recordEventFunc := func(eventType, event string) {
+				events = append(events, testEvent{
+					eventType: eventType,
+					event:     event,
+				})
+			}
+			degradedFunc := func() (bool, int) {
+				return tc.degraded, tc.unresolvedVolumes
+			}
+			// construct setter
+			setter := VolumeRecoveryDegradedCondition(nowFunc, degradedFunc, recordEventFunc)
+			// call setter on node
+			if err := setter(ctx, tc.node); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			// check expected condition
+			assert.True(t, apiequality.Semantic.DeepEqual(tc.expectConditions, tc.node.Status.Conditions),
+				"Diff: %s", cmp.Diff(tc.expectConditions, tc.node.Status.Conditions))
+			// check expected events
+			require.Equal(t, len(tc.expectEvents), len(events))
+			for i := range tc.expectEvents {
+				assert.Equal(t, tc.expectEvents[i], events[i])
+			}
+		})
+	}
+}
+
+func makeVolumeRecoveryDegradedCondition(degraded bool, unresolvedVolumes int, transition, heartbeat time.Time) *v1.NodeCondition {
+	if degraded {
+		return &v1.NodeCondition{
+			Type:               VolumeRecoveryDegradedConditionType,
+			Status:             v1.ConditionTrue,
+			Reason:             "VolumeReconstructionUnresolved",
+			Message:            fmt.Sprintf("%d volumes did not recover from kubelet startup reconstruction", unresolvedVolumes),
+			LastTransitionTime: metav1.NewTime(transition),
+			LastHeartbeatTime:  metav1.NewTime(heartbeat),
+		}
+	}
+	return &v1.NodeCondition{
+		Type:               VolumeRecoveryDegradedConditionType,
+		Status:             v1.ConditionFalse,
+		Reason:             "VolumeReconstructionResolved",
+		Message:            "all volumes reconstructed at kubelet startup recovered",
+		LastTransitionTime: metav1.NewTime(transition),
+		LastHeartbeatTime:  metav1.NewTime(heartbeat),
+	}
+}
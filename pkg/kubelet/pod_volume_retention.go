@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubelet
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/kubernetes/pkg/features"
+)
+
+// defaultPodVolumeRetentionPeriod is how long the volume directories of a
+// deleted pod are kept on disk before the orphan cleanup path removes them,
+// so forensic tools can inspect them briefly. The retention is skipped while
+// the node is under disk pressure.
+const defaultPodVolumeRetentionPeriod = 30 * time.Minute
+
+// podVolumeRetentionPeriod returns the retention period for orphaned pod
+// volume directories. It returns zero (disabled, directories are removed
+// immediately) unless the OrphanedPodVolumeRetention feature gate is enabled.
+func podVolumeRetentionPeriod() time.Duration {
+	if !utilfeature.DefaultFeatureGate.Enabled(features.OrphanedPodVolumeRetention) {
+		return 0
+	}
+	return defaultPodVolumeRetentionPeriod
+}
+
+// podVolumeRetentionTracker records when the orphan cleanup path first saw
+// each deleted pod's directories, so their removal can be postponed by the
+// configured retention period. Only used from cleanupOrphanedPodDirs, which
+// runs in the main sync loop.
+type podVolumeRetentionTracker struct {
+	retention time.Duration
+	firstSeen map[types.UID]time.Time
+}
+
+func newPodVolumeRetentionTracker(retention time.Duration) *podVolumeRetentionTracker {
+	return &podVolumeRetentionTracker{
+		retention: retention,
+		firstSeen: map[types.UID]time.Time{},
+	}
+}
+
+// shouldRetain returns true when removal of the given orphaned pod's
+// directories should be postponed. The first call for a pod starts its
+// retention. Disk pressure overrides the retention so that reclaiming disk
+// space is never delayed.
+func (t *podVolumeRetentionTracker) shouldRetain(uid types.UID, underDiskPressure bool) bool {
+	if t.retention == 0 {
+		return false
+	}
+	if underDiskPressure {
+		delete(t.firstSeen, uid)
+		return false
+	}
+	firstSeen, exists := t.firstSeen[uid]
+	if !exists {
+		t.firstSeen[uid] = time.Now()
+		return true
+	}
+	if time.Since(firstSeen) < t.retention {
+		return true
+	}
+	delete(t.firstSeen, uid)
+	return false
+}
+
+// sweep drops retention records of pods that are no longer orphaned, either
+// because their directories were removed or because the pod came back.
+func (t *podVolumeRetentionTracker) sweep(orphanedPods map[types.UID]struct{}) {
+	for uid := range t.firstSeen {
+		if _, exists := orphanedPods[uid]; !exists {
+			delete(t.firstSeen, uid)
+		}
+	}
+}
+
+// retainOrphanedPodVolumes returns true when the directories of the given
+// orphaned pod should be kept for the configured retention period.
+func (kl *Kubelet) retainOrphanedPodVolumes(uid types.UID) bool {
+	if kl.podVolumeRetention == nil {
+		return false
+	}
+	underDiskPressure := kl.evictionManager != nil && kl.evictionManager.IsUnderDiskPressure()
+	return kl.podVolumeRetention.shouldRetain(uid, underDiskPressure)
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubelet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+	"k8s.io/kubernetes/pkg/features"
+)
+
+func TestPodVolumeRetentionTracker(t *testing.T) {
+	uid := types.UID("pod1")
+
+	// Disabled retention never postpones removal.
+	tracker := newPodVolumeRetentionTracker(0)
+	assert.False(t, tracker.shouldRetain(uid, false /* underDiskPressure */))
+	assert.Empty(t, tracker.firstSeen)
+
+	// With retention enabled the first sighting starts the clock and removal
+	// is postponed until the period elapses.
+	tracker = newPodVolumeRetentionTracker(30 * time.Minute)
+	assert.True(t, tracker.shouldRetain(uid, false))
+	assert.True(t, tracker.shouldRetain(uid, false))
+	tracker.firstSeen[uid] = time.Now().Add(-time.Hour)
+	assert.False(t, tracker.shouldRetain(uid, false))
+	assert.Empty(t, tracker.firstSeen)
+
+	// Disk pressure overrides the retention.
+	assert.True(t, tracker.shouldRetain(uid, false))
+	assert.False(t, tracker.shouldRetain(uid, true /* underDiskPressure */))
+	assert.Empty(t, tracker.firstSeen)
+}
+
+func TestPodVolumeRetentionTrackerSweep(t *testing.T) {
+	tracker := newPodVolumeRetentionTracker(30 * time.Minute)
+	assert.True(t, tracker.shouldRetain(types.UID("gone"), false))
+	assert.True(t, tracker.shouldRetain(types.UID("still-orphaned"), false))
+
+	tracker.sweep(map[types.UID]struct{}{types.UID("still-orphaned"): {}})
+
+	assert.NotContains(t, tracker.firstSeen, types.UID("gone"))
+	assert.Contains(t, tracker.firstSeen, types.UID("still-orphaned"))
+}
+
+func TestPodVolumeRetentionPeriod(t *testing.T) {
+	assert.Equal(t, time.Duration(0), podVolumeRetentionPeriod())
+
+	featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, features.OrphanedPodVolumeRetention, true)
+	assert.Equal(t, defaultPodVolumeRetentionPeriod, podVolumeRetentionPeriod())
+}
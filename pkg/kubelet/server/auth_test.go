@@ -145,11 +145,17 @@ func AuthzTestCases(fineGrained bool) []AuthzTestCase {
 		"/pods/":                              {"proxy"},
 		"/portForward/{podNamespace}/{podID}": {"proxy"},
 		"/portForward/{podNamespace}/{podID}/{uid}":         {"proxy"},
+		"/quiesce/{podNamespace}/{podID}":                   {"proxy"},
+		"/thaw/{podNamespace}/{podID}":                      {"proxy"},
+		"/consistencygroups/{podNamespace}/{podID}":         {"proxy"},
+		"/pvcusers/{pvcUID}":                                {"proxy"},
+		"/volumeinventory/":                                 {"proxy"},
+		"/storagemaintenance/":                              {"proxy"},
 		"/run/{podNamespace}/{podID}/{containerName}":       {"proxy"},
 		"/run/{podNamespace}/{podID}/{uid}/{containerName}": {"proxy"},
-		"/runningpods/":  {"proxy"},
-		"/stats/":        {"stats"},
-		"/stats/summary": {"stats"},
+		"/runningpods/":                                     {"proxy"},
+		"/stats/":                                           {"stats"},
+		"/stats/summary":                                    {"stats"},
 	}
 
 	if fineGrained {
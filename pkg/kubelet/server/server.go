@@ -19,6 +19,7 @@ package server
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net"
@@ -89,6 +90,7 @@ import (
 	"k8s.io/kubernetes/pkg/kubelet/prober"
 	servermetrics "k8s.io/kubernetes/pkg/kubelet/server/metrics"
 	"k8s.io/kubernetes/pkg/kubelet/server/stats"
+	"k8s.io/kubernetes/pkg/kubelet/volumemanager"
 )
 
 func init() {
@@ -283,6 +285,15 @@ type HostInterface interface {
 	GetPortForward(ctx context.Context, podName, podNamespace string, podUID types.UID, portForwardOpts portforward.V4Options) (*url.URL, error)
 	ListMetricDescriptors(ctx context.Context) ([]*runtimeapi.MetricDescriptor, error)
 	ListPodSandboxMetrics(ctx context.Context) ([]*runtimeapi.PodSandboxMetrics, error)
+	QuiescePodVolumes(podUID types.UID, timeout time.Duration) error
+	ThawPodVolumes(podUID types.UID) error
+	GetVolumeConsistencyGroups(podUID types.UID) []volumemanager.VolumeConsistencyGroup
+	QuiesceConsistencyGroup(podUID types.UID, driver string, timeout time.Duration) error
+	ThawConsistencyGroup(podUID types.UID, driver string) error
+	GetPodsUsingPVC(pvcUID types.UID) []volumemanager.PVCUser
+	GetVolumeInventory() volumemanager.VolumeInventory
+	SetStorageMaintenanceMode(enabled bool)
+	GetStorageMaintenanceStatus() volumemanager.StorageMaintenanceStatus
 }
 
 // NewServer initializes and configures a kubelet.Server object to handle HTTP requests.
@@ -579,6 +590,68 @@ func (s *Server) InstallDebuggingHandlers() {
 		statusz.Install(s.restfulCont, ComponentKubelet, statusz.NewRegistry())
 	}
 
+	// The /quiesce and /thaw endpoints are called by external snapshot
+	// controllers to freeze a pod's volumes before a consistent snapshot and
+	// unfreeze them afterwards.
+	s.addMetricsBucketMatcher("quiesce")
+	ws = new(restful.WebService)
+	ws.
+		Path("/quiesce")
+	ws.Route(ws.POST("/{podNamespace}/{podID}").
+		To(s.quiescePodVolumes).
+		Operation("quiescePodVolumes"))
+	s.restfulCont.Add(ws)
+
+	s.addMetricsBucketMatcher("thaw")
+	ws = new(restful.WebService)
+	ws.
+		Path("/thaw")
+	ws.Route(ws.POST("/{podNamespace}/{podID}").
+		To(s.thawPodVolumes).
+		Operation("thawPodVolumes"))
+	s.restfulCont.Add(ws)
+
+	s.addMetricsBucketMatcher("consistencygroups")
+	ws = new(restful.WebService)
+	ws.
+		Path("/consistencygroups")
+	ws.Route(ws.GET("/{podNamespace}/{podID}").
+		To(s.getVolumeConsistencyGroups).
+		Operation("getVolumeConsistencyGroups"))
+	s.restfulCont.Add(ws)
+
+	s.addMetricsBucketMatcher("pvcusers")
+	ws = new(restful.WebService)
+	ws.
+		Path("/pvcusers")
+	ws.Route(ws.GET("/{pvcUID}").
+		To(s.getPodsUsingPVC).
+		Operation("getPodsUsingPVC"))
+	s.restfulCont.Add(ws)
+
+	s.addMetricsBucketMatcher("volumeinventory")
+	ws = new(restful.WebService)
+	ws.
+		Path("/volumeinventory").
+		Produces(restful.MIME_JSON)
+	ws.Route(ws.GET("").
+		To(s.getVolumeInventory).
+		Operation("getVolumeInventory"))
+	s.restfulCont.Add(ws)
+
+	s.addMetricsBucketMatcher("storagemaintenance")
+	ws = new(restful.WebService)
+	ws.
+		Path("/storagemaintenance").
+		Produces(restful.MIME_JSON)
+	ws.Route(ws.GET("").
+		To(s.getStorageMaintenance).
+		Operation("getStorageMaintenance"))
+	ws.Route(ws.POST("").
+		To(s.setStorageMaintenance).
+		Operation("setStorageMaintenance"))
+	s.restfulCont.Add(ws)
+
 	// The /runningpods endpoint is used for testing only.
 	s.addMetricsBucketMatcher("runningpods")
 	ws = new(restful.WebService)
@@ -846,6 +919,144 @@ func (s *Server) getRunningPods(request *restful.Request, response *restful.Resp
 	writeJSONResponse(response, data)
 }
 
+// quiescePodVolumes handles requests to freeze the mounted volumes of a pod
+// for a consistent snapshot. The optional "timeout" query parameter bounds
+// how long the volumes stay frozen without an explicit thaw; the optional
+// "driver" query parameter restricts the operation to the pod's consistency
+// group of that driver.
+func (s *Server) quiescePodVolumes(request *restful.Request, response *restful.Response) {
+	pod, ok := s.host.GetPodByName(request.PathParameter("podNamespace"), request.PathParameter("podID"))
+	if !ok {
+		response.WriteError(http.StatusNotFound, fmt.Errorf("pod does not exist"))
+		return
+	}
+	var timeout time.Duration
+	if timeoutParam := request.QueryParameter("timeout"); timeoutParam != "" {
+		var err error
+		timeout, err = time.ParseDuration(timeoutParam)
+		if err != nil {
+			response.WriteError(http.StatusBadRequest, fmt.Errorf("invalid timeout %q: %v", timeoutParam, err))
+			return
+		}
+	}
+	var err error
+	if driver := request.QueryParameter("driver"); driver != "" {
+		err = s.host.QuiesceConsistencyGroup(pod.UID, driver, timeout)
+	} else {
+		err = s.host.QuiescePodVolumes(pod.UID, timeout)
+	}
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	response.WriteHeader(http.StatusOK)
+}
+
+// thawPodVolumes handles requests to unfreeze the quiesced volumes of a pod.
+// The optional "driver" query parameter restricts the operation to the pod's
+// consistency group of that driver.
+func (s *Server) thawPodVolumes(request *restful.Request, response *restful.Response) {
+	pod, ok := s.host.GetPodByName(request.PathParameter("podNamespace"), request.PathParameter("podID"))
+	if !ok {
+		response.WriteError(http.StatusNotFound, fmt.Errorf("pod does not exist"))
+		return
+	}
+	var err error
+	if driver := request.QueryParameter("driver"); driver != "" {
+		err = s.host.ThawConsistencyGroup(pod.UID, driver)
+	} else {
+		err = s.host.ThawPodVolumes(pod.UID)
+	}
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	response.WriteHeader(http.StatusOK)
+}
+
+// getVolumeConsistencyGroups returns the mounted volumes of a pod grouped by
+// the driver serving them, for external group-snapshot controllers.
+func (s *Server) getVolumeConsistencyGroups(request *restful.Request, response *restful.Response) {
+	pod, ok := s.host.GetPodByName(request.PathParameter("podNamespace"), request.PathParameter("podID"))
+	if !ok {
+		response.WriteError(http.StatusNotFound, fmt.Errorf("pod does not exist"))
+		return
+	}
+	groups := s.host.GetVolumeConsistencyGroups(pod.UID)
+	data, err := json.Marshal(groups)
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	writeJSONResponse(response, data)
+}
+
+// getPodsUsingPVC returns the pods on this node that use the persistent
+// volume claim with the given UID, so node-level tooling such as backup
+// agents can discover the consumers of a claim without listing all pods.
+func (s *Server) getPodsUsingPVC(request *restful.Request, response *restful.Response) {
+	pvcUID := types.UID(request.PathParameter("pvcUID"))
+	if pvcUID == "" {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("missing pvcUID"))
+		return
+	}
+	users := s.host.GetPodsUsingPVC(pvcUID)
+	data, err := json.Marshal(users)
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	writeJSONResponse(response, data)
+}
+
+// getVolumeInventory returns a schema-versioned inventory of all mounts,
+// devices and CSI staging paths the kubelet believes it owns on this node, so
+// that external auditing tools can detect drift against the actual host.
+func (s *Server) getVolumeInventory(request *restful.Request, response *restful.Response) {
+	inventory := s.host.GetVolumeInventory()
+	data, err := json.Marshal(inventory)
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	writeJSONResponse(response, data)
+}
+
+// getStorageMaintenance reports whether the node is in storage maintenance
+// mode and whether the kubelet still owns any mounts.
+func (s *Server) getStorageMaintenance(request *restful.Request, response *restful.Response) {
+	status := s.host.GetStorageMaintenanceStatus()
+	data, err := json.Marshal(status)
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	writeJSONResponse(response, data)
+}
+
+// setStorageMaintenance transitions the node into or out of storage
+// maintenance mode via the required "enabled" query parameter. The updated
+// status is returned so that callers can poll the same endpoint until the
+// node reports it is drained.
+func (s *Server) setStorageMaintenance(request *restful.Request, response *restful.Response) {
+	switch request.QueryParameter("enabled") {
+	case "true":
+		s.host.SetStorageMaintenanceMode(true)
+	case "false":
+		s.host.SetStorageMaintenanceMode(false)
+	default:
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("the %q query parameter must be %q or %q", "enabled", "true", "false"))
+		return
+	}
+	status := s.host.GetStorageMaintenanceStatus()
+	data, err := json.Marshal(status)
+	if err != nil {
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	writeJSONResponse(response, data)
+}
+
 // getLogs handles logs requests against the Kubelet.
 func (s *Server) getLogs(request *restful.Request, response *restful.Response) {
 	s.host.ServeLogs(response, request.Request)
@@ -66,6 +66,7 @@ import (
 	kubeletconfiginternal "k8s.io/kubernetes/pkg/kubelet/apis/config"
 	"k8s.io/kubernetes/pkg/kubelet/cm"
 	"k8s.io/kubernetes/pkg/kubelet/server/stats"
+	"k8s.io/kubernetes/pkg/kubelet/volumemanager"
 	"k8s.io/kubernetes/pkg/volume"
 )
 
@@ -92,6 +93,8 @@ type fakeKubelet struct {
 	loopEntryTime     time.Time
 	plegHealth        bool
 	streamingRuntime  streaming.Server
+
+	storageMaintenanceEnabled bool
 }
 
 func (fk *fakeKubelet) LatestLoopEntryTime() time.Time {
@@ -153,6 +156,42 @@ func (fk *fakeKubelet) ListPodSandboxMetrics(ctx context.Context) ([]*runtimeapi
 	return nil, nil
 }
 
+func (fk *fakeKubelet) QuiescePodVolumes(podUID types.UID, timeout time.Duration) error {
+	return nil
+}
+
+func (fk *fakeKubelet) ThawPodVolumes(podUID types.UID) error {
+	return nil
+}
+
+func (fk *fakeKubelet) GetVolumeConsistencyGroups(podUID types.UID) []volumemanager.VolumeConsistencyGroup {
+	return nil
+}
+
+func (fk *fakeKubelet) QuiesceConsistencyGroup(podUID types.UID, driver string, timeout time.Duration) error {
+	return nil
+}
+
+func (fk *fakeKubelet) ThawConsistencyGroup(podUID types.UID, driver string) error {
+	return nil
+}
+
+func (fk *fakeKubelet) GetPodsUsingPVC(pvcUID types.UID) []volumemanager.PVCUser {
+	return nil
+}
+
+func (fk *fakeKubelet) GetVolumeInventory() volumemanager.VolumeInventory {
+	return volumemanager.VolumeInventory{SchemaVersion: volumemanager.VolumeInventorySchemaVersion}
+}
+
+func (fk *fakeKubelet) SetStorageMaintenanceMode(enabled bool) {
+	fk.storageMaintenanceEnabled = enabled
+}
+
+func (fk *fakeKubelet) GetStorageMaintenanceStatus() volumemanager.StorageMaintenanceStatus {
+	return volumemanager.StorageMaintenanceStatus{Enabled: fk.storageMaintenanceEnabled, Drained: fk.storageMaintenanceEnabled}
+}
+
 func (fk *fakeKubelet) SyncLoopHealthCheck(req *http.Request) error {
 	duration := fk.resyncInterval * 2
 	minDuration := time.Minute * 5
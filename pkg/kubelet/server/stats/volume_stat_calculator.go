@@ -17,6 +17,7 @@ limitations under the License.
 package stats
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -35,6 +36,10 @@ import (
 	utiltrace "k8s.io/utils/trace"
 )
 
+// volumeMetricsTimeout bounds a single stats call for volumes whose
+// MetricsProvider accepts a context.
+const volumeMetricsTimeout = time.Minute
+
 // volumeStatCalculator calculates volume metrics for a given pod periodically in the background and caches the result
 type volumeStatCalculator struct {
 	statsProvider Provider
@@ -137,6 +142,13 @@ func (s *volumeStatCalculator) calcAndStoreStats() {
 		metric, err := func() (*volume.Metrics, error) {
 			trace := utiltrace.New(fmt.Sprintf("Calculate volume metrics of %v for pod %v/%v", name, s.pod.Namespace, s.pod.Name))
 			defer trace.LogIfLong(1 * time.Second)
+			// Prefer the context-aware variant so a single misbehaving
+			// volume cannot stall stats collection for the whole pod.
+			if mp, ok := v.(volume.MetricsProviderWithContext); ok {
+				ctx, cancel := context.WithTimeout(context.Background(), volumeMetricsTimeout)
+				defer cancel()
+				return mp.GetMetricsWithContext(ctx)
+			}
 			return v.GetMetrics()
 		}()
 		if err != nil {
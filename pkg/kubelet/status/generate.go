@@ -24,6 +24,8 @@ import (
 	podutil "k8s.io/kubernetes/pkg/api/v1/pod"
 	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
 	runtimeutil "k8s.io/kubernetes/pkg/kubelet/kuberuntime/util"
+	kubetypes "k8s.io/kubernetes/pkg/kubelet/types"
+	"k8s.io/kubernetes/pkg/kubelet/volumemanager"
 )
 
 const (
@@ -39,6 +41,19 @@ const (
 	ContainersNotInitialized = "ContainersNotInitialized"
 	// ReadinessGatesNotReady says that one or more pod readiness gates are not ready.
 	ReadinessGatesNotReady = "ReadinessGatesNotReady"
+	// VolumesNotMounted says that one or more of the pod's volumes are not mounted yet.
+	VolumesNotMounted = "VolumesNotMounted"
+	// MountCircuitBreakerOpen says that mount operations for one or more of
+	// the pod's volumes are temporarily rejected by an open mount circuit
+	// breaker.
+	MountCircuitBreakerOpen = "MountCircuitBreakerOpen"
+	// VolumeRestoreInProgress says that one or more of the pod's volumes are
+	// not mounted yet because their PVCs are still being populated from a
+	// data source, e.g. restored from a VolumeSnapshot.
+	VolumeRestoreInProgress = "VolumeRestoreInProgress"
+	// MountDeadlineExceeded says that the pod's volumes did not mount within
+	// the pod's mount deadline.
+	MountDeadlineExceeded = "VolumeMountDeadlineExceeded"
 )
 
 // GenerateContainersReadyCondition returns the status of "ContainersReady" condition.
@@ -256,6 +271,82 @@ func GeneratePodReadyToStartContainersCondition(pod *v1.Pod, podStatus *kubecont
 	}
 }
 
+// GenerateVolumeMountsReadyCondition returns the status of the
+// "VolumeMountsReady" condition. The condition is true when all volumes
+// referenced by the pod's containers are mounted; its message summarizes the
+// volume manager's view of each volume.
+func GenerateVolumeMountsReadyCondition(volumeStatuses []volumemanager.VolumeMountStatus) v1.PodCondition {
+	unmountedVolumes := []string{}
+	restoreInProgress := false
+	details := make([]string, 0, len(volumeStatuses))
+	for _, volumeStatus := range volumeStatuses {
+		if !volumeStatus.Mounted {
+			unmountedVolumes = append(unmountedVolumes, volumeStatus.Name)
+			if volumeStatus.RestoreInProgressMessage != "" {
+				restoreInProgress = true
+				details = append(details, fmt.Sprintf("%s: not mounted, %s", volumeStatus.Name, volumeStatus.RestoreInProgressMessage))
+			} else {
+				details = append(details, fmt.Sprintf("%s: not mounted", volumeStatus.Name))
+			}
+			continue
+		}
+		detail := fmt.Sprintf("%s: mounted readOnly=%t", volumeStatus.Name, volumeStatus.ReadOnly)
+		if volumeStatus.SELinuxMountContext != "" {
+			detail += fmt.Sprintf(" seLinuxMountContext=%s", volumeStatus.SELinuxMountContext)
+		}
+		details = append(details, detail)
+	}
+
+	condition := v1.PodCondition{
+		Type:    kubetypes.VolumeMountsReady,
+		Status:  v1.ConditionTrue,
+		Message: strings.Join(details, ", "),
+	}
+	if len(unmountedVolumes) > 0 {
+		condition.Status = v1.ConditionFalse
+		condition.Reason = VolumesNotMounted
+		if restoreInProgress {
+			condition.Reason = VolumeRestoreInProgress
+		}
+	}
+	return condition
+}
+
+// GenerateVolumeMountsBlockedCondition returns a "VolumeMountsBlocked"
+// condition listing the pod's volumes whose mount operations are currently
+// rejected by an open mount circuit breaker. The second return value is false
+// when no volume is blocked; the condition is only set on affected pods.
+func GenerateVolumeMountsBlockedCondition(volumeStatuses []volumemanager.VolumeMountStatus) (v1.PodCondition, bool) {
+	blockedVolumes := []string{}
+	for _, volumeStatus := range volumeStatuses {
+		if volumeStatus.BlockedOnCircuitBreaker {
+			blockedVolumes = append(blockedVolumes, volumeStatus.Name)
+		}
+	}
+	if len(blockedVolumes) == 0 {
+		return v1.PodCondition{}, false
+	}
+	return v1.PodCondition{
+		Type:    kubetypes.VolumeMountsBlocked,
+		Status:  v1.ConditionTrue,
+		Reason:  MountCircuitBreakerOpen,
+		Message: fmt.Sprintf("mount operations for volumes [%s] are temporarily rejected because of a high mount error rate on this node", strings.Join(blockedVolumes, ", ")),
+	}, true
+}
+
+// GenerateVolumeMountDeadlineExceededCondition returns a
+// "VolumeMountDeadlineExceeded" condition for a pod whose volumes did not
+// mount within the pod's mount deadline. The condition is only set on
+// affected pods.
+func GenerateVolumeMountDeadlineExceededCondition(message string) v1.PodCondition {
+	return v1.PodCondition{
+		Type:    kubetypes.VolumeMountDeadlineExceeded,
+		Status:  v1.ConditionTrue,
+		Reason:  MountDeadlineExceeded,
+		Message: message,
+	}
+}
+
 func generateContainersReadyConditionForTerminalPhase(podPhase v1.PodPhase) v1.PodCondition {
 	condition := v1.PodCondition{
 		Type:   v1.ContainersReady,
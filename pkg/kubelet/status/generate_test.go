@@ -26,6 +26,8 @@ import (
 	v1 "k8s.io/api/core/v1"
 	runtimeapi "k8s.io/cri-api/pkg/apis/runtime/v1"
 	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
+	kubetypes "k8s.io/kubernetes/pkg/kubelet/types"
+	"k8s.io/kubernetes/pkg/kubelet/volumemanager"
 	"k8s.io/utils/ptr"
 )
 
@@ -622,6 +624,95 @@ func TestGeneratePodReadyToStartContainersCondition(t *testing.T) {
 	}
 }
 
+func TestGenerateVolumeMountsReadyCondition(t *testing.T) {
+	for desc, test := range map[string]struct {
+		volumeStatuses []volumemanager.VolumeMountStatus
+		expected       v1.PodCondition
+	}{
+		"No volumes": {
+			volumeStatuses: nil,
+			expected: v1.PodCondition{
+				Status: v1.ConditionTrue,
+			},
+		},
+		"All volumes mounted": {
+			volumeStatuses: []volumemanager.VolumeMountStatus{
+				{Name: "vol1", Mounted: true},
+				{Name: "vol2", Mounted: true, ReadOnly: true, SELinuxMountContext: "system_u:object_r:container_file_t:s0:c1,c2"},
+			},
+			expected: v1.PodCondition{
+				Status:  v1.ConditionTrue,
+				Message: "vol1: mounted readOnly=false, vol2: mounted readOnly=true seLinuxMountContext=system_u:object_r:container_file_t:s0:c1,c2",
+			},
+		},
+		"Volume not mounted": {
+			volumeStatuses: []volumemanager.VolumeMountStatus{
+				{Name: "vol1", Mounted: true},
+				{Name: "vol2"},
+			},
+			expected: v1.PodCondition{
+				Status:  v1.ConditionFalse,
+				Reason:  VolumesNotMounted,
+				Message: "vol1: mounted readOnly=false, vol2: not mounted",
+			},
+		},
+		"Volume restore in progress": {
+			volumeStatuses: []volumemanager.VolumeMountStatus{
+				{Name: "vol1", Mounted: true},
+				{Name: "vol2", RestoreInProgressMessage: "PVC ns/claim is being populated from its data source"},
+			},
+			expected: v1.PodCondition{
+				Status:  v1.ConditionFalse,
+				Reason:  VolumeRestoreInProgress,
+				Message: "vol1: mounted readOnly=false, vol2: not mounted, PVC ns/claim is being populated from its data source",
+			},
+		},
+	} {
+		t.Run(desc, func(t *testing.T) {
+			test.expected.Type = kubetypes.VolumeMountsReady
+			condition := GenerateVolumeMountsReadyCondition(test.volumeStatuses)
+			require.Equal(t, test.expected, condition)
+		})
+	}
+}
+
+func TestGenerateVolumeMountsBlockedCondition(t *testing.T) {
+	for desc, test := range map[string]struct {
+		volumeStatuses []volumemanager.VolumeMountStatus
+		expected       v1.PodCondition
+		expectedSet    bool
+	}{
+		"No volumes": {
+			volumeStatuses: nil,
+		},
+		"No volume blocked": {
+			volumeStatuses: []volumemanager.VolumeMountStatus{
+				{Name: "vol1", Mounted: true},
+				{Name: "vol2"},
+			},
+		},
+		"Volume blocked by open circuit breaker": {
+			volumeStatuses: []volumemanager.VolumeMountStatus{
+				{Name: "vol1", Mounted: true},
+				{Name: "vol2", BlockedOnCircuitBreaker: true},
+			},
+			expected: v1.PodCondition{
+				Type:    kubetypes.VolumeMountsBlocked,
+				Status:  v1.ConditionTrue,
+				Reason:  MountCircuitBreakerOpen,
+				Message: "mount operations for volumes [vol2] are temporarily rejected because of a high mount error rate on this node",
+			},
+			expectedSet: true,
+		},
+	} {
+		t.Run(desc, func(t *testing.T) {
+			condition, set := GenerateVolumeMountsBlockedCondition(test.volumeStatuses)
+			require.Equal(t, test.expectedSet, set)
+			require.Equal(t, test.expected, condition)
+		})
+	}
+}
+
 func getPodCondition(conditionType v1.PodConditionType, status v1.ConditionStatus, reason, message string) v1.PodCondition {
 	return v1.PodCondition{
 		Type:    conditionType,
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubelet
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// SubPathCreationPolicyAnnotation is a pod annotation controlling how
+	// missing subPath directories are created, per volumeMount name. The
+	// value is a comma-separated list of "<volumeMountName>=<policy>"
+	// entries. Policy "never" fails the mount instead of auto-creating the
+	// missing subPath; policy "owned" creates it owned by the container's
+	// runAsUser and the pod's fsGroup instead of root. VolumeMounts without
+	// an entry keep the default behavior of creating the subPath with the
+	// volume root's mode.
+	SubPathCreationPolicyAnnotation = "volumes.kubernetes.io/subpath-creation-policy"
+
+	// subPathCreatePolicyNever fails the mount when the subPath is missing.
+	subPathCreatePolicyNever = "never"
+	// subPathCreatePolicyOwned creates the missing subPath owned by the
+	// container's runAsUser and the pod's fsGroup.
+	subPathCreatePolicyOwned = "owned"
+)
+
+// subPathCreationPolicy returns the creation policy of the given volumeMount,
+// or the empty string for the default behavior. Unknown policies are ignored
+// with a log so that a typo does not fail otherwise valid pods.
+func subPathCreationPolicy(pod *v1.Pod, volumeMountName string) string {
+	value, ok := pod.Annotations[SubPathCreationPolicyAnnotation]
+	if !ok {
+		return ""
+	}
+	for _, entry := range strings.Split(value, ",") {
+		name, policy, found := strings.Cut(strings.TrimSpace(entry), "=")
+		if !found || name != volumeMountName {
+			continue
+		}
+		switch policy {
+		case subPathCreatePolicyNever, subPathCreatePolicyOwned:
+			return policy
+		default:
+			klog.InfoS("Ignoring unknown subPath creation policy", "pod", klog.KObj(pod), "volumeMountName", volumeMountName, "policy", policy)
+			return ""
+		}
+	}
+	return ""
+}
+
+// subPathOwnership derives the owner of an auto-created subPath directory
+// from the container's effective runAsUser and the pod's fsGroup. Unset
+// values are returned as -1, which leaves the respective id unchanged in
+// chown.
+func subPathOwnership(pod *v1.Pod, container *v1.Container) (uid, gid int64) {
+	uid, gid = -1, -1
+	if pod.Spec.SecurityContext != nil {
+		if pod.Spec.SecurityContext.RunAsUser != nil {
+			uid = *pod.Spec.SecurityContext.RunAsUser
+		}
+		if pod.Spec.SecurityContext.FSGroup != nil {
+			gid = *pod.Spec.SecurityContext.FSGroup
+		}
+	}
+	if container.SecurityContext != nil && container.SecurityContext.RunAsUser != nil {
+		uid = *container.SecurityContext.RunAsUser
+	}
+	return uid, gid
+}
+
+// applySubPathOwnership chowns the freshly created subPath directory to the
+// owner derived from the pod's security context, and widens its mode to
+// g+rwx when an fsGroup is set so that the group can actually use it.
+func applySubPathOwnership(subPathDir string, pod *v1.Pod, container *v1.Container) error {
+	uid, gid := subPathOwnership(pod, container)
+	if uid == -1 && gid == -1 {
+		return nil
+	}
+	if err := os.Chown(subPathDir, int(uid), int(gid)); err != nil {
+		return fmt.Errorf("chown of subPath directory failed: %w", err)
+	}
+	mode := os.FileMode(0750)
+	if gid != -1 {
+		mode = 0770
+	}
+	if err := os.Chmod(subPathDir, mode); err != nil {
+		return fmt.Errorf("chmod of subPath directory failed: %w", err)
+	}
+	return nil
+}
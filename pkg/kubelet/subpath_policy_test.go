@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubelet
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestSubPathCreationPolicy(t *testing.T) {
+	tests := []struct {
+		name            string
+		annotation      string
+		volumeMountName string
+		expectedPolicy  string
+	}{
+		{
+			name:            "no annotation",
+			volumeMountName: "data",
+			expectedPolicy:  "",
+		},
+		{
+			name:            "never policy",
+			annotation:      "data=never",
+			volumeMountName: "data",
+			expectedPolicy:  "never",
+		},
+		{
+			name:            "owned policy in a list",
+			annotation:      "logs=never, data=owned",
+			volumeMountName: "data",
+			expectedPolicy:  "owned",
+		},
+		{
+			name:            "volumeMount without an entry",
+			annotation:      "logs=never",
+			volumeMountName: "data",
+			expectedPolicy:  "",
+		},
+		{
+			name:            "unknown policy is ignored",
+			annotation:      "data=sometimes",
+			volumeMountName: "data",
+			expectedPolicy:  "",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod1", Namespace: "ns"}}
+			if tc.annotation != "" {
+				pod.Annotations = map[string]string{SubPathCreationPolicyAnnotation: tc.annotation}
+			}
+			if policy := subPathCreationPolicy(pod, tc.volumeMountName); policy != tc.expectedPolicy {
+				t.Errorf("expected policy %q, got %q", tc.expectedPolicy, policy)
+			}
+		})
+	}
+}
+
+func TestSubPathOwnership(t *testing.T) {
+	pod := &v1.Pod{
+		Spec: v1.PodSpec{
+			SecurityContext: &v1.PodSecurityContext{
+				RunAsUser: ptr.To[int64](1000),
+				FSGroup:   ptr.To[int64](2000),
+			},
+		},
+	}
+	container := &v1.Container{Name: "container1"}
+
+	uid, gid := subPathOwnership(pod, container)
+	if uid != 1000 || gid != 2000 {
+		t.Errorf("expected uid 1000 and gid 2000, got %d and %d", uid, gid)
+	}
+
+	// The container-level runAsUser overrides the pod-level one.
+	container.SecurityContext = &v1.SecurityContext{RunAsUser: ptr.To[int64](1001)}
+	uid, gid = subPathOwnership(pod, container)
+	if uid != 1001 || gid != 2000 {
+		t.Errorf("expected uid 1001 and gid 2000, got %d and %d", uid, gid)
+	}
+
+	// Unset values map to -1, leaving the id unchanged in chown.
+	uid, gid = subPathOwnership(&v1.Pod{}, &v1.Container{})
+	if uid != -1 || gid != -1 {
+		t.Errorf("expected uid and gid -1, got %d and %d", uid, gid)
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubelet
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/third_party/forked/golang/expansion"
+)
+
+// Sources an environment variable referenced by a subPathExpr can come from.
+// Literal values and downward API fields are static for the lifetime of the
+// pod; configmap-backed values are re-expanded whenever the container
+// (re)starts, so changes take effect on the next restart; secret-backed
+// values are rejected because expanding them into a host path would leak
+// secret material into mount paths and logs.
+const (
+	subPathExprSourceLiteral       = "literal"
+	subPathExprSourceFieldRef      = "fieldRef"
+	subPathExprSourceResourceField = "resourceFieldRef"
+	subPathExprSourceConfigMap     = "configMap"
+	subPathExprSourceSecret        = "secret"
+	subPathExprSourceEnvFrom       = "envFrom"
+	subPathExprSourceUndefined     = "undefined"
+)
+
+// subPathExprEnvDependencies returns the names of the environment variables
+// the given subPathExpr references via $(VAR) expansion.
+func subPathExprEnvDependencies(subPathExpr string) sets.Set[string] {
+	names := sets.New[string]()
+	expansion.Expand(subPathExpr, func(name string) string {
+		names.Insert(name)
+		return ""
+	})
+	return names
+}
+
+// subPathExprEnvSource classifies where the named environment variable of the
+// container comes from.
+func subPathExprEnvSource(container *v1.Container, name string) string {
+	for _, envVar := range container.Env {
+		if envVar.Name != name {
+			continue
+		}
+		if envVar.ValueFrom == nil {
+			return subPathExprSourceLiteral
+		}
+		switch {
+		case envVar.ValueFrom.FieldRef != nil:
+			return subPathExprSourceFieldRef
+		case envVar.ValueFrom.ResourceFieldRef != nil:
+			return subPathExprSourceResourceField
+		case envVar.ValueFrom.ConfigMapKeyRef != nil:
+			return subPathExprSourceConfigMap
+		case envVar.ValueFrom.SecretKeyRef != nil:
+			return subPathExprSourceSecret
+		}
+	}
+	// Variables injected via envFrom cannot be attributed to a single source
+	// without resolving the referenced objects; report whether any envFrom
+	// entry is a secret so that callers can reject those conservatively.
+	for _, envFrom := range container.EnvFrom {
+		if envFrom.SecretRef != nil {
+			return subPathExprSourceSecret
+		}
+	}
+	if len(container.EnvFrom) > 0 {
+		return subPathExprSourceEnvFrom
+	}
+	return subPathExprSourceUndefined
+}
+
+// checkSubPathExprDependencies verifies that every environment variable the
+// volumeMount's subPathExpr depends on comes from a source supported for
+// subPath expansion. Secret-backed variables are rejected; configmap-backed
+// variables are allowed and re-expanded each time the container (re)starts.
+func checkSubPathExprDependencies(pod *v1.Pod, container *v1.Container, mount v1.VolumeMount) error {
+	for _, name := range sets.List(subPathExprEnvDependencies(mount.SubPathExpr)) {
+		source := subPathExprEnvSource(container, name)
+		switch source {
+		case subPathExprSourceSecret:
+			return fmt.Errorf("subPathExpr of volumeMount %q of container %q references environment variable %q backed by a Secret, which is not supported for subPath expansion", mount.Name, container.Name, name)
+		case subPathExprSourceConfigMap, subPathExprSourceEnvFrom:
+			klog.V(4).InfoS("subPathExpr depends on a configmap-backed environment variable; changes take effect when the container restarts", "pod", klog.KObj(pod), "containerName", container.Name, "volumeMountName", mount.Name, "envVar", name, "source", source)
+		default:
+			klog.V(5).InfoS("subPathExpr environment variable dependency", "pod", klog.KObj(pod), "containerName", container.Name, "volumeMountName", mount.Name, "envVar", name, "source", source)
+		}
+	}
+	return nil
+}
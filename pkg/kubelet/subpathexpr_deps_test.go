@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubelet
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func TestSubPathExprEnvDependencies(t *testing.T) {
+	deps := subPathExprEnvDependencies("$(POD_NAME)/logs/$(NODE_NAME)")
+	expected := sets.New("POD_NAME", "NODE_NAME")
+	if !deps.Equal(expected) {
+		t.Errorf("expected dependencies %v, got %v", sets.List(expected), sets.List(deps))
+	}
+	if deps := subPathExprEnvDependencies("static/path"); deps.Len() != 0 {
+		t.Errorf("expected no dependencies, got %v", sets.List(deps))
+	}
+}
+
+func TestSubPathExprEnvSource(t *testing.T) {
+	container := &v1.Container{
+		Name: "container1",
+		Env: []v1.EnvVar{
+			{Name: "LITERAL", Value: "value"},
+			{
+				Name: "POD_NAME",
+				ValueFrom: &v1.EnvVarSource{
+					FieldRef: &v1.ObjectFieldSelector{FieldPath: "metadata.name"},
+				},
+			},
+			{
+				Name: "FROM_CONFIGMAP",
+				ValueFrom: &v1.EnvVarSource{
+					ConfigMapKeyRef: &v1.ConfigMapKeySelector{Key: "key"},
+				},
+			},
+			{
+				Name: "FROM_SECRET",
+				ValueFrom: &v1.EnvVarSource{
+					SecretKeyRef: &v1.SecretKeySelector{Key: "key"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		envVar   string
+		expected string
+	}{
+		{envVar: "LITERAL", expected: subPathExprSourceLiteral},
+		{envVar: "POD_NAME", expected: subPathExprSourceFieldRef},
+		{envVar: "FROM_CONFIGMAP", expected: subPathExprSourceConfigMap},
+		{envVar: "FROM_SECRET", expected: subPathExprSourceSecret},
+		{envVar: "UNKNOWN", expected: subPathExprSourceUndefined},
+	}
+	for _, tc := range tests {
+		if source := subPathExprEnvSource(container, tc.envVar); source != tc.expected {
+			t.Errorf("expected source of %q to be %q, got %q", tc.envVar, tc.expected, source)
+		}
+	}
+}
+
+func TestCheckSubPathExprDependencies(t *testing.T) {
+	pod := &v1.Pod{}
+	container := &v1.Container{
+		Name: "container1",
+		Env: []v1.EnvVar{
+			{
+				Name: "POD_NAME",
+				ValueFrom: &v1.EnvVarSource{
+					FieldRef: &v1.ObjectFieldSelector{FieldPath: "metadata.name"},
+				},
+			},
+			{
+				Name: "FROM_SECRET",
+				ValueFrom: &v1.EnvVarSource{
+					SecretKeyRef: &v1.SecretKeySelector{Key: "key"},
+				},
+			},
+		},
+	}
+
+	mount := v1.VolumeMount{Name: "data", SubPathExpr: "$(POD_NAME)"}
+	if err := checkSubPathExprDependencies(pod, container, mount); err != nil {
+		t.Errorf("expected downward API dependency to be allowed, got %v", err)
+	}
+
+	mount.SubPathExpr = "$(FROM_SECRET)"
+	if err := checkSubPathExprDependencies(pod, container, mount); err == nil {
+		t.Errorf("expected a secret-backed dependency to be rejected")
+	}
+}
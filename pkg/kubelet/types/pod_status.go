@@ -22,6 +22,23 @@ import (
 	"k8s.io/kubernetes/pkg/features"
 )
 
+// VolumeMountsReady is a kubelet-owned pod condition that reports the volume
+// manager's per-volume mount status. It is only set when the
+// VolumeMountsReadyCondition feature is enabled.
+const VolumeMountsReady v1.PodConditionType = "VolumeMountsReady"
+
+// VolumeMountsBlocked is a kubelet-owned pod condition set on pods for which
+// new mount operations are currently rejected because the mount circuit
+// breaker of one of their volume plugins is open. It is only present on
+// affected pods.
+const VolumeMountsBlocked v1.PodConditionType = "VolumeMountsBlocked"
+
+// VolumeMountDeadlineExceeded is a kubelet-owned pod condition set on pods
+// whose volumes did not mount within the pod's mount deadline. Job pod
+// failure policies can match it via onPodConditions. It is only present on
+// affected pods.
+const VolumeMountDeadlineExceeded v1.PodConditionType = "VolumeMountDeadlineExceeded"
+
 // PodConditionsByKubelet is the list of pod conditions owned by kubelet
 var PodConditionsByKubelet = []v1.PodConditionType{
 	v1.PodScheduled,
@@ -42,6 +59,17 @@ func PodConditionByKubelet(conditionType v1.PodConditionType) bool {
 			return true
 		}
 	}
+	if utilfeature.DefaultFeatureGate.Enabled(features.VolumeMountsReadyCondition) {
+		if conditionType == VolumeMountsReady {
+			return true
+		}
+	}
+	if conditionType == VolumeMountsBlocked {
+		return true
+	}
+	if conditionType == VolumeMountDeadlineExceeded {
+		return true
+	}
 	return false
 }
 
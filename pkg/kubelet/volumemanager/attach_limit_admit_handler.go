@@ -0,0 +1,221 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumemanager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/kubelet/lifecycle"
+	"k8s.io/kubernetes/pkg/kubelet/volumemanager/populator"
+	csiplugin "k8s.io/kubernetes/pkg/volume/csi"
+)
+
+const (
+	// ExceededNodeAttachLimit is the reason used to reject pods whose CSI
+	// volumes cannot be attached because the node's attach limit is already
+	// exhausted.
+	ExceededNodeAttachLimit = "ExceededNodeAttachLimit"
+
+	// attachLimitAdmitTimeout bounds the API calls made during a single
+	// admission so that a slow or unreachable API server cannot stall pod
+	// admission; on timeout the check fails open.
+	attachLimitAdmitTimeout = 5 * time.Second
+)
+
+// attachLimitAdmitHandler rejects pods whose CSI volumes would exceed the
+// attach limits the node published in its CSINode object. Without this check
+// a pod bound to a node with an exhausted attach limit (e.g. because the
+// scheduler raced with another attach) waits forever on WaitForAttach.
+// Rejecting the pod with a clear reason lets the scheduler place it
+// elsewhere. All lookups fail open: the pod is admitted when the required
+// information is not available.
+type attachLimitAdmitHandler struct {
+	kubeClient    clientset.Interface
+	nodeName      k8stypes.NodeName
+	volumeManager VolumeManager
+	// objectCache optionally serves PVC and PV lookups from informer
+	// caches; nil when the feature is disabled.
+	objectCache *populator.ObjectCache
+}
+
+var _ lifecycle.PodAdmitHandler = &attachLimitAdmitHandler{}
+
+// NewAttachLimitAdmitHandler returns a PodAdmitHandler that fails pods early
+// when the node's CSINode allocatable volume counts are already exhausted.
+func NewAttachLimitAdmitHandler(
+	kubeClient clientset.Interface,
+	nodeName k8stypes.NodeName,
+	volumeManager VolumeManager,
+	objectCache *populator.ObjectCache) lifecycle.PodAdmitHandler {
+	return &attachLimitAdmitHandler{
+		kubeClient:    kubeClient,
+		nodeName:      nodeName,
+		volumeManager: volumeManager,
+		objectCache:   objectCache,
+	}
+}
+
+func (h *attachLimitAdmitHandler) Admit(attrs *lifecycle.PodAdmitAttributes) lifecycle.PodAdmitResult {
+	if h.kubeClient == nil {
+		// Standalone mode, no CSINode object to consult.
+		return lifecycle.PodAdmitResult{Admit: true}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), attachLimitAdmitTimeout)
+	defer cancel()
+
+	pod := attrs.Pod
+	podVolumesByDriver := h.csiVolumesByDriver(ctx, pod)
+	if len(podVolumesByDriver) == 0 {
+		return lifecycle.PodAdmitResult{Admit: true}
+	}
+
+	csiNode, err := h.kubeClient.StorageV1().CSINodes().Get(ctx, string(h.nodeName), metav1.GetOptions{})
+	if err != nil {
+		klog.V(4).InfoS("Could not get CSINode object, skipping attach limit admission check", "node", h.nodeName, "err", err)
+		return lifecycle.PodAdmitResult{Admit: true}
+	}
+
+	// Count unique CSI volumes already in use on this node, per driver.
+	// Volumes shared by multiple pods are counted once, and volumes of the
+	// admitted pod that are already in use do not consume additional
+	// attachments.
+	inUseByDriver := map[string]sets.Set[string]{}
+	for _, uniqueVolumeName := range h.volumeManager.GetVolumesInUse() {
+		driverName, volumeHandle, ok := parseCSIUniqueVolumeName(uniqueVolumeName)
+		if !ok {
+			continue
+		}
+		if inUseByDriver[driverName] == nil {
+			inUseByDriver[driverName] = sets.New[string]()
+		}
+		inUseByDriver[driverName].Insert(volumeHandle)
+	}
+
+	for _, driver := range csiNode.Spec.Drivers {
+		if driver.Allocatable == nil || driver.Allocatable.Count == nil {
+			continue
+		}
+		podVolumes, found := podVolumesByDriver[driver.Name]
+		if !found {
+			continue
+		}
+		inUseVolumes := inUseByDriver[driver.Name]
+		newVolumes := podVolumes.Difference(inUseVolumes)
+		limit := int(*driver.Allocatable.Count)
+		if inUseVolumes.Len()+newVolumes.Len() > limit {
+			message := fmt.Sprintf(
+				"Pod requires %d new volume(s) of CSI driver %q but the node attach limit is %d and %d volume(s) are already in use",
+				newVolumes.Len(), driver.Name, limit, inUseVolumes.Len())
+			klog.V(2).InfoS("Failed to admit pod, node attach limit exceeded", "pod", klog.KObj(pod), "driver", driver.Name, "message", message)
+			return lifecycle.PodAdmitResult{
+				Admit:   false,
+				Reason:  ExceededNodeAttachLimit,
+				Message: message,
+			}
+		}
+	}
+
+	return lifecycle.PodAdmitResult{Admit: true}
+}
+
+// csiVolumesByDriver resolves the pod's volumes to sets of CSI volume handles
+// keyed by driver name. Volumes that cannot be resolved (e.g. a PVC that is
+// not bound yet) are ignored so that admission fails open.
+func (h *attachLimitAdmitHandler) csiVolumesByDriver(ctx context.Context, pod *v1.Pod) map[string]sets.Set[string] {
+	volumesByDriver := map[string]sets.Set[string]{}
+	addVolume := func(driverName, volumeHandle string) {
+		if volumesByDriver[driverName] == nil {
+			volumesByDriver[driverName] = sets.New[string]()
+		}
+		volumesByDriver[driverName].Insert(volumeHandle)
+	}
+
+	for _, podVolume := range pod.Spec.Volumes {
+		switch {
+		case podVolume.CSI != nil:
+			// Inline volumes have no volume handle before they are mounted;
+			// they are unique per pod by definition.
+			addVolume(podVolume.CSI.Driver, fmt.Sprintf("%s/%s", pod.UID, podVolume.Name))
+		case podVolume.PersistentVolumeClaim != nil:
+			h.addVolumeFromClaim(ctx, pod.Namespace, podVolume.PersistentVolumeClaim.ClaimName, addVolume)
+		case podVolume.Ephemeral != nil:
+			h.addVolumeFromClaim(ctx, pod.Namespace, pod.Name+"-"+podVolume.Name, addVolume)
+		}
+	}
+	return volumesByDriver
+}
+
+func (h *attachLimitAdmitHandler) addVolumeFromClaim(ctx context.Context, namespace, claimName string, addVolume func(driverName, volumeHandle string)) {
+	claim, err := h.getPVC(ctx, namespace, claimName)
+	if err != nil || claim.Spec.VolumeName == "" {
+		return
+	}
+	pv, err := h.getPV(ctx, claim.Spec.VolumeName)
+	if err != nil || pv.Spec.CSI == nil {
+		return
+	}
+	addVolume(pv.Spec.CSI.Driver, pv.Spec.CSI.VolumeHandle)
+}
+
+// getPVC returns the PVC with the given namespace and name, served from the
+// informer cache when available and falling back to a direct GET bounded by
+// the admission context.
+func (h *attachLimitAdmitHandler) getPVC(ctx context.Context, namespace, claimName string) (*v1.PersistentVolumeClaim, error) {
+	if h.objectCache != nil {
+		if pvc, ok := h.objectCache.GetPVC(namespace, claimName); ok {
+			return pvc, nil
+		}
+	}
+	return h.kubeClient.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, claimName, metav1.GetOptions{})
+}
+
+// getPV returns the PV with the given name, served from the informer cache
+// when available and falling back to a direct GET bounded by the admission
+// context.
+func (h *attachLimitAdmitHandler) getPV(ctx context.Context, name string) (*v1.PersistentVolume, error) {
+	if h.objectCache != nil {
+		if pv, ok := h.objectCache.GetPV(name); ok {
+			return pv, nil
+		}
+	}
+	return h.kubeClient.CoreV1().PersistentVolumes().Get(ctx, name, metav1.GetOptions{})
+}
+
+// parseCSIUniqueVolumeName splits a unique volume name of the form
+// kubernetes.io/csi/<driver>^<volume handle> as generated for CSI volumes.
+func parseCSIUniqueVolumeName(uniqueVolumeName v1.UniqueVolumeName) (driverName, volumeHandle string, ok bool) {
+	prefix := csiplugin.CSIPluginName + "/"
+	name := string(uniqueVolumeName)
+	if !strings.HasPrefix(name, prefix) {
+		return "", "", false
+	}
+	driverName, volumeHandle, found := strings.Cut(strings.TrimPrefix(name, prefix), "^")
+	if !found || driverName == "" || volumeHandle == "" {
+		return "", "", false
+	}
+	return driverName, volumeHandle, true
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumemanager
+
+import (
+	"fmt"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/kubernetes/pkg/kubelet/lifecycle"
+)
+
+const testAttachLimitNodeName = "attach-limit-node"
+
+func TestAttachLimitAdmitHandler(t *testing.T) {
+	tests := []struct {
+		name         string
+		pod          *v1.Pod
+		csiNode      *storagev1.CSINode
+		volumesInUse []v1.UniqueVolumeName
+		expectAdmit  bool
+		expectReason string
+	}{
+		{
+			name:        "pod without CSI volumes is admitted",
+			pod:         attachLimitPod("pod1", v1.Volume{Name: "empty", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}}),
+			csiNode:     attachLimitCSINode("test-driver", 1),
+			expectAdmit: true,
+		},
+		{
+			name:        "missing CSINode fails open",
+			pod:         attachLimitPodWithClaims("pod1", "claim-a"),
+			expectAdmit: true,
+		},
+		{
+			name:    "pod within attach limit is admitted",
+			pod:     attachLimitPodWithClaims("pod1", "claim-a"),
+			csiNode: attachLimitCSINode("test-driver", 2),
+			volumesInUse: []v1.UniqueVolumeName{
+				"kubernetes.io/csi/test-driver^handle-other",
+			},
+			expectAdmit: true,
+		},
+		{
+			name:    "pod exceeding attach limit is rejected",
+			pod:     attachLimitPodWithClaims("pod1", "claim-a"),
+			csiNode: attachLimitCSINode("test-driver", 2),
+			volumesInUse: []v1.UniqueVolumeName{
+				"kubernetes.io/csi/test-driver^handle-one",
+				"kubernetes.io/csi/test-driver^handle-two",
+			},
+			expectAdmit:  false,
+			expectReason: ExceededNodeAttachLimit,
+		},
+		{
+			name:    "volumes already in use by the pod are not double counted",
+			pod:     attachLimitPodWithClaims("pod1", "claim-a"),
+			csiNode: attachLimitCSINode("test-driver", 1),
+			volumesInUse: []v1.UniqueVolumeName{
+				"kubernetes.io/csi/test-driver^handle-claim-a",
+			},
+			expectAdmit: true,
+		},
+		{
+			name:    "limits of other drivers do not apply",
+			pod:     attachLimitPodWithClaims("pod1", "claim-a"),
+			csiNode: attachLimitCSINode("other-driver", 0),
+			volumesInUse: []v1.UniqueVolumeName{
+				"kubernetes.io/csi/other-driver^handle-one",
+			},
+			expectAdmit: true,
+		},
+		{
+			name:        "driver without allocatable count is ignored",
+			pod:         attachLimitPodWithClaims("pod1", "claim-a"),
+			csiNode:     attachLimitCSINode("test-driver", -1),
+			expectAdmit: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			objects := []runtime.Object{}
+			if test.csiNode != nil {
+				objects = append(objects, test.csiNode)
+			}
+			for _, podVolume := range test.pod.Spec.Volumes {
+				if podVolume.PersistentVolumeClaim == nil {
+					continue
+				}
+				claimName := podVolume.PersistentVolumeClaim.ClaimName
+				objects = append(objects, attachLimitClaimAndPV(test.pod.Namespace, claimName)...)
+			}
+			kubeClient := fake.NewSimpleClientset(objects...)
+			handler := NewAttachLimitAdmitHandler(kubeClient, testAttachLimitNodeName, NewFakeVolumeManager(test.volumesInUse, 0, nil), nil)
+
+			result := handler.Admit(&lifecycle.PodAdmitAttributes{Pod: test.pod})
+			if result.Admit != test.expectAdmit {
+				t.Errorf("expected admit=%t, got admit=%t (reason=%q message=%q)", test.expectAdmit, result.Admit, result.Reason, result.Message)
+			}
+			if !test.expectAdmit && result.Reason != test.expectReason {
+				t.Errorf("expected reason %q, got %q", test.expectReason, result.Reason)
+			}
+		})
+	}
+}
+
+func attachLimitPod(name string, volumes ...v1.Volume) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "ns",
+			UID:       "pod-uid",
+		},
+		Spec: v1.PodSpec{
+			Volumes: volumes,
+		},
+	}
+}
+
+func attachLimitPodWithClaims(name string, claimNames ...string) *v1.Pod {
+	volumes := []v1.Volume{}
+	for _, claimName := range claimNames {
+		volumes = append(volumes, v1.Volume{
+			Name: "vol-" + claimName,
+			VolumeSource: v1.VolumeSource{
+				PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+					ClaimName: claimName,
+				},
+			},
+		})
+	}
+	return attachLimitPod(name, volumes...)
+}
+
+// attachLimitCSINode returns a CSINode with the given attach limit for the
+// driver. A negative count produces a driver without allocatable counts.
+func attachLimitCSINode(driverName string, count int32) *storagev1.CSINode {
+	driver := storagev1.CSINodeDriver{
+		Name:   driverName,
+		NodeID: testAttachLimitNodeName,
+	}
+	if count >= 0 {
+		driver.Allocatable = &storagev1.VolumeNodeResources{Count: &count}
+	}
+	return &storagev1.CSINode{
+		ObjectMeta: metav1.ObjectMeta{Name: testAttachLimitNodeName},
+		Spec: storagev1.CSINodeSpec{
+			Drivers: []storagev1.CSINodeDriver{driver},
+		},
+	}
+}
+
+func attachLimitClaimAndPV(namespace, claimName string) []runtime.Object {
+	pvName := fmt.Sprintf("pv-%s", claimName)
+	return []runtime.Object{
+		&v1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: claimName, Namespace: namespace},
+			Spec:       v1.PersistentVolumeClaimSpec{VolumeName: pvName},
+		},
+		&v1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: pvName},
+			Spec: v1.PersistentVolumeSpec{
+				PersistentVolumeSource: v1.PersistentVolumeSource{
+					CSI: &v1.CSIPersistentVolumeSource{
+						Driver:       "test-driver",
+						VolumeHandle: "handle-" + claimName,
+					},
+				},
+			},
+		},
+	}
+}
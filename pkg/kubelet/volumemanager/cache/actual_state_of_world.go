@@ -22,7 +22,11 @@ package cache
 
 import (
 	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"sync"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -144,6 +148,19 @@ type ActualStateOfWorld interface {
 	// those that are in VolumeMounted state and VolumeMountUncertain state.
 	GetAllMountedVolumes() []MountedVolume
 
+	// GetDependentMounts returns the volumes whose mount paths are nested
+	// inside the mount path of the given volume of the given pod, e.g. a
+	// hostPath volume pointing into another volume's mount point. Such
+	// volumes must be unmounted before the given volume to avoid leaking
+	// or tearing down mounts underneath an active mount point.
+	GetDependentMounts(podName volumetypes.UniquePodName, volumeName v1.UniqueVolumeName) []v1.UniqueVolumeName
+
+	// PopRemountedVolumes drains and returns the list of volumes that were
+	// remounted for a pod after their mount state had become uncertain, e.g.
+	// after recovery from a mount failure. Each remount is reported exactly
+	// once.
+	PopRemountedVolumes() []RemountedVolume
+
 	// GetMountedVolumesForPod generates and returns a list of volumes that are
 	// successfully attached and mounted for the specified pod based on the
 	// current actual state of the world.
@@ -181,6 +198,15 @@ type ActualStateOfWorld interface {
 	// is false.
 	GetAttachedVolume(volumeName v1.UniqueVolumeName) (AttachedVolume, bool)
 
+	// GetVolumesByBackingDevice groups attached volumes by the physical
+	// device backing them, derived from their attachment device path with
+	// partition suffixes stripped. Volumes without a known device path are
+	// omitted. A device backing more than one volume indicates PVs carved
+	// from shared storage (e.g. an LVM pool or partitions of a SAN LUN),
+	// whose stats and throttling should be attributed to the device rather
+	// than the individual volumes.
+	GetVolumesByBackingDevice() map[string][]v1.UniqueVolumeName
+
 	// Add the specified volume to ASW as uncertainly attached.
 	AddAttachUncertainReconstructedVolume(volumeName v1.UniqueVolumeName, volumeSpec *volume.Spec, nodeName types.NodeName, devicePath string) error
 
@@ -192,6 +218,71 @@ type ActualStateOfWorld interface {
 
 	// UpdateReconstructedVolumeAttachability updates volume attachability from the API server.
 	UpdateReconstructedVolumeAttachability(volumeName v1.UniqueVolumeName, volumeAttachable bool)
+
+	// MarkVolumeAsQuiesced marks the given volume of the given pod as quiesced
+	// (frozen for a consistent snapshot) until it is thawed or the safety
+	// timeout elapses. An error is returned when the volume is not mounted for
+	// the pod.
+	MarkVolumeAsQuiesced(podName volumetypes.UniquePodName, volumeName v1.UniqueVolumeName, timeout time.Duration) error
+
+	// MarkVolumeAsThawed clears the quiesced state of the given volume of the
+	// given pod. It is a no-op when the volume is not quiesced or not mounted.
+	MarkVolumeAsThawed(podName volumetypes.UniquePodName, volumeName v1.UniqueVolumeName)
+
+	// GetQuiescedVolumesForPod returns the volumes of the given pod that are
+	// marked as quiesced. Enforcing the safety timeout is up to the caller.
+	GetQuiescedVolumesForPod(podName volumetypes.UniquePodName) []MountedVolume
+
+	// MarkVolumeUnhealthy records that the given volume is no longer backed by
+	// a healthy device, e.g. because the device was hot-unplugged from the
+	// node. The reason is surfaced on the AttachedVolume returned by the
+	// getters. If a volume with the name volumeName does not exist in the list
+	// of attached volumes, an error is returned.
+	MarkVolumeUnhealthy(volumeName v1.UniqueVolumeName, reason string) error
+
+	// GetUnhealthyVolumes returns the attached volumes that were marked
+	// unhealthy with MarkVolumeUnhealthy.
+	GetUnhealthyVolumes() []AttachedVolume
+
+	// MarkVolumeSpecMissing records that the API objects backing the given
+	// mounted volume of the given pod (its PV or PVC) no longer exist. The
+	// volume stays mounted and the reconciler must not clean it up until the
+	// pod terminates. An error is returned when the volume is not mounted for
+	// the pod.
+	MarkVolumeSpecMissing(podName volumetypes.UniquePodName, volumeName v1.UniqueVolumeName) error
+
+	// PodVolumeSpecMissing returns true when the given volume of the given
+	// pod was marked with MarkVolumeSpecMissing and the mark was not cleared
+	// yet.
+	PodVolumeSpecMissing(podName volumetypes.UniquePodName, volumeName v1.UniqueVolumeName) bool
+
+	// ClearVolumeSpecMissing clears the spec-missing marks of all volumes
+	// mounted for the given pod, allowing the reconciler to clean them up.
+	ClearVolumeSpecMissing(podName volumetypes.UniquePodName)
+
+	// GetSpecMissingPods returns the pods that have at least one mounted
+	// volume marked with MarkVolumeSpecMissing.
+	GetSpecMissingPods() []volumetypes.UniquePodName
+
+	// UpdateVolumeAttributesClass records the VolumeAttributesClass the given
+	// volume currently has. It returns the previously recorded class name and
+	// whether the recorded value changed. It is a no-op returning ("", false)
+	// when the volume is not attached.
+	UpdateVolumeAttributesClass(volumeName v1.UniqueVolumeName, className string) (string, bool)
+
+	// MarkRemountRequiredForVolume marks the given volume of the given pod as
+	// requiring a remount regardless of whether its plugin requires remounts,
+	// e.g. to renegotiate mount options after a VolumeAttributesClass change.
+	// It is a no-op when the volume is not mounted for the pod.
+	MarkRemountRequiredForVolume(podName volumetypes.UniquePodName, volumeName v1.UniqueVolumeName)
+
+	// SubscribeVolumeEvents registers a subscriber for typed notifications
+	// about volume state transitions (volumes mounted/unmounted for pods,
+	// devices globally mounted/unmounted). The returned cancel function drops
+	// the subscription and closes the channel. Delivery is best effort: when
+	// the subscriber's buffer of the given size is full, events are dropped
+	// rather than blocking state updates.
+	SubscribeVolumeEvents(bufferSize int) (<-chan VolumeEvent, func())
 }
 
 // MountedVolume represents a volume that has successfully been mounted to a pod.
@@ -210,6 +301,11 @@ type AttachedVolume struct {
 	// (via -o context=XYZ mount option). If empty, the volume is not mounted with
 	// "-o context=".
 	SELinuxMountContext string
+
+	// UnhealthyReason explains why the volume was marked unhealthy, e.g.
+	// because its backing device was hot-unplugged from the node. Empty for
+	// healthy volumes.
+	UnhealthyReason string
 }
 
 // DeviceMayBeMounted returns true if device is mounted in global path or is in
@@ -227,8 +323,10 @@ func NewActualStateOfWorld(
 		nodeName:                        nodeName,
 		attachedVolumes:                 make(map[v1.UniqueVolumeName]attachedVolume),
 		foundDuringReconstruction:       make(map[v1.UniqueVolumeName]map[volumetypes.UniquePodName]types.UID),
+		mountedVolumesByPod:             make(map[volumetypes.UniquePodName]sets.Set[v1.UniqueVolumeName]),
 		volumePluginMgr:                 volumePluginMgr,
 		volumesWithFinalExpansionErrors: sets.New[v1.UniqueVolumeName](),
+		volumeEventSubscribers:          make(map[int]chan VolumeEvent),
 	}
 }
 
@@ -261,14 +359,46 @@ type actualStateOfWorld struct {
 	// from kubelet root directory when kubelet was restarted.
 	foundDuringReconstruction map[v1.UniqueVolumeName]map[volumetypes.UniquePodName]types.UID
 
+	// mountedVolumesByPod is an index of the volumes each pod has in
+	// VolumeMounted state, kept in sync with the mount states stored in
+	// attachedVolumes. It lets GetMountedVolumesForPod serve the per-pod
+	// view queried on every pod sync without scanning all attached volumes.
+	mountedVolumesByPod map[volumetypes.UniquePodName]sets.Set[v1.UniqueVolumeName]
+
 	volumesWithFinalExpansionErrors sets.Set[v1.UniqueVolumeName]
 
+	// remountedVolumes collects volumes that transitioned back from an
+	// uncertain mount state to mounted, until they are drained with
+	// PopRemountedVolumes.
+	remountedVolumes []RemountedVolume
+
+	// volumeEventSubscribers holds the channels of the active volume event
+	// subscriptions, keyed by subscriber id.
+	volumeEventSubscribers map[int]chan VolumeEvent
+	// nextVolumeEventSubscriberID is the id assigned to the next subscriber.
+	nextVolumeEventSubscriberID int
+
 	// volumePluginMgr is the volume plugin manager used to create volume
 	// plugin objects.
 	volumePluginMgr *volume.VolumePluginMgr
 	sync.RWMutex
 }
 
+// RemountedVolume describes a volume that was mounted again for a pod after
+// its mount state had become uncertain.
+type RemountedVolume struct {
+	// PodName is the unique name of the pod the volume was remounted for.
+	PodName volumetypes.UniquePodName
+	// PodUID is the UID of the pod.
+	PodUID types.UID
+	// VolumeName is the unique name of the remounted volume.
+	VolumeName v1.UniqueVolumeName
+	// OuterVolumeSpecName is the podSpec.Volume[x].Name of the volume.
+	OuterVolumeSpecName string
+	// RemountedAt is when the remount was recorded.
+	RemountedAt time.Time
+}
+
 type volumeAttachability string
 
 const (
@@ -332,6 +462,16 @@ type attachedVolume struct {
 	// (via -o context=XYZ mount option). If nil, the volume is not mounted. If "", the volume is
 	// mounted without "-o context=".
 	seLinuxMountContext *string
+
+	// unhealthyReason explains why the volume was marked unhealthy, e.g.
+	// because its backing device was hot-unplugged from the node. Empty for
+	// healthy volumes.
+	unhealthyReason string
+
+	// volumeAttributesClassName is the VolumeAttributesClass the volume
+	// currently has, as reported by pvc.Status.CurrentVolumeAttributesClassName.
+	// Empty when the volume has no class or the class was not recorded yet.
+	volumeAttributesClassName string
 }
 
 // The mountedPod object represents a pod for which the kubelet volume manager
@@ -382,6 +522,20 @@ type mountedPod struct {
 	// (via -o context=XYZ mount option). If nil, the volume is not mounted. If "", the volume is
 	// mounted without "-o context=".
 	seLinuxMountContext string
+
+	// quiescedAt is the time the volume was quiesced (frozen for a consistent
+	// snapshot) for this pod. The zero value means the volume is not quiesced.
+	quiescedAt time.Time
+
+	// quiesceTimeout is the safety timeout after which a quiesced volume is
+	// considered thawed even when no explicit thaw arrived.
+	quiesceTimeout time.Duration
+
+	// specMissing indicates the API objects the volume was mounted from (its
+	// PV or PVC) no longer exist, so the desired state of the world cannot be
+	// repopulated for this volume. The volume must stay mounted until the pod
+	// terminates.
+	specMissing bool
 }
 
 func (asw *actualStateOfWorld) MarkVolumeAsAttached(
@@ -506,6 +660,7 @@ func (asw *actualStateOfWorld) CheckAndMarkVolumeAsUncertainViaReconstruction(op
 	}
 
 	asw.attachedVolumes[volumeName].mountedPods[podName] = podObj
+	asw.indexPodVolumeMountState(podName, volumeName, operationexecutor.VolumeMountUncertain)
 
 	podMap, ok := asw.foundDuringReconstruction[opts.VolumeName]
 	if !ok {
@@ -626,6 +781,27 @@ func (asw *actualStateOfWorld) GetDeviceMountState(volumeName v1.UniqueVolumeNam
 	return volumeObj.deviceMountState
 }
 
+func (asw *actualStateOfWorld) GetConflictingDeviceMountPathOwner(volumeName v1.UniqueVolumeName, deviceMountPath string) (v1.UniqueVolumeName, bool) {
+	asw.RLock()
+	defer asw.RUnlock()
+
+	if deviceMountPath == "" {
+		return "", false
+	}
+	for otherVolumeName, volumeObj := range asw.attachedVolumes {
+		if otherVolumeName == volumeName {
+			continue
+		}
+		if volumeObj.deviceMountState == operationexecutor.DeviceNotMounted {
+			continue
+		}
+		if volumeObj.deviceMountPath == deviceMountPath {
+			return otherVolumeName, true
+		}
+	}
+	return "", false
+}
+
 func (asw *actualStateOfWorld) MarkForInUseExpansionError(volumeName v1.UniqueVolumeName) {
 	asw.Lock()
 	defer asw.Unlock()
@@ -724,6 +900,25 @@ func (asw *actualStateOfWorld) addVolume(
 	return nil
 }
 
+// indexPodVolumeMountState updates mountedVolumesByPod after the mount state
+// of the pod's volume changed. The caller must hold the asw lock.
+func (asw *actualStateOfWorld) indexPodVolumeMountState(
+	podName volumetypes.UniquePodName, volumeName v1.UniqueVolumeName, mountState operationexecutor.VolumeMountState) {
+	if mountState == operationexecutor.VolumeMounted {
+		if asw.mountedVolumesByPod[podName] == nil {
+			asw.mountedVolumesByPod[podName] = sets.New[v1.UniqueVolumeName]()
+		}
+		asw.mountedVolumesByPod[podName].Insert(volumeName)
+		return
+	}
+	if mountedVolumes, exists := asw.mountedVolumesByPod[podName]; exists {
+		mountedVolumes.Delete(volumeName)
+		if mountedVolumes.Len() == 0 {
+			delete(asw.mountedVolumesByPod, podName)
+		}
+	}
+}
+
 func (asw *actualStateOfWorld) AddPodToVolume(markVolumeOpts operationexecutor.MarkVolumeOpts) error {
 	podName := markVolumeOpts.PodName
 	podUID := markVolumeOpts.PodUID
@@ -744,6 +939,7 @@ func (asw *actualStateOfWorld) AddPodToVolume(markVolumeOpts operationexecutor.M
 	}
 
 	podObj, podExists := volumeObj.mountedPods[podName]
+	previouslyMounted := podExists && podObj.volumeMountStateForPod == operationexecutor.VolumeMounted
 
 	updateUncertainVolume := false
 	if podExists {
@@ -775,12 +971,32 @@ func (asw *actualStateOfWorld) AddPodToVolume(markVolumeOpts operationexecutor.M
 	if markVolumeOpts.VolumeMountState == operationexecutor.VolumeMounted {
 		delete(asw.foundDuringReconstruction[volumeName], podName)
 	}
+	// A transition from an uncertain mount state back to mounted is a
+	// remount after recovery; record it for interested components, e.g. the
+	// restart-on-remount policy.
+	if updateUncertainVolume && markVolumeOpts.VolumeMountState == operationexecutor.VolumeMounted {
+		asw.remountedVolumes = append(asw.remountedVolumes, RemountedVolume{
+			PodName:             podName,
+			PodUID:              podUID,
+			VolumeName:          volumeName,
+			OuterVolumeSpecName: outerVolumeSpecName,
+			RemountedAt:         time.Now(),
+		})
+	}
 	if mounter != nil {
 		// The mounter stored in the object may have old information,
 		// use the newest one.
 		podObj.mounter = mounter
 	}
 	asw.attachedVolumes[volumeName].mountedPods[podName] = podObj
+	asw.indexPodVolumeMountState(podName, volumeName, markVolumeOpts.VolumeMountState)
+	if !previouslyMounted && markVolumeOpts.VolumeMountState == operationexecutor.VolumeMounted {
+		asw.notifyVolumeEvent(VolumeEvent{
+			Type:       VolumeMountedEvent,
+			VolumeName: volumeName,
+			PodName:    podName,
+		})
+	}
 	if utilfeature.DefaultFeatureGate.Enabled(features.SELinuxMountReadWriteOncePod) {
 		// Store the mount context also in the AttachedVolume to have a global volume context
 		// for a quick comparison in PodExistsInVolume.
@@ -840,6 +1056,7 @@ func (asw *actualStateOfWorld) SetDeviceMountState(
 			volumeName)
 	}
 
+	previousDeviceMountState := volumeObj.deviceMountState
 	volumeObj.deviceMountState = deviceMountState
 	volumeObj.deviceMountPath = deviceMountPath
 	if devicePath != "" {
@@ -852,6 +1069,20 @@ func (asw *actualStateOfWorld) SetDeviceMountState(
 	}
 
 	asw.attachedVolumes[volumeName] = volumeObj
+	if deviceMountState != previousDeviceMountState {
+		switch deviceMountState {
+		case operationexecutor.DeviceGloballyMounted:
+			asw.notifyVolumeEvent(VolumeEvent{
+				Type:       DeviceMountedEvent,
+				VolumeName: volumeName,
+			})
+		case operationexecutor.DeviceNotMounted:
+			asw.notifyVolumeEvent(VolumeEvent{
+				Type:       DeviceUnmountedEvent,
+				VolumeName: volumeName,
+			})
+		}
+	}
 	return nil
 }
 
@@ -894,6 +1125,12 @@ func (asw *actualStateOfWorld) DeletePodFromVolume(
 	_, podExists := volumeObj.mountedPods[podName]
 	if podExists {
 		delete(asw.attachedVolumes[volumeName].mountedPods, podName)
+		asw.indexPodVolumeMountState(podName, volumeName, operationexecutor.VolumeNotMounted)
+		asw.notifyVolumeEvent(VolumeEvent{
+			Type:       VolumeUnmountedEvent,
+			VolumeName: volumeName,
+			PodName:    podName,
+		})
 	}
 
 	// if there were reconstructed volumes, we should remove them
@@ -1082,21 +1319,267 @@ func (asw *actualStateOfWorld) GetAllMountedVolumes() []MountedVolume {
 	return mountedVolume
 }
 
-func (asw *actualStateOfWorld) GetMountedVolumesForPod(
+// GetDependentMounts walks the mount dependency graph spanned by the mount
+// paths of all mounted volumes: volume B depends on volume A when B's mount
+// path lies inside A's. Only filesystem mounts take part; block mappings
+// have no mount point to nest under.
+func (asw *actualStateOfWorld) GetDependentMounts(
+	podName volumetypes.UniquePodName, volumeName v1.UniqueVolumeName) []v1.UniqueVolumeName {
+	asw.RLock()
+	defer asw.RUnlock()
+
+	volumeObj, volumeExists := asw.attachedVolumes[volumeName]
+	if !volumeExists {
+		return nil
+	}
+	podObj, podExists := volumeObj.mountedPods[podName]
+	if !podExists || podObj.mounter == nil {
+		return nil
+	}
+	basePath := filepath.Clean(podObj.mounter.GetPath())
+	if basePath == "" || basePath == "." || basePath == "/" {
+		return nil
+	}
+
+	var dependents []v1.UniqueVolumeName
+	for otherVolumeName, otherVolumeObj := range asw.attachedVolumes {
+		for _, otherPodObj := range otherVolumeObj.mountedPods {
+			if otherVolumeName == volumeName && otherPodObj.podName == podName {
+				continue
+			}
+			if otherPodObj.mounter == nil {
+				continue
+			}
+			if otherPodObj.volumeMountStateForPod != operationexecutor.VolumeMounted &&
+				otherPodObj.volumeMountStateForPod != operationexecutor.VolumeMountUncertain {
+				continue
+			}
+			if strings.HasPrefix(filepath.Clean(otherPodObj.mounter.GetPath()), basePath+string(filepath.Separator)) {
+				dependents = append(dependents, otherVolumeName)
+				break
+			}
+		}
+	}
+	return dependents
+}
+
+func (asw *actualStateOfWorld) PopRemountedVolumes() []RemountedVolume {
+	asw.Lock()
+	defer asw.Unlock()
+	remounted := asw.remountedVolumes
+	asw.remountedVolumes = nil
+	return remounted
+}
+
+func (asw *actualStateOfWorld) MarkVolumeAsQuiesced(
+	podName volumetypes.UniquePodName, volumeName v1.UniqueVolumeName, timeout time.Duration) error {
+	asw.Lock()
+	defer asw.Unlock()
+
+	volumeObj, volumeExists := asw.attachedVolumes[volumeName]
+	if !volumeExists {
+		return newVolumeNotAttachedError(volumeName)
+	}
+	podObj, podExists := volumeObj.mountedPods[podName]
+	if !podExists || podObj.volumeMountStateForPod != operationexecutor.VolumeMounted {
+		return fmt.Errorf(
+			"volume %q is not mounted for pod %q and cannot be quiesced",
+			volumeName, podName)
+	}
+	podObj.quiescedAt = time.Now()
+	podObj.quiesceTimeout = timeout
+	asw.attachedVolumes[volumeName].mountedPods[podName] = podObj
+	return nil
+}
+
+func (asw *actualStateOfWorld) MarkVolumeAsThawed(
+	podName volumetypes.UniquePodName, volumeName v1.UniqueVolumeName) {
+	asw.Lock()
+	defer asw.Unlock()
+
+	volumeObj, volumeExists := asw.attachedVolumes[volumeName]
+	if !volumeExists {
+		return
+	}
+	podObj, podExists := volumeObj.mountedPods[podName]
+	if !podExists {
+		return
+	}
+	podObj.quiescedAt = time.Time{}
+	podObj.quiesceTimeout = 0
+	asw.attachedVolumes[volumeName].mountedPods[podName] = podObj
+}
+
+func (asw *actualStateOfWorld) GetQuiescedVolumesForPod(
 	podName volumetypes.UniquePodName) []MountedVolume {
 	asw.RLock()
 	defer asw.RUnlock()
-	mountedVolume := make([]MountedVolume, 0 /* len */)
+	quiescedVolumes := make([]MountedVolume, 0 /* len */)
 	for _, volumeObj := range asw.attachedVolumes {
 		for mountedPodName, podObj := range volumeObj.mountedPods {
-			if mountedPodName == podName && podObj.volumeMountStateForPod == operationexecutor.VolumeMounted {
-				mountedVolume = append(
-					mountedVolume,
-					getMountedVolume(&podObj, &volumeObj))
+			if mountedPodName != podName || podObj.quiescedAt.IsZero() {
+				continue
+			}
+			quiescedVolumes = append(
+				quiescedVolumes,
+				getMountedVolume(&podObj, &volumeObj))
+		}
+	}
+
+	return quiescedVolumes
+}
+
+func (asw *actualStateOfWorld) MarkVolumeUnhealthy(
+	volumeName v1.UniqueVolumeName, reason string) error {
+	asw.Lock()
+	defer asw.Unlock()
+
+	volumeObj, volumeExists := asw.attachedVolumes[volumeName]
+	if !volumeExists {
+		return newVolumeNotAttachedError(volumeName)
+	}
+
+	volumeObj.unhealthyReason = reason
+	asw.attachedVolumes[volumeName] = volumeObj
+	return nil
+}
+
+func (asw *actualStateOfWorld) GetUnhealthyVolumes() []AttachedVolume {
+	asw.RLock()
+	defer asw.RUnlock()
+	unhealthyVolumes := make([]AttachedVolume, 0 /* len */)
+	for _, volumeObj := range asw.attachedVolumes {
+		if volumeObj.unhealthyReason != "" {
+			unhealthyVolumes = append(
+				unhealthyVolumes,
+				asw.newAttachedVolume(&volumeObj))
+		}
+	}
+
+	return unhealthyVolumes
+}
+
+func (asw *actualStateOfWorld) MarkVolumeSpecMissing(
+	podName volumetypes.UniquePodName, volumeName v1.UniqueVolumeName) error {
+	asw.Lock()
+	defer asw.Unlock()
+
+	volumeObj, volumeExists := asw.attachedVolumes[volumeName]
+	if !volumeExists {
+		return newVolumeNotAttachedError(volumeName)
+	}
+	podObj, podExists := volumeObj.mountedPods[podName]
+	if !podExists {
+		return fmt.Errorf(
+			"volume %q is not mounted for pod %q and cannot be marked spec-missing",
+			volumeName, podName)
+	}
+	podObj.specMissing = true
+	asw.attachedVolumes[volumeName].mountedPods[podName] = podObj
+	return nil
+}
+
+func (asw *actualStateOfWorld) PodVolumeSpecMissing(
+	podName volumetypes.UniquePodName, volumeName v1.UniqueVolumeName) bool {
+	asw.RLock()
+	defer asw.RUnlock()
+
+	volumeObj, volumeExists := asw.attachedVolumes[volumeName]
+	if !volumeExists {
+		return false
+	}
+	podObj, podExists := volumeObj.mountedPods[podName]
+	return podExists && podObj.specMissing
+}
+
+func (asw *actualStateOfWorld) ClearVolumeSpecMissing(
+	podName volumetypes.UniquePodName) {
+	asw.Lock()
+	defer asw.Unlock()
+
+	for volumeName, volumeObj := range asw.attachedVolumes {
+		podObj, podExists := volumeObj.mountedPods[podName]
+		if !podExists || !podObj.specMissing {
+			continue
+		}
+		podObj.specMissing = false
+		asw.attachedVolumes[volumeName].mountedPods[podName] = podObj
+	}
+}
+
+func (asw *actualStateOfWorld) UpdateVolumeAttributesClass(
+	volumeName v1.UniqueVolumeName, className string) (string, bool) {
+	asw.Lock()
+	defer asw.Unlock()
+
+	volumeObj, volumeExists := asw.attachedVolumes[volumeName]
+	if !volumeExists {
+		return "", false
+	}
+	previous := volumeObj.volumeAttributesClassName
+	if previous == className {
+		return previous, false
+	}
+	volumeObj.volumeAttributesClassName = className
+	asw.attachedVolumes[volumeName] = volumeObj
+	return previous, true
+}
+
+func (asw *actualStateOfWorld) MarkRemountRequiredForVolume(
+	podName volumetypes.UniquePodName, volumeName v1.UniqueVolumeName) {
+	asw.Lock()
+	defer asw.Unlock()
+
+	volumeObj, volumeExists := asw.attachedVolumes[volumeName]
+	if !volumeExists {
+		return
+	}
+	podObj, podExists := volumeObj.mountedPods[podName]
+	if !podExists || podObj.remountRequired {
+		return
+	}
+	podObj.remountRequired = true
+	asw.attachedVolumes[volumeName].mountedPods[podName] = podObj
+}
+
+func (asw *actualStateOfWorld) GetSpecMissingPods() []volumetypes.UniquePodName {
+	asw.RLock()
+	defer asw.RUnlock()
+
+	pods := sets.New[volumetypes.UniquePodName]()
+	for _, volumeObj := range asw.attachedVolumes {
+		for mountedPodName, podObj := range volumeObj.mountedPods {
+			if podObj.specMissing {
+				pods.Insert(mountedPodName)
 			}
 		}
 	}
 
+	return pods.UnsortedList()
+}
+
+func (asw *actualStateOfWorld) GetMountedVolumesForPod(
+	podName volumetypes.UniquePodName) []MountedVolume {
+	asw.RLock()
+	defer asw.RUnlock()
+	// Serve the precomputed per-pod view; this method is called on every
+	// pod sync and must not scan all attached volumes.
+	mountedVolumes := asw.mountedVolumesByPod[podName]
+	mountedVolume := make([]MountedVolume, 0 /* len */, mountedVolumes.Len() /* cap */)
+	for volumeName := range mountedVolumes {
+		volumeObj, volumeExists := asw.attachedVolumes[volumeName]
+		if !volumeExists {
+			continue
+		}
+		podObj, podExists := volumeObj.mountedPods[podName]
+		if !podExists || podObj.volumeMountStateForPod != operationexecutor.VolumeMounted {
+			continue
+		}
+		mountedVolume = append(
+			mountedVolume,
+			getMountedVolume(&podObj, &volumeObj))
+	}
+
 	return mountedVolume
 }
 
@@ -1177,6 +1660,39 @@ func (asw *actualStateOfWorld) GetAttachedVolume(volumeName v1.UniqueVolumeName)
 	return asw.newAttachedVolume(&volumeObj), true
 }
 
+// partitionSuffixRegexp matches device paths of disk partitions and captures
+// the whole-disk device they belong to, e.g. /dev/sdb2 -> /dev/sdb and
+// /dev/nvme0n1p2 -> /dev/nvme0n1.
+var partitionSuffixRegexp = regexp.MustCompile(`^(/dev/(?:sd|vd|xvd)[a-z]+)[0-9]+$|^(/dev/nvme[0-9]+n[0-9]+)p[0-9]+$`)
+
+// backingDeviceForPath derives the physical device backing an attached volume
+// from its attachment device path by stripping any partition suffix. Paths
+// that do not look like disk partitions (LVM/dm devices, CSI opaque handles)
+// are returned unchanged.
+func backingDeviceForPath(devicePath string) string {
+	if match := partitionSuffixRegexp.FindStringSubmatch(devicePath); match != nil {
+		if match[1] != "" {
+			return match[1]
+		}
+		return match[2]
+	}
+	return devicePath
+}
+
+func (asw *actualStateOfWorld) GetVolumesByBackingDevice() map[string][]v1.UniqueVolumeName {
+	asw.RLock()
+	defer asw.RUnlock()
+	volumesByDevice := make(map[string][]v1.UniqueVolumeName)
+	for volumeName, volumeObj := range asw.attachedVolumes {
+		if volumeObj.devicePath == "" {
+			continue
+		}
+		device := backingDeviceForPath(volumeObj.devicePath)
+		volumesByDevice[device] = append(volumesByDevice[device], volumeName)
+	}
+	return volumesByDevice
+}
+
 func (asw *actualStateOfWorld) GetUnmountedVolumes() []AttachedVolume {
 	asw.RLock()
 	defer asw.RUnlock()
@@ -1212,6 +1728,7 @@ func (asw *actualStateOfWorld) newAttachedVolume(
 			SELinuxMountContext: seLinuxMountContext},
 		DeviceMountState:    attachedVolume.deviceMountState,
 		SELinuxMountContext: seLinuxMountContext,
+		UnhealthyReason:     attachedVolume.unhealthyReason,
 	}
 }
 
@@ -872,6 +872,64 @@ func Test_MarkDeviceAsMounted_Positive_NewVolume(t *testing.T) {
 	verifyVolumeExistsInGloballyMountedVolumes(t, generatedVolumeName, asw)
 }
 
+// Calls MarkVolumeAsAttached() and MarkDeviceAsMounted() for two volumes.
+// Verifies GetConflictingDeviceMountPathOwner() reports a conflict only for
+// another volume claiming the same device mount path.
+func Test_GetConflictingDeviceMountPathOwner(t *testing.T) {
+	// Arrange
+	volumePluginMgr, plugin := volumetesting.GetTestKubeletVolumePluginMgr(t)
+	asw := NewActualStateOfWorld("mynode" /* nodeName */, volumePluginMgr)
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "pod1",
+			UID:  "pod1uid",
+		},
+		Spec: v1.PodSpec{
+			Volumes: []v1.Volume{
+				{
+					Name: "volume-name",
+					VolumeSource: v1.VolumeSource{
+						GCEPersistentDisk: &v1.GCEPersistentDiskVolumeSource{
+							PDName: "fake-device1",
+						},
+					},
+				},
+			},
+		},
+	}
+	volumeSpec := &volume.Spec{Volume: &pod.Spec.Volumes[0]}
+	devicePath := "fake/device/path"
+	deviceMountPath := "fake/device/mount/path"
+	generatedVolumeName, err := util.GetUniqueVolumeNameFromSpec(plugin, volumeSpec)
+	if err != nil {
+		t.Fatalf("GetUniqueVolumeNameFromSpec failed. Expected: <no error> Actual: <%v>", err)
+	}
+	logger, _ := ktesting.NewTestContext(t)
+	err = asw.MarkVolumeAsAttached(logger, emptyVolumeName, volumeSpec, "" /* nodeName */, devicePath)
+	if err != nil {
+		t.Fatalf("MarkVolumeAsAttached failed. Expected: <no error> Actual: <%v>", err)
+	}
+	err = asw.MarkDeviceAsMounted(generatedVolumeName, devicePath, deviceMountPath, "")
+	if err != nil {
+		t.Fatalf("MarkDeviceAsMounted failed. Expected: <no error> Actual: <%v>", err)
+	}
+
+	// Act & Assert
+	if owner, conflict := asw.GetConflictingDeviceMountPathOwner(generatedVolumeName, deviceMountPath); conflict {
+		t.Errorf("Expected no conflict for the volume owning the path, got owner %q", owner)
+	}
+	owner, conflict := asw.GetConflictingDeviceMountPathOwner("other-volume", deviceMountPath)
+	if !conflict {
+		t.Fatalf("Expected a conflict for another volume claiming %q", deviceMountPath)
+	}
+	if owner != generatedVolumeName {
+		t.Errorf("Expected conflict owner %q, got %q", generatedVolumeName, owner)
+	}
+	if _, conflict := asw.GetConflictingDeviceMountPathOwner("other-volume", "other/device/mount/path"); conflict {
+		t.Errorf("Expected no conflict for an unclaimed device mount path")
+	}
+}
+
 // Populates data struct with a volume with a SELinux context.
 // Calls AddPodToVolume() to add a pod to the volume
 // Verifies volume/pod combo exist using PodExistsInVolume()
@@ -1087,6 +1145,216 @@ func TestUncertainVolumeMounts(t *testing.T) {
 	}
 }
 
+func Test_MarkVolumeSpecMissing(t *testing.T) {
+	// Arrange
+	volumePluginMgr, plugin := volumetesting.GetTestKubeletVolumePluginMgr(t)
+	asw := NewActualStateOfWorld("mynode" /* nodeName */, volumePluginMgr)
+	devicePath := "fake/device/path"
+
+	pod1 := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "pod1",
+			UID:  "pod1uid",
+		},
+		Spec: v1.PodSpec{
+			Volumes: []v1.Volume{
+				{
+					Name: "volume-name-1",
+					VolumeSource: v1.VolumeSource{
+						GCEPersistentDisk: &v1.GCEPersistentDiskVolumeSource{
+							PDName: "fake-device1",
+						},
+					},
+				},
+			},
+		},
+	}
+	volumeSpec1 := &volume.Spec{Volume: &pod1.Spec.Volumes[0]}
+	generatedVolumeName1, err := util.GetUniqueVolumeNameFromSpec(
+		plugin, volumeSpec1)
+	require.NoError(t, err)
+	logger, _ := ktesting.NewTestContext(t)
+	err = asw.MarkVolumeAsAttached(logger, generatedVolumeName1, volumeSpec1, "" /* nodeName */, devicePath)
+	if err != nil {
+		t.Fatalf("MarkVolumeAsAttached failed. Expected: <no error> Actual: <%v>", err)
+	}
+	podName1 := util.GetUniquePodName(pod1)
+
+	// Marking a volume that is not mounted for the pod must fail.
+	if err := asw.MarkVolumeSpecMissing(podName1, generatedVolumeName1); err == nil {
+		t.Fatalf("MarkVolumeSpecMissing succeeded. Expected: <error> Actual: <no error>")
+	}
+
+	mounter1, err := plugin.NewMounter(volumeSpec1, pod1)
+	if err != nil {
+		t.Fatalf("NewMounter failed. Expected: <no error> Actual: <%v>", err)
+	}
+
+	markVolumeOpts1 := operationexecutor.MarkVolumeOpts{
+		PodName:             podName1,
+		PodUID:              pod1.UID,
+		VolumeName:          generatedVolumeName1,
+		Mounter:             mounter1,
+		OuterVolumeSpecName: volumeSpec1.Name(),
+		VolumeSpec:          volumeSpec1,
+		VolumeMountState:    operationexecutor.VolumeMounted,
+	}
+	err = asw.AddPodToVolume(markVolumeOpts1)
+	if err != nil {
+		t.Fatalf("AddPodToVolume failed. Expected: <no error> Actual: <%v>", err)
+	}
+
+	if asw.PodVolumeSpecMissing(podName1, generatedVolumeName1) {
+		t.Fatalf("expected volume %s not to be marked spec-missing before MarkVolumeSpecMissing", generatedVolumeName1)
+	}
+
+	// Act
+	if err := asw.MarkVolumeSpecMissing(podName1, generatedVolumeName1); err != nil {
+		t.Fatalf("MarkVolumeSpecMissing failed. Expected: <no error> Actual: <%v>", err)
+	}
+
+	// Assert
+	if !asw.PodVolumeSpecMissing(podName1, generatedVolumeName1) {
+		t.Fatalf("expected volume %s to be marked spec-missing", generatedVolumeName1)
+	}
+	specMissingPods := asw.GetSpecMissingPods()
+	if len(specMissingPods) != 1 || specMissingPods[0] != podName1 {
+		t.Fatalf("expected GetSpecMissingPods to return [%q], got %v", podName1, specMissingPods)
+	}
+
+	asw.ClearVolumeSpecMissing(podName1)
+	if asw.PodVolumeSpecMissing(podName1, generatedVolumeName1) {
+		t.Fatalf("expected spec-missing mark of volume %s to be cleared", generatedVolumeName1)
+	}
+	if pods := asw.GetSpecMissingPods(); len(pods) != 0 {
+		t.Fatalf("expected GetSpecMissingPods to be empty after clearing, got %v", pods)
+	}
+}
+
+func Test_VolumeEventSubscription(t *testing.T) {
+	// Arrange
+	volumePluginMgr, plugin := volumetesting.GetTestKubeletVolumePluginMgr(t)
+	asw := NewActualStateOfWorld("mynode" /* nodeName */, volumePluginMgr)
+	devicePath := "fake/device/path"
+
+	pod1 := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "pod1",
+			UID:  "pod1uid",
+		},
+		Spec: v1.PodSpec{
+			Volumes: []v1.Volume{
+				{
+					Name: "volume-name-1",
+					VolumeSource: v1.VolumeSource{
+						GCEPersistentDisk: &v1.GCEPersistentDiskVolumeSource{
+							PDName: "fake-device1",
+						},
+					},
+				},
+			},
+		},
+	}
+	volumeSpec1 := &volume.Spec{Volume: &pod1.Spec.Volumes[0]}
+	generatedVolumeName1, err := util.GetUniqueVolumeNameFromSpec(
+		plugin, volumeSpec1)
+	require.NoError(t, err)
+	logger, _ := ktesting.NewTestContext(t)
+	err = asw.MarkVolumeAsAttached(logger, generatedVolumeName1, volumeSpec1, "" /* nodeName */, devicePath)
+	if err != nil {
+		t.Fatalf("MarkVolumeAsAttached failed. Expected: <no error> Actual: <%v>", err)
+	}
+	podName1 := util.GetUniquePodName(pod1)
+
+	events, cancel := asw.SubscribeVolumeEvents(10 /* bufferSize */)
+	defer cancel()
+
+	expectEvent := func(expectedType VolumeEventType, expectedPodName volumetypes.UniquePodName) {
+		t.Helper()
+		select {
+		case event := <-events:
+			if event.Type != expectedType {
+				t.Fatalf("expected event of type %q, got %q", expectedType, event.Type)
+			}
+			if event.VolumeName != generatedVolumeName1 {
+				t.Fatalf("expected event for volume %q, got %q", generatedVolumeName1, event.VolumeName)
+			}
+			if event.PodName != expectedPodName {
+				t.Fatalf("expected event for pod %q, got %q", expectedPodName, event.PodName)
+			}
+			if event.Timestamp.IsZero() {
+				t.Fatalf("expected event timestamp to be set")
+			}
+		default:
+			t.Fatalf("expected an event of type %q, got none", expectedType)
+		}
+	}
+	expectNoEvent := func() {
+		t.Helper()
+		select {
+		case event := <-events:
+			t.Fatalf("expected no event, got %+v", event)
+		default:
+		}
+	}
+
+	// Act & Assert
+	err = asw.SetDeviceMountState(generatedVolumeName1, operationexecutor.DeviceGloballyMounted, devicePath, "" /* deviceMountPath */, "" /* seLinuxMountContext */)
+	if err != nil {
+		t.Fatalf("SetDeviceMountState failed. Expected: <no error> Actual: <%v>", err)
+	}
+	expectEvent(DeviceMountedEvent, "" /* podName */)
+
+	mounter1, err := plugin.NewMounter(volumeSpec1, pod1)
+	if err != nil {
+		t.Fatalf("NewMounter failed. Expected: <no error> Actual: <%v>", err)
+	}
+	markVolumeOpts1 := operationexecutor.MarkVolumeOpts{
+		PodName:             podName1,
+		PodUID:              pod1.UID,
+		VolumeName:          generatedVolumeName1,
+		Mounter:             mounter1,
+		OuterVolumeSpecName: volumeSpec1.Name(),
+		VolumeSpec:          volumeSpec1,
+		VolumeMountState:    operationexecutor.VolumeMounted,
+	}
+	err = asw.AddPodToVolume(markVolumeOpts1)
+	if err != nil {
+		t.Fatalf("AddPodToVolume failed. Expected: <no error> Actual: <%v>", err)
+	}
+	expectEvent(VolumeMountedEvent, podName1)
+
+	// Re-adding an already mounted volume is not a transition and must not
+	// produce another event.
+	err = asw.AddPodToVolume(markVolumeOpts1)
+	if err != nil {
+		t.Fatalf("AddPodToVolume failed. Expected: <no error> Actual: <%v>", err)
+	}
+	expectNoEvent()
+
+	err = asw.DeletePodFromVolume(podName1, generatedVolumeName1)
+	if err != nil {
+		t.Fatalf("DeletePodFromVolume failed. Expected: <no error> Actual: <%v>", err)
+	}
+	expectEvent(VolumeUnmountedEvent, podName1)
+
+	err = asw.SetDeviceMountState(generatedVolumeName1, operationexecutor.DeviceNotMounted, devicePath, "" /* deviceMountPath */, "" /* seLinuxMountContext */)
+	if err != nil {
+		t.Fatalf("SetDeviceMountState failed. Expected: <no error> Actual: <%v>", err)
+	}
+	expectEvent(DeviceUnmountedEvent, "" /* podName */)
+
+	// After cancelling, the channel is closed and no further events arrive.
+	cancel()
+	err = asw.SetDeviceMountState(generatedVolumeName1, operationexecutor.DeviceGloballyMounted, devicePath, "" /* deviceMountPath */, "" /* seLinuxMountContext */)
+	if err != nil {
+		t.Fatalf("SetDeviceMountState failed. Expected: <no error> Actual: <%v>", err)
+	}
+	if _, open := <-events; open {
+		t.Fatalf("expected the event channel to be closed after cancel")
+	}
+}
+
 func verifyVolumeExistsInGloballyMountedVolumes(
 	t *testing.T, expectedVolumeName v1.UniqueVolumeName, asw ActualStateOfWorld) {
 	globallyMountedVolumes := asw.GetGloballyMountedVolumes()
@@ -1378,3 +1646,240 @@ func verifyVolumeAttachability(t *testing.T, volumeToCheck v1.UniqueVolumeName,
 		}
 	}
 }
+
+// Calls MarkVolumeAsAttached() for three volumes, two of which are partitions
+// of the same disk. Verifies GetVolumesByBackingDevice() groups the two
+// partitions under their whole-disk device.
+func Test_GetVolumesByBackingDevice(t *testing.T) {
+	// Arrange
+	volumePluginMgr, _ := volumetesting.GetTestKubeletVolumePluginMgr(t)
+	asw := NewActualStateOfWorld("mynode" /* nodeName */, volumePluginMgr)
+	logger, _ := ktesting.NewTestContext(t)
+
+	devicePaths := map[v1.UniqueVolumeName]string{
+		"fake-plugin/volume1": "/dev/sdb1",
+		"fake-plugin/volume2": "/dev/sdb2",
+		"fake-plugin/volume3": "/dev/sdc",
+	}
+	for volumeName, devicePath := range devicePaths {
+		pdName := string(volumeName)
+		volumeSpec := &volume.Spec{
+			Volume: &v1.Volume{
+				Name: pdName,
+				VolumeSource: v1.VolumeSource{
+					GCEPersistentDisk: &v1.GCEPersistentDiskVolumeSource{
+						PDName: pdName,
+					},
+				},
+			},
+		}
+		err := asw.MarkVolumeAsAttached(logger, volumeName, volumeSpec, "" /* nodeName */, devicePath)
+		if err != nil {
+			t.Fatalf("MarkVolumeAsAttached failed. Expected: <no error> Actual: <%v>", err)
+		}
+	}
+
+	// Act
+	volumesByDevice := asw.GetVolumesByBackingDevice()
+
+	// Assert
+	if len(volumesByDevice) != 2 {
+		t.Fatalf("expected 2 backing devices, got %v", volumesByDevice)
+	}
+	if len(volumesByDevice["/dev/sdb"]) != 2 {
+		t.Errorf("expected 2 volumes backed by /dev/sdb, got %v", volumesByDevice["/dev/sdb"])
+	}
+	if len(volumesByDevice["/dev/sdc"]) != 1 {
+		t.Errorf("expected 1 volume backed by /dev/sdc, got %v", volumesByDevice["/dev/sdc"])
+	}
+}
+
+func Test_backingDeviceForPath(t *testing.T) {
+	tests := map[string]string{
+		"/dev/sdb2":            "/dev/sdb",
+		"/dev/xvdaa1":          "/dev/xvdaa",
+		"/dev/nvme0n1p2":       "/dev/nvme0n1",
+		"/dev/nvme0n1":         "/dev/nvme0n1",
+		"/dev/mapper/vg0-lv0":  "/dev/mapper/vg0-lv0",
+		"csi-0123456789abcdef": "csi-0123456789abcdef",
+	}
+	for devicePath, expected := range tests {
+		if actual := backingDeviceForPath(devicePath); actual != expected {
+			t.Errorf("backingDeviceForPath(%q) = %q, expected %q", devicePath, actual, expected)
+		}
+	}
+}
+
+// fixedPathMounter overrides the mount path of a fake mounter, so that tests
+// can place mounts inside each other.
+type fixedPathMounter struct {
+	volume.Mounter
+	path string
+}
+
+func (m *fixedPathMounter) GetPath() string {
+	return m.path
+}
+
+func Test_GetDependentMounts(t *testing.T) {
+	// Arrange: one pod with an outer volume and a hostPath-style volume
+	// mounted inside it, plus a second pod with another nested mount.
+	volumePluginMgr, plugin := volumetesting.GetTestKubeletVolumePluginMgr(t)
+	asw := NewActualStateOfWorld("mynode" /* nodeName */, volumePluginMgr)
+	logger, _ := ktesting.NewTestContext(t)
+
+	pod1 := getTestPod("pod1", "pod1uid", "volume-name-1", "fake-device1")
+	pod1.Spec.Volumes = append(pod1.Spec.Volumes, v1.Volume{
+		Name: "volume-name-2",
+		VolumeSource: v1.VolumeSource{
+			GCEPersistentDisk: &v1.GCEPersistentDiskVolumeSource{
+				PDName: "fake-device2",
+			},
+		},
+	})
+	pod2 := getTestPod("pod2", "pod2uid", "volume-name-3", "fake-device3")
+
+	addMount := func(pod *v1.Pod, volumeIndex int, path string) v1.UniqueVolumeName {
+		volumeSpec := &volume.Spec{Volume: &pod.Spec.Volumes[volumeIndex]}
+		volumeName, err := util.GetUniqueVolumeNameFromSpec(plugin, volumeSpec)
+		require.NoError(t, err)
+		require.NoError(t, asw.MarkVolumeAsAttached(logger, volumeName, volumeSpec, "" /* nodeName */, "" /* devicePath */))
+		mounter, err := plugin.NewMounter(volumeSpec, pod)
+		require.NoError(t, err)
+		require.NoError(t, asw.AddPodToVolume(operationexecutor.MarkVolumeOpts{
+			PodName:             util.GetUniquePodName(pod),
+			PodUID:              pod.UID,
+			VolumeName:          volumeName,
+			Mounter:             &fixedPathMounter{Mounter: mounter, path: path},
+			OuterVolumeSpecName: volumeSpec.Name(),
+			VolumeSpec:          volumeSpec,
+			VolumeMountState:    operationexecutor.VolumeMounted,
+		}))
+		return volumeName
+	}
+
+	outerVolume := addMount(pod1, 0, "/mnt/outer")
+	nestedVolume := addMount(pod1, 1, "/mnt/outer/nested")
+	otherPodVolume := addMount(pod2, 0, "/mnt/outer/other-pod")
+
+	// Act & Assert: both nested mounts depend on the outer volume,
+	// regardless of which pod mounted them.
+	podName1 := util.GetUniquePodName(pod1)
+	dependents := asw.GetDependentMounts(podName1, outerVolume)
+	require.ElementsMatch(t, []v1.UniqueVolumeName{nestedVolume, otherPodVolume}, dependents)
+	require.Empty(t, asw.GetDependentMounts(podName1, nestedVolume))
+	require.Empty(t, asw.GetDependentMounts(podName1, "nonexistent-volume"))
+
+	// Unmounting the nested volumes releases the outer volume.
+	require.NoError(t, asw.MarkVolumeAsUnmounted(podName1, nestedVolume))
+	require.NoError(t, asw.MarkVolumeAsUnmounted(util.GetUniquePodName(pod2), otherPodVolume))
+	require.Empty(t, asw.GetDependentMounts(podName1, outerVolume))
+}
+
+func Test_GetMountedVolumesForPod_IndexConsistency(t *testing.T) {
+	// Arrange
+	volumePluginMgr, plugin := volumetesting.GetTestKubeletVolumePluginMgr(t)
+	asw := NewActualStateOfWorld("mynode" /* nodeName */, volumePluginMgr)
+	logger, _ := ktesting.NewTestContext(t)
+
+	pod := getTestPod("pod1", "pod1uid", "volume-name-1", "fake-device1")
+	podName := util.GetUniquePodName(pod)
+	volumeSpec := &volume.Spec{Volume: &pod.Spec.Volumes[0]}
+	volumeName, err := util.GetUniqueVolumeNameFromSpec(plugin, volumeSpec)
+	require.NoError(t, err)
+	require.NoError(t, asw.MarkVolumeAsAttached(logger, volumeName, volumeSpec, "" /* nodeName */, "" /* devicePath */))
+	mounter, err := plugin.NewMounter(volumeSpec, pod)
+	require.NoError(t, err)
+	markVolumeOpts := operationexecutor.MarkVolumeOpts{
+		PodName:             podName,
+		PodUID:              pod.UID,
+		VolumeName:          volumeName,
+		Mounter:             mounter,
+		OuterVolumeSpecName: volumeSpec.Name(),
+		VolumeSpec:          volumeSpec,
+		VolumeMountState:    operationexecutor.VolumeMountUncertain,
+	}
+
+	// An uncertain mount is not part of the mounted view.
+	require.NoError(t, asw.AddPodToVolume(markVolumeOpts))
+	require.Empty(t, asw.GetMountedVolumesForPod(podName))
+
+	// Mounted volumes show up, repeated marking is idempotent.
+	markVolumeOpts.VolumeMountState = operationexecutor.VolumeMounted
+	require.NoError(t, asw.AddPodToVolume(markVolumeOpts))
+	require.NoError(t, asw.AddPodToVolume(markVolumeOpts))
+	require.Len(t, asw.GetMountedVolumesForPod(podName), 1)
+
+	// Unmounting removes the volume from the view again.
+	require.NoError(t, asw.MarkVolumeAsUnmounted(podName, volumeName))
+	require.Empty(t, asw.GetMountedVolumesForPod(podName))
+}
+
+func BenchmarkGetMountedVolumesForPod(b *testing.B) {
+	volumePluginMgr := &volume.VolumePluginMgr{}
+	plugins := volumetesting.ProbeVolumePlugins(volume.VolumeConfig{})
+	if err := volumePluginMgr.InitPlugins(plugins, nil /* prober */, nil /* host */); err != nil {
+		b.Fatalf("failed to init volume plugins: %v", err)
+	}
+	plugin := plugins[0].(*volumetesting.FakeVolumePlugin)
+	asw := NewActualStateOfWorld("mynode" /* nodeName */, volumePluginMgr)
+	logger, _ := ktesting.NewTestContext(b)
+
+	// 100 pods with 10 volumes each; the benchmarked pod is one of many.
+	const pods = 100
+	const volumesPerPod = 10
+	var benchPodName volumetypes.UniquePodName
+	for i := 0; i < pods; i++ {
+		pod := getTestPod(
+			fmt.Sprintf("pod%d", i),
+			fmt.Sprintf("pod%duid", i),
+			"volume-name-0",
+			fmt.Sprintf("fake-device-%d-0", i))
+		for j := 1; j < volumesPerPod; j++ {
+			pod.Spec.Volumes = append(pod.Spec.Volumes, v1.Volume{
+				Name: fmt.Sprintf("volume-name-%d", j),
+				VolumeSource: v1.VolumeSource{
+					GCEPersistentDisk: &v1.GCEPersistentDiskVolumeSource{
+						PDName: fmt.Sprintf("fake-device-%d-%d", i, j),
+					},
+				},
+			})
+		}
+		podName := util.GetUniquePodName(pod)
+		if i == pods/2 {
+			benchPodName = podName
+		}
+		for j := range pod.Spec.Volumes {
+			volumeSpec := &volume.Spec{Volume: &pod.Spec.Volumes[j]}
+			volumeName, err := util.GetUniqueVolumeNameFromSpec(plugin, volumeSpec)
+			if err != nil {
+				b.Fatalf("GetUniqueVolumeNameFromSpec failed: %v", err)
+			}
+			if err := asw.MarkVolumeAsAttached(logger, volumeName, volumeSpec, "" /* nodeName */, "" /* devicePath */); err != nil {
+				b.Fatalf("MarkVolumeAsAttached failed: %v", err)
+			}
+			mounter, err := plugin.NewMounter(volumeSpec, pod)
+			if err != nil {
+				b.Fatalf("NewMounter failed: %v", err)
+			}
+			if err := asw.AddPodToVolume(operationexecutor.MarkVolumeOpts{
+				PodName:             podName,
+				PodUID:              pod.UID,
+				VolumeName:          volumeName,
+				Mounter:             mounter,
+				OuterVolumeSpecName: volumeSpec.Name(),
+				VolumeSpec:          volumeSpec,
+				VolumeMountState:    operationexecutor.VolumeMounted,
+			}); err != nil {
+				b.Fatalf("AddPodToVolume failed: %v", err)
+			}
+		}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if mounted := asw.GetMountedVolumesForPod(benchPodName); len(mounted) != volumesPerPod {
+			b.Fatalf("expected %d mounted volumes, got %d", volumesPerPod, len(mounted))
+		}
+	}
+}
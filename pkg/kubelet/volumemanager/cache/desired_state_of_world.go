@@ -26,7 +26,9 @@ import (
 	"time"
 
 	v1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/resource"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/component-base/metrics"
@@ -135,6 +137,12 @@ type DesiredStateOfWorld interface {
 	// so as it can be compared against actual size and volume expansion performed
 	// if necessary
 	UpdatePersistentVolumeSize(volumeName v1.UniqueVolumeName, size resource.Quantity)
+
+	// GetPodsForPVC returns the pods that should mount a volume backed by the
+	// persistent volume claim with the given UID. The lookup is served from an
+	// index maintained alongside the volumes, so it does not iterate all
+	// volumes in the cache.
+	GetPodsForPVC(pvcUID k8stypes.UID) []PodForPVC
 }
 
 // VolumeToMount represents a volume that is attached to this node and needs to
@@ -143,6 +151,20 @@ type VolumeToMount struct {
 	operationexecutor.VolumeToMount
 }
 
+// PodForPVC represents a pod in the desired state of world that mounts a
+// volume backed by a particular persistent volume claim.
+type PodForPVC struct {
+	// PodName contains the unique identifier of the pod.
+	PodName types.UniquePodName
+
+	// Pod to mount the volume to.
+	Pod *v1.Pod
+
+	// VolumeName contains the unique identifier of the volume backed by the
+	// claim.
+	VolumeName v1.UniqueVolumeName
+}
+
 // NewDesiredStateOfWorld returns a new instance of DesiredStateOfWorld.
 func NewDesiredStateOfWorld(volumePluginMgr *volume.VolumePluginMgr, seLinuxTranslator util.SELinuxLabelTranslator) DesiredStateOfWorld {
 	if feature.DefaultFeatureGate.Enabled(features.SELinuxMountReadWriteOncePod) {
@@ -152,6 +174,7 @@ func NewDesiredStateOfWorld(volumePluginMgr *volume.VolumePluginMgr, seLinuxTran
 		volumesToMount:    make(map[v1.UniqueVolumeName]volumeToMount),
 		volumePluginMgr:   volumePluginMgr,
 		podErrors:         make(map[types.UniquePodName]sets.Set[string]),
+		pvcIndex:          make(map[k8stypes.UID]map[types.UniquePodName]v1.UniqueVolumeName),
 		seLinuxTranslator: seLinuxTranslator,
 	}
 }
@@ -167,6 +190,11 @@ type desiredStateOfWorld struct {
 	volumePluginMgr *volume.VolumePluginMgr
 	// podErrors are errors caught by desiredStateOfWorldPopulator about volumes for a given pod.
 	podErrors map[types.UniquePodName]sets.Set[string]
+	// pvcIndex indexes the pods in volumesToMount by the UID of the
+	// persistent volume claim backing their volume, so that the pods using a
+	// claim can be looked up without iterating all volumes. The value maps
+	// each pod to the unique name of the volume backed by the claim.
+	pvcIndex map[k8stypes.UID]map[types.UniquePodName]v1.UniqueVolumeName
 	// seLinuxTranslator translates v1.SELinuxOptions to a file SELinux label.
 	seLinuxTranslator util.SELinuxLabelTranslator
 
@@ -225,6 +253,15 @@ type volumeToMount struct {
 	// - or the volume plugin responsible for this volume does not support mounting with -o context
 	// - or the OS does not support SELinux
 	originalSELinuxLabel string
+
+	// internedVolumeSpec is the canonical volume spec shared by the pods that
+	// mount this volume. AddPodToVolume stores it instead of the caller's
+	// spec when the two are semantically equal, so that many pods referencing
+	// the same volume do not each pin their own copy of a potentially large
+	// PersistentVolume object. Shared specs are treated as immutable: when a
+	// semantically different spec arrives, it becomes the new canonical
+	// object and pods stored earlier keep the old one (copy-on-write).
+	internedVolumeSpec *volume.Spec
 }
 
 // The pod object represents a pod that references the underlying volume and
@@ -379,6 +416,10 @@ func (dsw *desiredStateOfWorld) AddPodToVolume(
 		}
 	}
 
+	// Deduplicate the spec against the volume's canonical spec object so
+	// pods sharing the volume do not each pin their own copy.
+	volumeSpec = dsw.internVolumeSpec(volumeName, volumeSpec)
+
 	// Create new podToMount object. If it already exists, it is refreshed with
 	// updated values (this is required for volumes that require remounting on
 	// pod update, like Downward API volumes).
@@ -389,9 +430,43 @@ func (dsw *desiredStateOfWorld) AddPodToVolume(
 		outerVolumeSpecName: outerVolumeSpecName,
 		mountRequestTime:    mountRequestTime,
 	}
+	if pvcUID := claimUIDFromSpec(volumeSpec); pvcUID != "" {
+		podsForPVC, pvcExists := dsw.pvcIndex[pvcUID]
+		if !pvcExists {
+			podsForPVC = make(map[types.UniquePodName]v1.UniqueVolumeName)
+			dsw.pvcIndex[pvcUID] = podsForPVC
+		}
+		podsForPVC[podName] = volumeName
+	}
 	return volumeName, nil
 }
 
+// internVolumeSpec returns the volume's canonical spec object when it is
+// semantically equal to the given spec, and otherwise makes the given spec
+// the new canonical object. Must be called with dsw.Lock held.
+func (dsw *desiredStateOfWorld) internVolumeSpec(volumeName v1.UniqueVolumeName, volumeSpec *volume.Spec) *volume.Spec {
+	volumeObj := dsw.volumesToMount[volumeName]
+	if volumeObj.internedVolumeSpec != nil && apiequality.Semantic.DeepEqual(volumeObj.internedVolumeSpec, volumeSpec) {
+		return volumeObj.internedVolumeSpec
+	}
+	volumeObj.internedVolumeSpec = volumeSpec
+	dsw.volumesToMount[volumeName] = volumeObj
+	return volumeSpec
+}
+
+// claimUIDFromSpec returns the UID of the persistent volume claim backing the
+// given volume spec, or the empty UID if the volume is not backed by a claim.
+func claimUIDFromSpec(volumeSpec *volume.Spec) k8stypes.UID {
+	if volumeSpec == nil || volumeSpec.PersistentVolume == nil {
+		return ""
+	}
+	claimRef := volumeSpec.PersistentVolume.Spec.ClaimRef
+	if claimRef == nil {
+		return ""
+	}
+	return claimRef.UID
+}
+
 // getSELinuxLabel returns the SELinux label for a given volume and combination of SELinux labels and bool indicating
 // if the plugin supports mounting the volume with SELinux context.
 // It returns error if the SELinux label cannot be constructed or when the volume is used with multiple SELinux
@@ -455,10 +530,18 @@ func (dsw *desiredStateOfWorld) DeletePodFromVolume(
 		return
 	}
 
-	if _, podExists := volumeObj.podsToMount[podName]; !podExists {
+	podObj, podExists := volumeObj.podsToMount[podName]
+	if !podExists {
 		return
 	}
 
+	if pvcUID := claimUIDFromSpec(podObj.volumeSpec); pvcUID != "" {
+		delete(dsw.pvcIndex[pvcUID], podName)
+		if len(dsw.pvcIndex[pvcUID]) == 0 {
+			delete(dsw.pvcIndex, pvcUID)
+		}
+	}
+
 	// Delete pod if it exists
 	delete(dsw.volumesToMount[volumeName].podsToMount, podName)
 
@@ -468,6 +551,22 @@ func (dsw *desiredStateOfWorld) DeletePodFromVolume(
 	}
 }
 
+func (dsw *desiredStateOfWorld) GetPodsForPVC(pvcUID k8stypes.UID) []PodForPVC {
+	dsw.RLock()
+	defer dsw.RUnlock()
+
+	podsForPVC := make([]PodForPVC, 0, len(dsw.pvcIndex[pvcUID]))
+	for podName, volumeName := range dsw.pvcIndex[pvcUID] {
+		podObj := dsw.volumesToMount[volumeName].podsToMount[podName]
+		podsForPVC = append(podsForPVC, PodForPVC{
+			PodName:    podName,
+			Pod:        podObj.pod,
+			VolumeName: volumeName,
+		})
+	}
+	return podsForPVC
+}
+
 // UpdatePersistentVolumeSize updates last known PV size. This is used for volume expansion and
 // should be only used for persistent volumes.
 func (dsw *desiredStateOfWorld) UpdatePersistentVolumeSize(volumeName v1.UniqueVolumeName, size resource.Quantity) {
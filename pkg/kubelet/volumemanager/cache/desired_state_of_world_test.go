@@ -17,11 +17,13 @@ limitations under the License.
 package cache
 
 import (
+	"fmt"
 	"testing"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	"k8s.io/component-base/featuregate"
 	featuregatetesting "k8s.io/component-base/featuregate/testing"
@@ -1266,6 +1268,258 @@ func Test_AddPodToVolume_SELinux_MultiplePods(t *testing.T) {
 	}
 }
 
+// Calls AddPodToVolume() with semantically equal but distinct spec objects
+// for two pods sharing a volume.
+// Verifies the stored specs are interned to one shared object and that a
+// semantically different spec gets its own object without touching the specs
+// stored for earlier pods.
+func Test_AddPodToVolume_SpecInterning(t *testing.T) {
+	// Arrange
+	volumePluginMgr, _ := volumetesting.GetTestKubeletVolumePluginMgr(t)
+	seLinuxTranslator := util.NewFakeSELinuxLabelTranslator()
+	dsw := NewDesiredStateOfWorld(volumePluginMgr, seLinuxTranslator)
+	basePV := &v1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "basicPV",
+		},
+		Spec: v1.PersistentVolumeSpec{
+			AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteMany},
+			Capacity: v1.ResourceList{
+				v1.ResourceStorage: resource.MustParse("10Gi"),
+			},
+		},
+	}
+	newPod := func(name string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				UID:  k8stypes.UID(name + "uid"),
+			},
+			Spec: v1.PodSpec{
+				Volumes: []v1.Volume{
+					{
+						Name: "volume-name",
+						VolumeSource: v1.VolumeSource{
+							PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+								ClaimName: "myClaim",
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	pod1 := newPod("pod1")
+	pod2 := newPod("pod2")
+
+	// Act
+	generatedVolumeName, err := dsw.AddPodToVolume(
+		util.GetUniquePodName(pod1), pod1, &volume.Spec{PersistentVolume: basePV.DeepCopy()}, "volume-name", "" /* volumeGIDValue */, nil /* seLinuxContainerContexts */)
+	if err != nil {
+		t.Fatalf("AddPodToVolume failed. Expected: <no error> Actual: <%v>", err)
+	}
+	_, err = dsw.AddPodToVolume(
+		util.GetUniquePodName(pod2), pod2, &volume.Spec{PersistentVolume: basePV.DeepCopy()}, "volume-name", "" /* volumeGIDValue */, nil /* seLinuxContainerContexts */)
+	if err != nil {
+		t.Fatalf("AddPodToVolume failed. Expected: <no error> Actual: <%v>", err)
+	}
+
+	// Assert
+	specForPod := func(podName volumetypes.UniquePodName) *volume.Spec {
+		for _, volumeToMount := range dsw.GetVolumesToMount() {
+			if volumeToMount.VolumeName == generatedVolumeName && volumeToMount.PodName == podName {
+				return volumeToMount.VolumeSpec
+			}
+		}
+		t.Fatalf("pod %q not found in volumes to mount", podName)
+		return nil
+	}
+	pod1Spec := specForPod(util.GetUniquePodName(pod1))
+	pod2Spec := specForPod(util.GetUniquePodName(pod2))
+	if pod1Spec != pod2Spec {
+		t.Errorf("Expected pods with equal specs to share one interned spec object, got %p and %p", pod1Spec, pod2Spec)
+	}
+
+	// Act: a semantically different spec must not be interned to the old object.
+	updatedPV := basePV.DeepCopy()
+	updatedPV.Spec.Capacity[v1.ResourceStorage] = resource.MustParse("20Gi")
+	pod3 := newPod("pod3")
+	_, err = dsw.AddPodToVolume(
+		util.GetUniquePodName(pod3), pod3, &volume.Spec{PersistentVolume: updatedPV}, "volume-name", "" /* volumeGIDValue */, nil /* seLinuxContainerContexts */)
+	if err != nil {
+		t.Fatalf("AddPodToVolume failed. Expected: <no error> Actual: <%v>", err)
+	}
+
+	// Assert
+	pod3Spec := specForPod(util.GetUniquePodName(pod3))
+	if pod3Spec == pod1Spec {
+		t.Errorf("Expected pod with updated spec to get its own spec object")
+	}
+	if specForPod(util.GetUniquePodName(pod1)) != pod1Spec {
+		t.Errorf("Expected spec stored for earlier pod to be left untouched")
+	}
+}
+
+// Benchmarks adding many pods that share one persistent volume with a fresh
+// spec copy per pod, the pattern the populator produces.
+func BenchmarkAddPodToVolumeSharedSpec(b *testing.B) {
+	volumePluginMgr := &volume.VolumePluginMgr{}
+	if err := volumePluginMgr.InitPlugins(volumetesting.ProbeVolumePlugins(volume.VolumeConfig{}), nil /* prober */, nil /* host */); err != nil {
+		b.Fatalf("failed to init volume plugins: %v", err)
+	}
+	seLinuxTranslator := util.NewFakeSELinuxLabelTranslator()
+	dsw := NewDesiredStateOfWorld(volumePluginMgr, seLinuxTranslator)
+	basePV := &v1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "basicPV",
+		},
+		Spec: v1.PersistentVolumeSpec{
+			AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteMany},
+			Capacity: v1.ResourceList{
+				v1.ResourceStorage: resource.MustParse("10Gi"),
+			},
+		},
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		pod := &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: fmt.Sprintf("pod%d", i),
+				UID:  k8stypes.UID(fmt.Sprintf("pod%duid", i)),
+			},
+			Spec: v1.PodSpec{
+				Volumes: []v1.Volume{
+					{
+						Name: "volume-name",
+						VolumeSource: v1.VolumeSource{
+							PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+								ClaimName: "myClaim",
+							},
+						},
+					},
+				},
+			},
+		}
+		_, err := dsw.AddPodToVolume(
+			util.GetUniquePodName(pod), pod, &volume.Spec{PersistentVolume: basePV.DeepCopy()}, "volume-name", "", nil)
+		if err != nil {
+			b.Fatalf("AddPodToVolume failed: %v", err)
+		}
+	}
+}
+
+// Calls AddPodToVolume() to add two pods to a volume backed by a PVC.
+// Verifies GetPodsForPVC() returns both pods for the claim's UID and that
+// DeletePodFromVolume() removes them from the index again.
+func Test_GetPodsForPVC(t *testing.T) {
+	// Arrange
+	volumePluginMgr, _ := volumetesting.GetTestKubeletVolumePluginMgr(t)
+	seLinuxTranslator := util.NewFakeSELinuxLabelTranslator()
+	dsw := NewDesiredStateOfWorld(volumePluginMgr, seLinuxTranslator)
+	pvcUID := k8stypes.UID("myClaimUID")
+	volumeSpec := &volume.Spec{
+		PersistentVolume: &v1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "basicPV",
+			},
+			Spec: v1.PersistentVolumeSpec{
+				ClaimRef: &v1.ObjectReference{
+					Namespace: "ns",
+					Name:      "myClaim",
+					UID:       pvcUID,
+				},
+				AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteMany},
+			},
+		},
+	}
+	pods := []*v1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "pod1",
+				UID:  "pod1uid",
+			},
+			Spec: v1.PodSpec{
+				Volumes: []v1.Volume{
+					{
+						Name: "volume-name",
+						VolumeSource: v1.VolumeSource{
+							PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+								ClaimName: "myClaim",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "pod2",
+				UID:  "pod2uid",
+			},
+			Spec: v1.PodSpec{
+				Volumes: []v1.Volume{
+					{
+						Name: "volume-name",
+						VolumeSource: v1.VolumeSource{
+							PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+								ClaimName: "myClaim",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// Act
+	var generatedVolumeName v1.UniqueVolumeName
+	for _, pod := range pods {
+		var err error
+		generatedVolumeName, err = dsw.AddPodToVolume(
+			util.GetUniquePodName(pod), pod, volumeSpec, volumeSpec.Name(), "" /* volumeGIDValue */, nil /* seLinuxContainerContexts */)
+		if err != nil {
+			t.Fatalf("AddPodToVolume failed. Expected: <no error> Actual: <%v>", err)
+		}
+	}
+
+	// Assert
+	podsForPVC := dsw.GetPodsForPVC(pvcUID)
+	if len(podsForPVC) != 2 {
+		t.Fatalf("GetPodsForPVC(%q) returned %d pods, expected 2", pvcUID, len(podsForPVC))
+	}
+	for _, podForPVC := range podsForPVC {
+		if podForPVC.VolumeName != generatedVolumeName {
+			t.Errorf("GetPodsForPVC(%q) returned volume %q for pod %q, expected %q",
+				pvcUID, podForPVC.VolumeName, podForPVC.PodName, generatedVolumeName)
+		}
+	}
+	if podsForOtherPVC := dsw.GetPodsForPVC("otherClaimUID"); len(podsForOtherPVC) != 0 {
+		t.Errorf("GetPodsForPVC(\"otherClaimUID\") returned %d pods, expected 0", len(podsForOtherPVC))
+	}
+
+	// Act
+	dsw.DeletePodFromVolume(util.GetUniquePodName(pods[0]), generatedVolumeName)
+
+	// Assert
+	podsForPVC = dsw.GetPodsForPVC(pvcUID)
+	if len(podsForPVC) != 1 {
+		t.Fatalf("GetPodsForPVC(%q) returned %d pods after delete, expected 1", pvcUID, len(podsForPVC))
+	}
+	if podsForPVC[0].PodName != util.GetUniquePodName(pods[1]) {
+		t.Errorf("GetPodsForPVC(%q) returned pod %q, expected %q",
+			pvcUID, podsForPVC[0].PodName, util.GetUniquePodName(pods[1]))
+	}
+
+	// Act
+	dsw.DeletePodFromVolume(util.GetUniquePodName(pods[1]), generatedVolumeName)
+
+	// Assert
+	if podsForPVC := dsw.GetPodsForPVC(pvcUID); len(podsForPVC) != 0 {
+		t.Errorf("GetPodsForPVC(%q) returned %d pods after deleting all pods, expected 0", pvcUID, len(podsForPVC))
+	}
+}
+
 func verifyVolumeExistsDsw(
 	t *testing.T, expectedVolumeName v1.UniqueVolumeName, expectedSELinuxContext string, dsw DesiredStateOfWorld) {
 	volumeExists := dsw.VolumeExists(expectedVolumeName, expectedSELinuxContext)
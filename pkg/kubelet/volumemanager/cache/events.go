@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	volumetypes "k8s.io/kubernetes/pkg/volume/util/types"
+)
+
+// VolumeEventType is the kind of volume state transition a VolumeEvent
+// reports.
+type VolumeEventType string
+
+const (
+	// VolumeMountedEvent is sent when a volume was mounted for a pod.
+	VolumeMountedEvent VolumeEventType = "VolumeMounted"
+	// VolumeUnmountedEvent is sent when a volume was unmounted for a pod.
+	VolumeUnmountedEvent VolumeEventType = "VolumeUnmounted"
+	// DeviceMountedEvent is sent when a device was mounted to its global
+	// mount path.
+	DeviceMountedEvent VolumeEventType = "DeviceMounted"
+	// DeviceUnmountedEvent is sent when a device was unmounted from its
+	// global mount path.
+	DeviceUnmountedEvent VolumeEventType = "DeviceUnmounted"
+)
+
+// VolumeEvent is a typed notification about a volume state transition in the
+// actual state of the world, delivered to internal subscribers (e.g. the
+// stats provider or the eviction manager) so they do not have to poll.
+type VolumeEvent struct {
+	// Type is the kind of transition.
+	Type VolumeEventType
+	// VolumeName is the unique name of the volume the transition happened
+	// for.
+	VolumeName v1.UniqueVolumeName
+	// PodName is the unique name of the pod for pod-scoped transitions
+	// (VolumeMounted and VolumeUnmounted); empty for device transitions.
+	PodName volumetypes.UniquePodName
+	// Timestamp is when the transition was recorded.
+	Timestamp time.Time
+}
+
+func (asw *actualStateOfWorld) SubscribeVolumeEvents(bufferSize int) (<-chan VolumeEvent, func()) {
+	asw.Lock()
+	defer asw.Unlock()
+
+	id := asw.nextVolumeEventSubscriberID
+	asw.nextVolumeEventSubscriberID++
+	events := make(chan VolumeEvent, bufferSize)
+	asw.volumeEventSubscribers[id] = events
+
+	cancel := func() {
+		asw.Lock()
+		defer asw.Unlock()
+		if _, subscribed := asw.volumeEventSubscribers[id]; !subscribed {
+			return
+		}
+		delete(asw.volumeEventSubscribers, id)
+		close(events)
+	}
+	return events, cancel
+}
+
+// notifyVolumeEvent delivers the event to all subscribers. Delivery is best
+// effort: when a subscriber's buffer is full, the event is dropped so that a
+// slow subscriber cannot block volume state updates. Callers must hold the
+// asw lock.
+func (asw *actualStateOfWorld) notifyVolumeEvent(event VolumeEvent) {
+	if len(asw.volumeEventSubscribers) == 0 {
+		return
+	}
+	event.Timestamp = time.Now()
+	for id, events := range asw.volumeEventSubscribers {
+		select {
+		case events <- event:
+		default:
+			klog.V(4).InfoS("Dropping volume event, subscriber buffer is full", "subscriber", id, "eventType", event.Type, "volumeName", event.VolumeName)
+		}
+	}
+}
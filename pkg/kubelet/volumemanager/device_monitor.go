@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumemanager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+
+	"k8s.io/kubernetes/pkg/kubelet/volumemanager/cache"
+)
+
+const (
+	// EvictOnDeviceRemovalAnnotation is a pod annotation that makes the
+	// kubelet delete the pod when the block device backing one of its mounted
+	// volumes is hot-unplugged from the node. Without the annotation the
+	// affected volumes are only marked unhealthy and an event is emitted.
+	EvictOnDeviceRemovalAnnotation = "volumes.kubernetes.io/evict-on-device-removal"
+
+	// VolumeDeviceRemovedReason is the event reason used when the backing
+	// device of a mounted volume disappears from the node.
+	VolumeDeviceRemovedReason = "VolumeDeviceRemoved"
+
+	// deviceMonitorRestartPeriod is how long the device monitor waits before
+	// re-establishing its uevent listener after a failure.
+	deviceMonitorRestartPeriod = 10 * time.Second
+)
+
+// deviceMonitor listens for kernel hot-unplug events of block devices and
+// reacts when a removed device backs a volume that is mounted on this node:
+// the volume is marked unhealthy in the actual state of the world, a warning
+// event is emitted for every pod using it and pods that opted in via the
+// EvictOnDeviceRemovalAnnotation are deleted.
+type deviceMonitor struct {
+	actualStateOfWorld cache.ActualStateOfWorld
+	podManager         PodManager
+	kubeClient         clientset.Interface
+	recorder           record.EventRecorder
+}
+
+func newDeviceMonitor(
+	actualStateOfWorld cache.ActualStateOfWorld,
+	podManager PodManager,
+	kubeClient clientset.Interface,
+	recorder record.EventRecorder) *deviceMonitor {
+	return &deviceMonitor{
+		actualStateOfWorld: actualStateOfWorld,
+		podManager:         podManager,
+		kubeClient:         kubeClient,
+		recorder:           recorder,
+	}
+}
+
+// Run listens for device removal events until stopCh is closed, restarting
+// the platform listener after failures.
+func (dm *deviceMonitor) Run(stopCh <-chan struct{}) {
+	wait.Until(func() {
+		if err := dm.listen(stopCh); err != nil {
+			klog.ErrorS(err, "Volume device hot-unplug monitor failed, restarting")
+		}
+	}, deviceMonitorRestartPeriod, stopCh)
+}
+
+// handleDeviceRemoved marks all volumes backed by the given device as
+// unhealthy and notifies the pods they are mounted to.
+func (dm *deviceMonitor) handleDeviceRemoved(devicePath string) {
+	for _, attachedVolume := range dm.actualStateOfWorld.GetAttachedVolumes() {
+		if attachedVolume.DevicePath == "" || attachedVolume.DevicePath != devicePath {
+			continue
+		}
+
+		reason := fmt.Sprintf("backing device %s was removed from the node", devicePath)
+		if err := dm.actualStateOfWorld.MarkVolumeUnhealthy(attachedVolume.VolumeName, reason); err != nil {
+			// The volume was detached between listing and marking.
+			klog.V(4).InfoS("Cannot mark volume unhealthy", "volumeName", attachedVolume.VolumeName, "err", err)
+			continue
+		}
+		klog.InfoS("Marked volume unhealthy because its backing device was removed",
+			"volumeName", attachedVolume.VolumeName, "devicePath", devicePath)
+
+		dm.notifyPodsUsingVolume(attachedVolume, reason)
+	}
+}
+
+func (dm *deviceMonitor) notifyPodsUsingVolume(attachedVolume cache.AttachedVolume, reason string) {
+	for _, mountedVolume := range dm.actualStateOfWorld.GetAllMountedVolumes() {
+		if mountedVolume.VolumeName != attachedVolume.VolumeName {
+			continue
+		}
+
+		pod, podExists := dm.podManager.GetPodByUID(mountedVolume.PodUID)
+		if !podExists {
+			continue
+		}
+		dm.recorder.Event(pod, v1.EventTypeWarning, VolumeDeviceRemovedReason,
+			fmt.Sprintf("Volume %q became unhealthy: %s", mountedVolume.OuterVolumeSpecName, reason))
+
+		if pod.Annotations[EvictOnDeviceRemovalAnnotation] != "true" || dm.kubeClient == nil {
+			continue
+		}
+		klog.InfoS("Deleting pod because the device backing its volume was removed",
+			"pod", klog.KObj(pod), "volumeName", mountedVolume.VolumeName)
+		if err := dm.kubeClient.CoreV1().Pods(pod.Namespace).Delete(
+			context.TODO(), pod.Name, metav1.DeleteOptions{}); err != nil {
+			klog.ErrorS(err, "Failed to delete pod after device removal", "pod", klog.KObj(pod))
+		}
+	}
+}
@@ -0,0 +1,89 @@
+//go:build linux
+// +build linux
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumemanager
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/sys/unix"
+	"k8s.io/klog/v2"
+)
+
+// ueventGroupKernel is the netlink multicast group on which the kernel
+// broadcasts uevents.
+const ueventGroupKernel = 1
+
+// listen subscribes to kernel uevents over netlink and dispatches block
+// device removal events until stopCh is closed.
+func (dm *deviceMonitor) listen(stopCh <-chan struct{}) error {
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC, unix.NETLINK_KOBJECT_UEVENT)
+	if err != nil {
+		return fmt.Errorf("failed to create uevent netlink socket: %v", err)
+	}
+	if err := unix.Bind(fd, &unix.SockaddrNetlink{Family: unix.AF_NETLINK, Groups: ueventGroupKernel}); err != nil {
+		unix.Close(fd)
+		return fmt.Errorf("failed to bind uevent netlink socket: %v", err)
+	}
+
+	// Closing the socket unblocks the Recvfrom below when the monitor is
+	// asked to stop.
+	go func() {
+		<-stopCh
+		unix.Close(fd)
+	}()
+
+	klog.V(2).InfoS("Listening for block device hot-unplug uevents")
+	buf := make([]byte, 4096)
+	for {
+		n, _, err := unix.Recvfrom(fd, buf, 0)
+		if err != nil {
+			select {
+			case <-stopCh:
+				return nil
+			default:
+			}
+			if err == unix.EINTR || err == unix.ENOBUFS {
+				continue
+			}
+			return fmt.Errorf("failed to receive uevent: %v", err)
+		}
+
+		event := parseUEvent(buf[:n])
+		if event["ACTION"] == "remove" && event["SUBSYSTEM"] == "block" && event["DEVNAME"] != "" {
+			dm.handleDeviceRemoved("/dev/" + event["DEVNAME"])
+		}
+	}
+}
+
+// parseUEvent parses a kernel uevent message, a NUL-separated list of
+// KEY=value pairs preceded by an "action@devpath" header.
+func parseUEvent(data []byte) map[string]string {
+	event := map[string]string{}
+	for _, field := range strings.Split(string(data), "\x00") {
+		key, value, found := strings.Cut(field, "=")
+		if !found || key == "" {
+			// The header and trailing empty fields carry no KEY=value.
+			continue
+		}
+		event[key] = value
+	}
+	return event
+}
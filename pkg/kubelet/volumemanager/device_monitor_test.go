@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumemanager
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclient "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	kubepod "k8s.io/kubernetes/pkg/kubelet/pod"
+	testingexec "k8s.io/utils/exec/testing"
+)
+
+func TestHandleDeviceRemoved(t *testing.T) {
+	// Reuse the quiescer fixture, which mounts one volume with device path
+	// "fake/device/path" for one pod.
+	_, asw, pod := newQuiescerWithMountedVolume(t, &testingexec.FakeExec{})
+	podManager := kubepod.NewBasicPodManager()
+	podManager.SetPods(nil)
+	podManager.AddPod(pod)
+	kubeClient := fakeclient.NewSimpleClientset(pod)
+	recorder := record.NewFakeRecorder(10)
+	monitor := newDeviceMonitor(asw, podManager, kubeClient, recorder)
+
+	// Removal of an unrelated device changes nothing.
+	monitor.handleDeviceRemoved("/dev/unrelated")
+	if unhealthy := asw.GetUnhealthyVolumes(); len(unhealthy) != 0 {
+		t.Fatalf("expected no unhealthy volumes, got %d", len(unhealthy))
+	}
+
+	monitor.handleDeviceRemoved("fake/device/path")
+	unhealthy := asw.GetUnhealthyVolumes()
+	if len(unhealthy) != 1 {
+		t.Fatalf("expected 1 unhealthy volume, got %d", len(unhealthy))
+	}
+	if !strings.Contains(unhealthy[0].UnhealthyReason, "fake/device/path") {
+		t.Errorf("expected unhealthy reason to name the device, got %q", unhealthy[0].UnhealthyReason)
+	}
+	select {
+	case event := <-recorder.Events:
+		if !strings.Contains(event, VolumeDeviceRemovedReason) {
+			t.Errorf("expected %s event, got %q", VolumeDeviceRemovedReason, event)
+		}
+	default:
+		t.Error("expected an event for the pod using the volume")
+	}
+	// The pod did not opt into eviction and must not be deleted.
+	if _, err := kubeClient.CoreV1().Pods(pod.Namespace).Get(context.TODO(), pod.Name, metav1.GetOptions{}); err != nil {
+		t.Errorf("expected pod to still exist: %v", err)
+	}
+}
+
+func TestHandleDeviceRemovedEvictsOptedInPod(t *testing.T) {
+	_, asw, pod := newQuiescerWithMountedVolume(t, &testingexec.FakeExec{})
+	pod.Annotations = map[string]string{EvictOnDeviceRemovalAnnotation: "true"}
+	podManager := kubepod.NewBasicPodManager()
+	podManager.SetPods(nil)
+	podManager.AddPod(pod)
+	kubeClient := fakeclient.NewSimpleClientset(pod)
+	monitor := newDeviceMonitor(asw, podManager, kubeClient, record.NewFakeRecorder(10))
+
+	monitor.handleDeviceRemoved("fake/device/path")
+	if _, err := kubeClient.CoreV1().Pods(pod.Namespace).Get(context.TODO(), pod.Name, metav1.GetOptions{}); err == nil {
+		t.Error("expected opted-in pod to be deleted after device removal")
+	}
+}
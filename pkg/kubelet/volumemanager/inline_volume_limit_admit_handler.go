@@ -0,0 +1,160 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumemanager
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/kubelet/lifecycle"
+)
+
+const (
+	// ExceededPodInlineVolumeLimit is the reason used to reject pods that
+	// declare more CSI ephemeral inline volumes than the per-pod limit.
+	ExceededPodInlineVolumeLimit = "ExceededPodInlineVolumeLimit"
+	// ExceededNodeInlineVolumeLimit is the reason used to reject pods whose
+	// CSI ephemeral inline volumes would exceed the per-node limit together
+	// with the volumes of already admitted pods.
+	ExceededNodeInlineVolumeLimit = "ExceededNodeInlineVolumeLimit"
+	// ExceededPodInlineVolumeSizeLimit is the reason used to reject pods
+	// whose CSI ephemeral inline volumes declare more aggregate capacity than
+	// the per-pod size limit.
+	ExceededPodInlineVolumeSizeLimit = "ExceededPodInlineVolumeSizeLimit"
+
+	// inlineVolumeSizeAttribute is the de-facto volume attribute under which
+	// drivers report the requested capacity of an ephemeral inline volume.
+	// Volumes without it count as zero toward the size limit.
+	inlineVolumeSizeAttribute = "size"
+)
+
+// inlineVolumeLimitAdmitHandler rejects pods whose CSI ephemeral inline
+// volumes exceed the configured per-pod or per-node limits. Inline volumes
+// bypass PVC quota, so without this check a single namespace can exhaust a
+// node's local storage or a driver's provisioning capacity without any
+// admission control. Limits come from the kubelet configuration; when none
+// are set the handler admits everything.
+type inlineVolumeLimitAdmitHandler struct {
+	getActivePods func() []*v1.Pod
+
+	maxVolumesPerPod  int
+	maxVolumesPerNode int
+	maxSizePerPod     *resource.Quantity
+}
+
+var _ lifecycle.PodAdmitHandler = &inlineVolumeLimitAdmitHandler{}
+
+// NewInlineVolumeLimitAdmitHandler returns a PodAdmitHandler enforcing the
+// CSI ephemeral inline volume limits from the kubelet configuration:
+// maxCSIInlineVolumesPerPod, maxCSIInlineVolumesPerNode and
+// maxCSIInlineVolumeSizePerPod, where zero or empty means no limit.
+// getActivePods supplies the pods already admitted on this node.
+func NewInlineVolumeLimitAdmitHandler(getActivePods func() []*v1.Pod, maxVolumesPerPod, maxVolumesPerNode int32, maxSizePerPod string) lifecycle.PodAdmitHandler {
+	h := &inlineVolumeLimitAdmitHandler{
+		getActivePods:     getActivePods,
+		maxVolumesPerPod:  int(maxVolumesPerPod),
+		maxVolumesPerNode: int(maxVolumesPerNode),
+	}
+	if maxSizePerPod != "" {
+		quantity, err := resource.ParseQuantity(maxSizePerPod)
+		if err != nil {
+			// Validation rejects an unparseable size limit, but the handler
+			// must not panic on one either.
+			klog.ErrorS(err, "Ignoring invalid inline volume size limit", "maxCSIInlineVolumeSizePerPod", maxSizePerPod)
+		} else {
+			h.maxSizePerPod = &quantity
+		}
+	}
+	return h
+}
+
+func (h *inlineVolumeLimitAdmitHandler) Admit(attrs *lifecycle.PodAdmitAttributes) lifecycle.PodAdmitResult {
+	pod := attrs.Pod
+	podCount, podSize := countInlineVolumes(pod)
+	if podCount == 0 {
+		return lifecycle.PodAdmitResult{Admit: true}
+	}
+
+	if h.maxVolumesPerPod > 0 && podCount > h.maxVolumesPerPod {
+		message := fmt.Sprintf(
+			"Pod declares %d CSI ephemeral inline volume(s) but the per-pod limit is %d",
+			podCount, h.maxVolumesPerPod)
+		klog.V(2).InfoS("Failed to admit pod, inline volume limit exceeded", "pod", klog.KObj(pod), "message", message)
+		return lifecycle.PodAdmitResult{
+			Admit:   false,
+			Reason:  ExceededPodInlineVolumeLimit,
+			Message: message,
+		}
+	}
+
+	if h.maxSizePerPod != nil && podSize.Cmp(*h.maxSizePerPod) > 0 {
+		message := fmt.Sprintf(
+			"Pod requests %s of CSI ephemeral inline volume capacity but the per-pod limit is %s",
+			podSize.String(), h.maxSizePerPod.String())
+		klog.V(2).InfoS("Failed to admit pod, inline volume size limit exceeded", "pod", klog.KObj(pod), "message", message)
+		return lifecycle.PodAdmitResult{
+			Admit:   false,
+			Reason:  ExceededPodInlineVolumeSizeLimit,
+			Message: message,
+		}
+	}
+
+	if h.maxVolumesPerNode > 0 {
+		nodeCount := podCount
+		for _, activePod := range h.getActivePods() {
+			if activePod.UID == pod.UID {
+				continue
+			}
+			count, _ := countInlineVolumes(activePod)
+			nodeCount += count
+		}
+		if nodeCount > h.maxVolumesPerNode {
+			message := fmt.Sprintf(
+				"Pod declares %d CSI ephemeral inline volume(s) but only %d of the node limit of %d are free",
+				podCount, h.maxVolumesPerNode-(nodeCount-podCount), h.maxVolumesPerNode)
+			klog.V(2).InfoS("Failed to admit pod, node inline volume limit exceeded", "pod", klog.KObj(pod), "message", message)
+			return lifecycle.PodAdmitResult{
+				Admit:   false,
+				Reason:  ExceededNodeInlineVolumeLimit,
+				Message: message,
+			}
+		}
+	}
+
+	return lifecycle.PodAdmitResult{Admit: true}
+}
+
+// countInlineVolumes returns the number of CSI ephemeral inline volumes the
+// pod declares and their aggregate declared capacity.
+func countInlineVolumes(pod *v1.Pod) (int, resource.Quantity) {
+	count := 0
+	var size resource.Quantity
+	for _, podVolume := range pod.Spec.Volumes {
+		if podVolume.CSI == nil {
+			continue
+		}
+		count++
+		if declared, ok := podVolume.CSI.VolumeAttributes[inlineVolumeSizeAttribute]; ok {
+			if quantity, err := resource.ParseQuantity(declared); err == nil {
+				size.Add(quantity)
+			}
+		}
+	}
+	return count, size
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumemanager
+
+import (
+	"fmt"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/kubernetes/pkg/kubelet/lifecycle"
+)
+
+func TestInlineVolumeLimitAdmitHandler(t *testing.T) {
+	tests := []struct {
+		name         string
+		pod          *v1.Pod
+		activePods   []*v1.Pod
+		podLimit     int32
+		nodeLimit    int32
+		sizeLimit    string
+		expectAdmit  bool
+		expectReason string
+	}{
+		{
+			name:        "no limits configured admits everything",
+			pod:         inlineVolumePod("pod1", 5),
+			expectAdmit: true,
+		},
+		{
+			name:        "pod without inline volumes is admitted",
+			pod:         inlineVolumePod("pod1", 0),
+			podLimit:    1,
+			expectAdmit: true,
+		},
+		{
+			name:        "pod within per-pod limit is admitted",
+			pod:         inlineVolumePod("pod1", 2),
+			podLimit:    2,
+			expectAdmit: true,
+		},
+		{
+			name:         "pod exceeding per-pod limit is rejected",
+			pod:          inlineVolumePod("pod1", 3),
+			podLimit:     2,
+			expectAdmit:  false,
+			expectReason: ExceededPodInlineVolumeLimit,
+		},
+		{
+			name:        "pod within node limit is admitted",
+			pod:         inlineVolumePod("pod1", 1),
+			activePods:  []*v1.Pod{inlineVolumePod("pod2", 2)},
+			nodeLimit:   3,
+			expectAdmit: true,
+		},
+		{
+			name:         "pod exceeding node limit is rejected",
+			pod:          inlineVolumePod("pod1", 2),
+			activePods:   []*v1.Pod{inlineVolumePod("pod2", 2)},
+			nodeLimit:    3,
+			expectAdmit:  false,
+			expectReason: ExceededNodeInlineVolumeLimit,
+		},
+		{
+			name:        "already admitted pod is not double counted",
+			pod:         inlineVolumePod("pod1", 2),
+			activePods:  []*v1.Pod{inlineVolumePod("pod1", 2)},
+			nodeLimit:   2,
+			expectAdmit: true,
+		},
+		{
+			name:        "pod within size limit is admitted",
+			pod:         inlineVolumePodWithSizes("pod1", "1Gi", "2Gi"),
+			sizeLimit:   "4Gi",
+			expectAdmit: true,
+		},
+		{
+			name:         "pod exceeding size limit is rejected",
+			pod:          inlineVolumePodWithSizes("pod1", "3Gi", "2Gi"),
+			sizeLimit:    "4Gi",
+			expectAdmit:  false,
+			expectReason: ExceededPodInlineVolumeSizeLimit,
+		},
+		{
+			name:        "invalid size limit is ignored",
+			pod:         inlineVolumePodWithSizes("pod1", "3Gi", "2Gi"),
+			sizeLimit:   "not-a-quantity",
+			expectAdmit: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := NewInlineVolumeLimitAdmitHandler(func() []*v1.Pod { return test.activePods }, test.podLimit, test.nodeLimit, test.sizeLimit)
+			result := handler.Admit(&lifecycle.PodAdmitAttributes{Pod: test.pod})
+			if result.Admit != test.expectAdmit {
+				t.Fatalf("expected admit=%v, got admit=%v (reason: %q, message: %q)",
+					test.expectAdmit, result.Admit, result.Reason, result.Message)
+			}
+			if !test.expectAdmit && result.Reason != test.expectReason {
+				t.Errorf("expected reason %q, got %q", test.expectReason, result.Reason)
+			}
+		})
+	}
+}
+
+func inlineVolumePod(name string, inlineVolumes int) *v1.Pod {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "ns",
+			UID:       k8stypes.UID(name + "-uid"),
+		},
+	}
+	for i := 0; i < inlineVolumes; i++ {
+		pod.Spec.Volumes = append(pod.Spec.Volumes, v1.Volume{
+			Name: fmt.Sprintf("inline-%d", i),
+			VolumeSource: v1.VolumeSource{
+				CSI: &v1.CSIVolumeSource{Driver: "test-driver"},
+			},
+		})
+	}
+	return pod
+}
+
+func inlineVolumePodWithSizes(name string, sizes ...string) *v1.Pod {
+	pod := inlineVolumePod(name, len(sizes))
+	for i, size := range sizes {
+		pod.Spec.Volumes[i].CSI.VolumeAttributes = map[string]string{
+			inlineVolumeSizeAttribute: size,
+		}
+	}
+	return pod
+}
@@ -31,10 +31,17 @@ const (
 
 	// Metric keys for Volume Manager.
 	volumeManagerTotalVolumes                     = "volume_manager_total_volumes"
+	volumeManagerSharedDeviceVolumes              = "volume_manager_shared_device_volumes"
 	reconstructVolumeOperationsTotal              = "reconstruct_volume_operations_total"
 	reconstructVolumeOperationsErrorsTotal        = "reconstruct_volume_operations_errors_total"
 	forceCleanedFailedVolumeOperationsTotal       = "force_cleaned_failed_volume_operations_total"
 	forceCleanedFailedVolumeOperationsErrorsTotal = "force_cleaned_failed_volume_operation_errors_total"
+	volumeObjectCacheLookupsTotal                 = "volume_object_cache_lookups_total"
+	skippedDuringReconstructionVolumes            = "skipped_during_reconstruction_volumes"
+	terminatedPodStatusTimeoutsTotal              = "terminated_pod_status_timeouts_total"
+	malformedPodVolumeDirsTotal                   = "malformed_pod_volume_dirs_total"
+	stackedMountsTotal                            = "volume_manager_stacked_mounts_total"
+	mountOptionDriftTotal                         = "volume_manager_mount_option_drift_total"
 )
 
 var (
@@ -48,6 +55,14 @@ var (
 		metrics.ALPHA, "",
 	)
 
+	sharedDeviceVolumesDesc = metrics.NewDesc(
+		volumeManagerSharedDeviceVolumes,
+		"Number of volumes in Volume Manager that share their backing physical device with at least one other volume, partitioned by device. Volumes on a shared device compete for its IO capacity.",
+		[]string{"backing_device"},
+		nil,
+		metrics.ALPHA, "",
+	)
+
 	ReconstructVolumeOperationsTotal = metrics.NewCounter(
 		&metrics.CounterOpts{
 			Name:           reconstructVolumeOperationsTotal,
@@ -77,6 +92,58 @@ var (
 			StabilityLevel: metrics.ALPHA,
 		},
 	)
+
+	VolumeObjectCacheLookupsTotal = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Name:           volumeObjectCacheLookupsTotal,
+			Help:           "The number of PVC and PV lookups of the desired state of world populator served from the informer cache versus falling back to a direct API GET, partitioned by resource and result.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"resource", "result"},
+	)
+
+	SkippedDuringReconstructionVolumes = metrics.NewGauge(
+		&metrics.GaugeOpts{
+			Name:           skippedDuringReconstructionVolumes,
+			Help:           "The number of volumes that were skipped during kubelet state reconstruction and still did not reach the actual state of the world.",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+
+	TerminatedPodStatusTimeoutsTotal = metrics.NewCounter(
+		&metrics.CounterOpts{
+			Name:           terminatedPodStatusTimeoutsTotal,
+			Help:           "The number of pods that were treated as terminated for volume cleanup because the container runtime did not confirm their termination within the configured timeout.",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+
+	MalformedPodVolumeDirsTotal = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Name:           malformedPodVolumeDirsTotal,
+			Help:           "The number of entries under per-pod volumes directories that did not match the expected layout and were skipped while scanning pod directories, partitioned by reason. Such entries usually indicate a volume plugin leaving stray files behind.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"reason"},
+	)
+
+	StackedMountsTotal = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Name:           stackedMountsTotal,
+			Help:           "The number of pod volume paths found mounted more than once with an identical source, partitioned by volume plugin. Stacked mounts are collapsed automatically; recurring occurrences point at a plugin retrying SetUp without cleaning up.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"plugin_name"},
+	)
+
+	MountOptionDriftTotal = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Name:           mountOptionDriftTotal,
+			Help:           "The number of mounted devices found with mount options drifted from the volume spec, partitioned by volume plugin and the action taken.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"plugin_name", "action"},
+	)
 )
 
 // volumeCount is a map of maps used as a counter.
@@ -99,6 +166,13 @@ func Register(asw cache.ActualStateOfWorld, dsw cache.DesiredStateOfWorld, plugi
 		legacyregistry.MustRegister(ReconstructVolumeOperationsErrorsTotal)
 		legacyregistry.MustRegister(ForceCleanedFailedVolumeOperationsTotal)
 		legacyregistry.MustRegister(ForceCleanedFailedVolumeOperationsErrorsTotal)
+		legacyregistry.MustRegister(VolumeObjectCacheLookupsTotal)
+		legacyregistry.MustRegister(SkippedDuringReconstructionVolumes)
+		legacyregistry.MustRegister(TerminatedPodStatusTimeoutsTotal)
+		legacyregistry.MustRegister(MalformedPodVolumeDirsTotal)
+		legacyregistry.MustRegister(PodVolumeStartupDuration)
+		legacyregistry.MustRegister(StackedMountsTotal)
+		legacyregistry.MustRegister(MountOptionDriftTotal)
 	})
 }
 
@@ -115,6 +189,7 @@ var _ metrics.StableCollector = &totalVolumesCollector{}
 // DescribeWithStability implements the metrics.StableCollector interface.
 func (c *totalVolumesCollector) DescribeWithStability(ch chan<- *metrics.Desc) {
 	ch <- totalVolumesDesc
+	ch <- sharedDeviceVolumesDesc
 }
 
 // CollectWithStability implements the metrics.StableCollector interface.
@@ -128,6 +203,17 @@ func (c *totalVolumesCollector) CollectWithStability(ch chan<- metrics.Metric) {
 				stateName)
 		}
 	}
+	for device, volumes := range c.asw.GetVolumesByBackingDevice() {
+		// Only devices shared by several volumes are interesting for
+		// noisy-neighbor attribution.
+		if len(volumes) < 2 {
+			continue
+		}
+		ch <- metrics.NewLazyConstMetric(sharedDeviceVolumesDesc,
+			metrics.GaugeValue,
+			float64(len(volumes)),
+			device)
+	}
 }
 
 func (c *totalVolumesCollector) getVolumeCount() volumeCount {
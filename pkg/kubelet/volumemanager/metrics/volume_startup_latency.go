@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/component-base/metrics"
+	volumetypes "k8s.io/kubernetes/pkg/volume/util/types"
+)
+
+const (
+	podVolumeStartupDuration = "volume_manager_pod_volume_startup_duration_seconds"
+
+	// noPriorityClass is reported for pods without a priority class so that
+	// the label is never empty.
+	noPriorityClass = "none"
+)
+
+// PodVolumeStartupDuration decomposes pod startup latency into the part spent
+// waiting for volumes: the time from the first volume of a pod entering the
+// desired state of the world until all volumes of the pod were mounted.
+var PodVolumeStartupDuration = metrics.NewHistogramVec(
+	&metrics.HistogramOpts{
+		Name:           podVolumeStartupDuration,
+		Help:           "Time in seconds from the first volume of a pod being added to the desired state of the world until all volumes of the pod were mounted, per pod priority class.",
+		Buckets:        metrics.ExponentialBuckets(0.1, 2, 12),
+		StabilityLevel: metrics.ALPHA,
+	},
+	[]string{"priority_class"},
+)
+
+// PodVolumeStartupLatency is the tracker feeding PodVolumeStartupDuration. It
+// is shared between the desired state of world populator, which records when
+// the first volume of a pod is added, and the volume manager, which observes
+// the latency once all volumes of the pod are mounted.
+var PodVolumeStartupLatency = &podVolumeStartupLatencyTracker{
+	firstVolumeAdded: map[volumetypes.UniquePodName]time.Time{},
+}
+
+type podVolumeStartupLatencyTracker struct {
+	mutex            sync.Mutex
+	firstVolumeAdded map[volumetypes.UniquePodName]time.Time
+}
+
+// RecordVolumeAdded records that a volume of the pod entered the desired
+// state of the world. Only the first volume of a pod starts the clock.
+func (t *podVolumeStartupLatencyTracker) RecordVolumeAdded(podName volumetypes.UniquePodName) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if _, ok := t.firstVolumeAdded[podName]; !ok {
+		t.firstVolumeAdded[podName] = time.Now()
+	}
+}
+
+// RecordVolumesMounted observes the volume startup latency of the pod. It is
+// a no-op for pods whose volume additions were never recorded, and at most
+// one observation is made per recorded pod.
+func (t *podVolumeStartupLatencyTracker) RecordVolumesMounted(podName volumetypes.UniquePodName, priorityClassName string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	start, ok := t.firstVolumeAdded[podName]
+	if !ok {
+		return
+	}
+	delete(t.firstVolumeAdded, podName)
+	if priorityClassName == "" {
+		priorityClassName = noPriorityClass
+	}
+	PodVolumeStartupDuration.WithLabelValues(priorityClassName).Observe(time.Since(start).Seconds())
+}
+
+// ForgetPod drops the recorded start time of a pod that went away before all
+// of its volumes were mounted.
+func (t *podVolumeStartupLatencyTracker) ForgetPod(podName volumetypes.UniquePodName) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	delete(t.firstVolumeAdded, podName)
+}
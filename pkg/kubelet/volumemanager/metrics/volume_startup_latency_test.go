@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/component-base/metrics/legacyregistry"
+	"k8s.io/component-base/metrics/testutil"
+	volumetypes "k8s.io/kubernetes/pkg/volume/util/types"
+)
+
+func TestPodVolumeStartupLatencyTracker(t *testing.T) {
+	legacyregistry.MustRegister(PodVolumeStartupDuration)
+	tracker := &podVolumeStartupLatencyTracker{
+		firstVolumeAdded: map[volumetypes.UniquePodName]time.Time{},
+	}
+	podName := volumetypes.UniquePodName("pod1uid")
+
+	// Observing a pod that was never recorded is a no-op.
+	tracker.RecordVolumesMounted(podName, "")
+	testutil.AssertHistogramTotalCount(t, podVolumeStartupDuration, map[string]string{}, 0)
+
+	// Only the first volume of a pod starts the clock.
+	tracker.RecordVolumeAdded(podName)
+	start := tracker.firstVolumeAdded[podName]
+	tracker.RecordVolumeAdded(podName)
+	if tracker.firstVolumeAdded[podName] != start {
+		t.Errorf("expected second volume addition to keep the original start time")
+	}
+
+	tracker.RecordVolumesMounted(podName, "high-priority")
+	testutil.AssertHistogramTotalCount(t, podVolumeStartupDuration, map[string]string{"priority_class": "high-priority"}, 1)
+	if _, ok := tracker.firstVolumeAdded[podName]; ok {
+		t.Errorf("expected pod to be dropped from the tracker after observation")
+	}
+
+	// A forgotten pod is not observed.
+	tracker.RecordVolumeAdded(podName)
+	tracker.ForgetPod(podName)
+	tracker.RecordVolumesMounted(podName, "")
+	testutil.AssertHistogramTotalCount(t, podVolumeStartupDuration, map[string]string{}, 1)
+}
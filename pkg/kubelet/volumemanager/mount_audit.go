@@ -0,0 +1,203 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumemanager
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// MountVisibilityViolationReason is the event reason used when a pod's
+	// volume mount makes data reachable from pods of another namespace.
+	MountVisibilityViolationReason = "MountVisibilityViolation"
+
+	// mountAuditInterval is how often the mount visibility audit runs.
+	mountAuditInterval = time.Minute
+)
+
+// mountAuditor periodically verifies that no pod's volume mount makes data
+// visible to pods of another namespace on the same node: hostPath volumes of
+// different namespaces that overlap share files, and subPath mounts that
+// escape their volume expose paths outside the volume. Violations are
+// reported once per pod as a warning event and an error log for security
+// review; the audit does not block or unmount anything.
+type mountAuditor struct {
+	podManager PodManager
+	recorder   record.EventRecorder
+	// reported holds the violations already reported, per pod UID, so that
+	// a persisting violation is not re-reported every interval. Only
+	// accessed from the audit loop.
+	reported map[k8stypes.UID]sets.Set[string]
+}
+
+// mountViolation is a single audit finding on a pod.
+type mountViolation struct {
+	pod     *v1.Pod
+	message string
+}
+
+func newMountAuditor(podManager PodManager, recorder record.EventRecorder) *mountAuditor {
+	return &mountAuditor{
+		podManager: podManager,
+		recorder:   recorder,
+		reported:   map[k8stypes.UID]sets.Set[string]{},
+	}
+}
+
+// Run audits mount visibility every mountAuditInterval until stopCh is
+// closed.
+func (ma *mountAuditor) Run(stopCh <-chan struct{}) {
+	wait.Until(ma.audit, mountAuditInterval, stopCh)
+}
+
+func (ma *mountAuditor) audit() {
+	pods := ma.podManager.GetPods()
+	activePods := sets.New[k8stypes.UID]()
+	for _, pod := range pods {
+		activePods.Insert(pod.UID)
+	}
+	for uid := range ma.reported {
+		if !activePods.Has(uid) {
+			delete(ma.reported, uid)
+		}
+	}
+
+	for _, violation := range auditMountVisibility(pods) {
+		reported, exists := ma.reported[violation.pod.UID]
+		if !exists {
+			reported = sets.New[string]()
+			ma.reported[violation.pod.UID] = reported
+		}
+		if reported.Has(violation.message) {
+			continue
+		}
+		reported.Insert(violation.message)
+		klog.ErrorS(nil, "Mount visibility violation", "pod", klog.KObj(violation.pod), "violation", violation.message)
+		ma.recorder.Event(violation.pod, v1.EventTypeWarning, MountVisibilityViolationReason, violation.message)
+	}
+}
+
+// auditMountVisibility returns the mount visibility violations of the given
+// pods: hostPath volumes overlapping across namespaces and subPath mounts
+// that escape their volume.
+func auditMountVisibility(pods []*v1.Pod) []mountViolation {
+	violations := auditSubPathEscapes(pods)
+	violations = append(violations, auditHostPathOverlaps(pods)...)
+	return violations
+}
+
+// hostPathUse is one hostPath volume of one pod.
+type hostPathUse struct {
+	pod        *v1.Pod
+	volumeName string
+	path       string
+}
+
+// auditHostPathOverlaps reports pairs of pods from different namespaces whose
+// hostPath volumes overlap, i.e. one path equals or contains the other. Such
+// pods share files on the host even though namespace boundaries suggest
+// isolation.
+func auditHostPathOverlaps(pods []*v1.Pod) []mountViolation {
+	var uses []hostPathUse
+	for _, pod := range pods {
+		for _, podVolume := range pod.Spec.Volumes {
+			if podVolume.HostPath == nil || podVolume.HostPath.Path == "" {
+				continue
+			}
+			uses = append(uses, hostPathUse{
+				pod:        pod,
+				volumeName: podVolume.Name,
+				path:       filepath.Clean(podVolume.HostPath.Path),
+			})
+		}
+	}
+
+	var violations []mountViolation
+	for i := range uses {
+		for j := i + 1; j < len(uses); j++ {
+			a, b := uses[i], uses[j]
+			if a.pod.Namespace == b.pod.Namespace {
+				continue
+			}
+			if !hostPathsOverlap(a.path, b.path) {
+				continue
+			}
+			violations = append(violations,
+				mountViolation{
+					pod:     a.pod,
+					message: fmt.Sprintf("hostPath volume %q (%s) overlaps with hostPath volume %q (%s) of pod %s/%s in another namespace", a.volumeName, a.path, b.volumeName, b.path, b.pod.Namespace, b.pod.Name),
+				},
+				mountViolation{
+					pod:     b.pod,
+					message: fmt.Sprintf("hostPath volume %q (%s) overlaps with hostPath volume %q (%s) of pod %s/%s in another namespace", b.volumeName, b.path, a.volumeName, a.path, a.pod.Namespace, a.pod.Name),
+				})
+		}
+	}
+	return violations
+}
+
+// hostPathsOverlap returns true when the two cleaned paths are equal or one
+// is contained in the other.
+func hostPathsOverlap(a, b string) bool {
+	if a == b {
+		return true
+	}
+	return strings.HasPrefix(a, b+string(filepath.Separator)) ||
+		strings.HasPrefix(b, a+string(filepath.Separator))
+}
+
+// auditSubPathEscapes reports volumeMounts whose subPath leaves the volume,
+// i.e. is absolute or traverses upwards. The API server validates this for
+// new pods; the audit catches pods admitted before that validation or through
+// other channels.
+func auditSubPathEscapes(pods []*v1.Pod) []mountViolation {
+	var violations []mountViolation
+	for _, pod := range pods {
+		for _, container := range pod.Spec.Containers {
+			for _, volumeMount := range container.VolumeMounts {
+				if volumeMount.SubPath == "" || !subPathEscapesVolume(volumeMount.SubPath) {
+					continue
+				}
+				violations = append(violations, mountViolation{
+					pod:     pod,
+					message: fmt.Sprintf("container %q mounts volume %q with subPath %q that escapes the volume", container.Name, volumeMount.Name, volumeMount.SubPath),
+				})
+			}
+		}
+	}
+	return violations
+}
+
+// subPathEscapesVolume returns true when the subPath resolves outside the
+// volume root.
+func subPathEscapesVolume(subPath string) bool {
+	if filepath.IsAbs(subPath) {
+		return true
+	}
+	cleaned := filepath.Clean(subPath)
+	return cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator))
+}
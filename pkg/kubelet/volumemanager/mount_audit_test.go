@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumemanager
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+)
+
+func hostPathPod(namespace, name string, uid k8stypes.UID, hostPath string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			UID:       uid,
+		},
+		Spec: v1.PodSpec{
+			Volumes: []v1.Volume{
+				{
+					Name: "host-volume",
+					VolumeSource: v1.VolumeSource{
+						HostPath: &v1.HostPathVolumeSource{Path: hostPath},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestAuditHostPathOverlaps(t *testing.T) {
+	tests := []struct {
+		name               string
+		pods               []*v1.Pod
+		expectedViolations int
+	}{
+		{
+			name: "overlapping hostPaths across namespaces",
+			pods: []*v1.Pod{
+				hostPathPod("ns1", "pod1", "pod1uid", "/var/data"),
+				hostPathPod("ns2", "pod2", "pod2uid", "/var/data/tenant"),
+			},
+			expectedViolations: 2,
+		},
+		{
+			name: "identical hostPaths across namespaces",
+			pods: []*v1.Pod{
+				hostPathPod("ns1", "pod1", "pod1uid", "/var/data"),
+				hostPathPod("ns2", "pod2", "pod2uid", "/var/data"),
+			},
+			expectedViolations: 2,
+		},
+		{
+			name: "overlapping hostPaths in the same namespace are allowed",
+			pods: []*v1.Pod{
+				hostPathPod("ns1", "pod1", "pod1uid", "/var/data"),
+				hostPathPod("ns1", "pod2", "pod2uid", "/var/data"),
+			},
+			expectedViolations: 0,
+		},
+		{
+			name: "disjoint hostPaths",
+			pods: []*v1.Pod{
+				hostPathPod("ns1", "pod1", "pod1uid", "/var/data-a"),
+				hostPathPod("ns2", "pod2", "pod2uid", "/var/data"),
+			},
+			expectedViolations: 0,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			violations := auditHostPathOverlaps(tc.pods)
+			if len(violations) != tc.expectedViolations {
+				t.Errorf("expected %d violations, got %d: %v", tc.expectedViolations, len(violations), violations)
+			}
+		})
+	}
+}
+
+func TestSubPathEscapesVolume(t *testing.T) {
+	tests := []struct {
+		subPath string
+		escapes bool
+	}{
+		{subPath: "data", escapes: false},
+		{subPath: "data/../other", escapes: false},
+		{subPath: "..", escapes: true},
+		{subPath: "../other", escapes: true},
+		{subPath: "data/../../other", escapes: true},
+		{subPath: "/etc/passwd", escapes: true},
+	}
+	for _, tc := range tests {
+		if escapes := subPathEscapesVolume(tc.subPath); escapes != tc.escapes {
+			t.Errorf("subPathEscapesVolume(%q) = %v, expected %v", tc.subPath, escapes, tc.escapes)
+		}
+	}
+}
+
+func TestAuditSubPathEscapes(t *testing.T) {
+	pod := newRemountTestPod()
+	pod.Spec.Containers = []v1.Container{
+		{
+			Name: "container1",
+			VolumeMounts: []v1.VolumeMount{
+				{Name: "volume-name", MountPath: "/data", SubPath: "../../etc"},
+				{Name: "volume-name", MountPath: "/logs", SubPath: "logs"},
+			},
+		},
+	}
+	violations := auditSubPathEscapes([]*v1.Pod{pod})
+	if len(violations) != 1 {
+		t.Fatalf("expected 1 violation, got %d: %v", len(violations), violations)
+	}
+	if violations[0].pod != pod {
+		t.Errorf("expected the violation to be attributed to the pod")
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumemanager
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	volumetypes "k8s.io/kubernetes/pkg/volume/util/types"
+	"k8s.io/utils/clock"
+)
+
+const (
+	// MountDeadlineAnnotation is a pod annotation holding the total budget
+	// (as a Go duration, e.g. "5m") for all of the pod's volumes to mount,
+	// measured from the first mount attempt. Without it, every sync applies
+	// only the regular per-attempt timeout and the pod retries forever.
+	MountDeadlineAnnotation = "volumes.kubernetes.io/mount-deadline"
+
+	// MountDeadlineActionAnnotation selects what happens when the deadline
+	// expires: "condition" (the default) only sets the pod condition,
+	// "fail" additionally fails the pod unless its restart policy is
+	// Always, so Jobs with infeasible volumes terminate instead of hanging.
+	MountDeadlineActionAnnotation = "volumes.kubernetes.io/mount-deadline-action"
+
+	mountDeadlineActionCondition = "condition"
+	mountDeadlineActionFail      = "fail"
+
+	// MountDeadlineExceededReason is the event and rejection reason used
+	// when a pod's mount deadline expired. It matches the reason of the
+	// VolumeMountDeadlineExceeded pod condition.
+	MountDeadlineExceededReason = "VolumeMountDeadlineExceeded"
+)
+
+// MountDeadlineExceededError is returned by WaitForAttachAndMount when the
+// pod's mount deadline expired. When FailPod is true the pod opted into the
+// fail action and the caller should fail it through the status manager.
+type MountDeadlineExceededError struct {
+	Message string
+	FailPod bool
+}
+
+func (e *MountDeadlineExceededError) Error() string {
+	return fmt.Sprintf("%s: %s", MountDeadlineExceededReason, e.Message)
+}
+
+// mountDeadlineTracker remembers when the kubelet first tried to mount the
+// volumes of each pod carrying a mount deadline and enforces the deadline.
+// It only keeps node-local state; the expired deadline surfaces on the pod
+// through the regular status generation path, never through direct API
+// writes from the volume manager.
+type mountDeadlineTracker struct {
+	recorder record.EventRecorder
+	clock    clock.Clock
+
+	mutex        sync.Mutex
+	firstAttempt map[volumetypes.UniquePodName]time.Time
+	expired      map[volumetypes.UniquePodName]string
+}
+
+func newMountDeadlineTracker(recorder record.EventRecorder) *mountDeadlineTracker {
+	return &mountDeadlineTracker{
+		recorder:     recorder,
+		clock:        clock.RealClock{},
+		firstAttempt: map[volumetypes.UniquePodName]time.Time{},
+		expired:      map[volumetypes.UniquePodName]string{},
+	}
+}
+
+// podMountDeadline returns the deadline requested by the pod's annotation and
+// whether one was set.
+func podMountDeadline(pod *v1.Pod) (time.Duration, bool, error) {
+	value, ok := pod.Annotations[MountDeadlineAnnotation]
+	if !ok {
+		return 0, false, nil
+	}
+	deadline, err := time.ParseDuration(value)
+	if err != nil || deadline <= 0 {
+		return 0, false, fmt.Errorf("invalid %s value %q", MountDeadlineAnnotation, value)
+	}
+	return deadline, true, nil
+}
+
+// remaining records the first mount attempt for the pod and returns how much
+// of the deadline is left; zero or negative means the deadline expired.
+func (t *mountDeadlineTracker) remaining(podName volumetypes.UniquePodName, deadline time.Duration) time.Duration {
+	now := t.clock.Now()
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	first, ok := t.firstAttempt[podName]
+	if !ok {
+		t.firstAttempt[podName] = now
+		first = now
+	}
+	return deadline - now.Sub(first)
+}
+
+// forget drops the tracking state of the pod, e.g. after its volumes mounted
+// or the pod started tearing down.
+func (t *mountDeadlineTracker) forget(podName volumetypes.UniquePodName) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	delete(t.firstAttempt, podName)
+	delete(t.expired, podName)
+}
+
+// exceededMessage returns the message of the pod's expired mount deadline
+// and whether the deadline has expired.
+func (t *mountDeadlineTracker) exceededMessage(podName volumetypes.UniquePodName) (string, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	message, ok := t.expired[podName]
+	return message, ok
+}
+
+// handleExpired reports the expired deadline: it emits an event, remembers
+// the expiry so the VolumeMountDeadlineExceeded condition is set during
+// status generation, and returns the error to surface from
+// WaitForAttachAndMount. When the pod opted into the fail action and its
+// restart policy permits, the returned error asks the caller to fail the pod
+// through the status manager.
+func (t *mountDeadlineTracker) handleExpired(pod *v1.Pod, podName volumetypes.UniquePodName, deadline time.Duration, unmountedVolumes []string) error {
+	message := fmt.Sprintf("volumes %v did not mount within the %s deadline", unmountedVolumes, deadline)
+	if t.recorder != nil {
+		t.recorder.Eventf(pod, v1.EventTypeWarning, MountDeadlineExceededReason, message)
+	}
+
+	t.mutex.Lock()
+	t.expired[podName] = message
+	t.mutex.Unlock()
+
+	return &MountDeadlineExceededError{
+		Message: message,
+		FailPod: pod.Annotations[MountDeadlineActionAnnotation] == mountDeadlineActionFail &&
+			pod.Spec.RestartPolicy != v1.RestartPolicyAlways,
+	}
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumemanager
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	volumetypes "k8s.io/kubernetes/pkg/volume/util/types"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestPodMountDeadline(t *testing.T) {
+	testCases := []struct {
+		name        string
+		annotations map[string]string
+		expected    time.Duration
+		expectSet   bool
+		expectError bool
+	}{
+		{name: "no annotation"},
+		{name: "valid", annotations: map[string]string{MountDeadlineAnnotation: "5m"}, expected: 5 * time.Minute, expectSet: true},
+		{name: "unparsable", annotations: map[string]string{MountDeadlineAnnotation: "soon"}, expectError: true},
+		{name: "negative", annotations: map[string]string{MountDeadlineAnnotation: "-1m"}, expectError: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations}}
+			deadline, set, err := podMountDeadline(pod)
+			if tc.expectError != (err != nil) {
+				t.Fatalf("expected error=%v, got %v", tc.expectError, err)
+			}
+			if set != tc.expectSet || deadline != tc.expected {
+				t.Errorf("expected (%v, %v), got (%v, %v)", tc.expected, tc.expectSet, deadline, set)
+			}
+		})
+	}
+}
+
+func TestMountDeadlineTracker(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	tracker := newMountDeadlineTracker(nil)
+	tracker.clock = fakeClock
+	podName := volumetypes.UniquePodName("pod1uid")
+	deadline := 5 * time.Minute
+
+	if remaining := tracker.remaining(podName, deadline); remaining != deadline {
+		t.Errorf("expected the full deadline on the first attempt, got %v", remaining)
+	}
+	fakeClock.Step(2 * time.Minute)
+	if remaining := tracker.remaining(podName, deadline); remaining != 3*time.Minute {
+		t.Errorf("expected 3m remaining, got %v", remaining)
+	}
+	fakeClock.Step(4 * time.Minute)
+	if remaining := tracker.remaining(podName, deadline); remaining > 0 {
+		t.Errorf("expected the deadline to be expired, got %v remaining", remaining)
+	}
+
+	// After forgetting, the deadline starts over.
+	tracker.forget(podName)
+	if remaining := tracker.remaining(podName, deadline); remaining != deadline {
+		t.Errorf("expected the full deadline after forget, got %v", remaining)
+	}
+}
+
+func TestHandleExpiredMountDeadline(t *testing.T) {
+	testCases := []struct {
+		name          string
+		action        string
+		restartPolicy v1.RestartPolicy
+		expectFail    bool
+	}{
+		{name: "default action only sets the condition", restartPolicy: v1.RestartPolicyNever},
+		{name: "fail action asks the caller to fail the pod", action: "fail", restartPolicy: v1.RestartPolicyNever, expectFail: true},
+		{name: "fail action respects restartPolicy Always", action: "fail", restartPolicy: v1.RestartPolicyAlways},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pod1",
+					Namespace: "ns",
+					UID:       "pod1uid",
+				},
+				Spec: v1.PodSpec{RestartPolicy: tc.restartPolicy},
+			}
+			if tc.action != "" {
+				pod.Annotations = map[string]string{MountDeadlineActionAnnotation: tc.action}
+			}
+			fakeRecorder := record.NewFakeRecorder(10)
+			tracker := newMountDeadlineTracker(fakeRecorder)
+
+			err := tracker.handleExpired(pod, "pod1uid", 5*time.Minute, []string{"vol-1"})
+			if err == nil {
+				t.Fatalf("expected handleExpired to return an error")
+			}
+			select {
+			case <-fakeRecorder.Events:
+			default:
+				t.Errorf("expected a warning event")
+			}
+
+			var deadlineErr *MountDeadlineExceededError
+			if !errors.As(err, &deadlineErr) {
+				t.Fatalf("expected a MountDeadlineExceededError, got %v", err)
+			}
+			if deadlineErr.FailPod != tc.expectFail {
+				t.Errorf("expected FailPod=%v, got %v", tc.expectFail, deadlineErr.FailPod)
+			}
+			if message, expired := tracker.exceededMessage("pod1uid"); !expired || message != deadlineErr.Message {
+				t.Errorf("expected the expiry to be remembered with message %q, got (%q, %v)", deadlineErr.Message, message, expired)
+			}
+
+			// The expiry is dropped with the rest of the pod state.
+			tracker.forget("pod1uid")
+			if _, expired := tracker.exceededMessage("pod1uid"); expired {
+				t.Errorf("expected the expiry to be forgotten")
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumemanager
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	compbasemetrics "k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+	kevents "k8s.io/kubernetes/pkg/kubelet/events"
+	"k8s.io/utils/clock"
+)
+
+const (
+	// mountFailureAggregationInterval is how often at most one event is
+	// emitted for the same recurring mount failure of a pod volume.
+	mountFailureAggregationInterval = time.Minute
+
+	// mountFailureRetentionInterval is how long a failure without new
+	// occurrences is remembered. A failure that recurs after this interval
+	// starts a fresh aggregation.
+	mountFailureRetentionInterval = 10 * mountFailureAggregationInterval
+)
+
+var (
+	suppressedMountFailureEvents = compbasemetrics.NewCounter(
+		&compbasemetrics.CounterOpts{
+			Name:           "volume_manager_mount_failure_events_suppressed_total",
+			Help:           "Number of FailedMount pod events that were suppressed because an identical failure was already reported recently.",
+			StabilityLevel: compbasemetrics.ALPHA,
+		},
+	)
+
+	registerMountFailureMetrics sync.Once
+)
+
+// aggregatedMountFailure tracks the recurrences of one identical mount
+// failure.
+type aggregatedMountFailure struct {
+	count       int
+	firstSeen   time.Time
+	lastSeen    time.Time
+	lastEmitted time.Time
+}
+
+// mountFailureAggregatingRecorder is an EventRecorder that collapses repeated
+// identical FailedMount warnings for the same object into a single event per
+// aggregation interval, annotated with a counter and the time of the first
+// occurrence. A mount that keeps failing otherwise produces an event on every
+// reconciler retry, drowning out everything else on the pod. All other events
+// pass through unchanged.
+type mountFailureAggregatingRecorder struct {
+	delegate record.EventRecorder
+	clock    clock.Clock
+
+	mutex    sync.Mutex
+	failures map[string]*aggregatedMountFailure
+}
+
+var _ record.EventRecorder = &mountFailureAggregatingRecorder{}
+
+// newMountFailureAggregatingRecorder wraps the given recorder with FailedMount
+// aggregation.
+func newMountFailureAggregatingRecorder(delegate record.EventRecorder) record.EventRecorder {
+	registerMountFailureMetrics.Do(func() {
+		legacyregistry.MustRegister(suppressedMountFailureEvents)
+	})
+	return &mountFailureAggregatingRecorder{
+		delegate: delegate,
+		clock:    clock.RealClock{},
+		failures: map[string]*aggregatedMountFailure{},
+	}
+}
+
+func (r *mountFailureAggregatingRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	if eventtype == v1.EventTypeWarning && reason == kevents.FailedMountVolume {
+		r.recordMountFailure(object, eventtype, reason, message)
+		return
+	}
+	r.delegate.Event(object, eventtype, reason, message)
+}
+
+func (r *mountFailureAggregatingRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	if eventtype == v1.EventTypeWarning && reason == kevents.FailedMountVolume {
+		r.recordMountFailure(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+		return
+	}
+	r.delegate.Eventf(object, eventtype, reason, messageFmt, args...)
+}
+
+func (r *mountFailureAggregatingRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.delegate.AnnotatedEventf(object, annotations, eventtype, reason, messageFmt, args...)
+}
+
+// recordMountFailure emits the first occurrence of a failure verbatim and at
+// most one summarizing event per aggregation interval afterwards.
+func (r *mountFailureAggregatingRecorder) recordMountFailure(object runtime.Object, eventtype, reason, message string) {
+	key := mountFailureKey(object, message)
+	now := r.clock.Now()
+
+	r.mutex.Lock()
+	r.pruneLocked(now)
+	failure, known := r.failures[key]
+	if !known {
+		r.failures[key] = &aggregatedMountFailure{
+			count:       1,
+			firstSeen:   now,
+			lastSeen:    now,
+			lastEmitted: now,
+		}
+		r.mutex.Unlock()
+		r.delegate.Event(object, eventtype, reason, message)
+		return
+	}
+	failure.count++
+	failure.lastSeen = now
+	if now.Sub(failure.lastEmitted) < mountFailureAggregationInterval {
+		r.mutex.Unlock()
+		suppressedMountFailureEvents.Inc()
+		return
+	}
+	failure.lastEmitted = now
+	count := failure.count
+	firstSeen := failure.firstSeen
+	r.mutex.Unlock()
+
+	r.delegate.Event(object, eventtype, reason,
+		fmt.Sprintf("%s (repeated %d times since %s)", message, count, firstSeen.Format(time.RFC3339)))
+}
+
+// pruneLocked drops failures that stopped recurring. Callers must hold the
+// mutex.
+func (r *mountFailureAggregatingRecorder) pruneLocked(now time.Time) {
+	for key, failure := range r.failures {
+		if now.Sub(failure.lastSeen) > mountFailureRetentionInterval {
+			delete(r.failures, key)
+		}
+	}
+}
+
+// mountFailureKey identifies one recurring failure: the same message for the
+// same object. The message contains the volume name, so failures of different
+// volumes of a pod aggregate separately.
+func mountFailureKey(object runtime.Object, message string) string {
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return message
+	}
+	return string(accessor.GetUID()) + "/" + message
+}
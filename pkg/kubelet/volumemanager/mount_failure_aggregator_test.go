@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumemanager
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	kevents "k8s.io/kubernetes/pkg/kubelet/events"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestMountFailureAggregation(t *testing.T) {
+	fakeRecorder := record.NewFakeRecorder(100)
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	recorder := &mountFailureAggregatingRecorder{
+		delegate: fakeRecorder,
+		clock:    fakeClock,
+		failures: map[string]*aggregatedMountFailure{},
+	}
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod1", UID: "pod1uid"}}
+
+	nextEvent := func() string {
+		t.Helper()
+		select {
+		case event := <-fakeRecorder.Events:
+			return event
+		default:
+			t.Fatalf("expected an event, got none")
+			return ""
+		}
+	}
+	expectNoEvent := func() {
+		t.Helper()
+		select {
+		case event := <-fakeRecorder.Events:
+			t.Fatalf("expected no event, got %q", event)
+		default:
+		}
+	}
+
+	// The first occurrence is emitted verbatim.
+	recorder.Eventf(pod, v1.EventTypeWarning, kevents.FailedMountVolume, "MountVolume.SetUp failed for volume %q: timed out", "vol-1")
+	if event := nextEvent(); !strings.Contains(event, `volume "vol-1"`) || strings.Contains(event, "repeated") {
+		t.Errorf("expected the first failure verbatim, got %q", event)
+	}
+
+	// Repeats within the aggregation interval are suppressed.
+	for i := 0; i < 5; i++ {
+		fakeClock.Step(time.Second)
+		recorder.Eventf(pod, v1.EventTypeWarning, kevents.FailedMountVolume, "MountVolume.SetUp failed for volume %q: timed out", "vol-1")
+	}
+	expectNoEvent()
+
+	// A different failure message is not aggregated with the first one.
+	recorder.Eventf(pod, v1.EventTypeWarning, kevents.FailedMountVolume, "MountVolume.SetUp failed for volume %q: timed out", "vol-2")
+	if event := nextEvent(); !strings.Contains(event, `volume "vol-2"`) {
+		t.Errorf("expected the other volume's failure to be emitted, got %q", event)
+	}
+
+	// After the aggregation interval the recurring failure is emitted once,
+	// with a counter.
+	fakeClock.Step(mountFailureAggregationInterval)
+	recorder.Eventf(pod, v1.EventTypeWarning, kevents.FailedMountVolume, "MountVolume.SetUp failed for volume %q: timed out", "vol-1")
+	if event := nextEvent(); !strings.Contains(event, "repeated 7 times since") {
+		t.Errorf("expected a summarizing event with a counter, got %q", event)
+	}
+	expectNoEvent()
+
+	// Non-FailedMount events pass through untouched.
+	recorder.Eventf(pod, v1.EventTypeNormal, kevents.SuccessfulMountVolume, "MountVolume.SetUp succeeded")
+	recorder.Eventf(pod, v1.EventTypeNormal, kevents.SuccessfulMountVolume, "MountVolume.SetUp succeeded")
+	nextEvent()
+	nextEvent()
+
+	// A failure that stopped recurring for the retention interval starts a
+	// fresh aggregation.
+	fakeClock.Step(mountFailureRetentionInterval + time.Second)
+	recorder.Eventf(pod, v1.EventTypeWarning, kevents.FailedMountVolume, "MountVolume.SetUp failed for volume %q: timed out", "vol-1")
+	if event := nextEvent(); strings.Contains(event, "repeated") {
+		t.Errorf("expected a fresh failure to be emitted verbatim, got %q", event)
+	}
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumemanager
+
+import (
+	"fmt"
+	"path/filepath"
+
+	v1 "k8s.io/api/core/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/volume/csi"
+	volumetypes "k8s.io/kubernetes/pkg/volume/util/types"
+)
+
+// PodMountStateSchemaVersion identifies the layout of PodMountState. It is
+// bumped whenever fields are removed or change meaning, so that a node
+// importing state exported by another kubelet can detect incompatibilities.
+const PodMountStateSchemaVersion = "v1"
+
+// PodMountState is an exported snapshot of the volume mount state of one pod:
+// its device paths, staged paths and CSI publish contexts. It is a building
+// block for checkpoint/restore-based migration of stateful pods; exporting on
+// the source node and importing on the target node is experimental and the
+// imported state is not acted on yet.
+type PodMountState struct {
+	// SchemaVersion identifies the layout of this structure, see
+	// PodMountStateSchemaVersion.
+	SchemaVersion string `json:"schemaVersion"`
+
+	// NodeName is the name of the node the state was exported from.
+	NodeName string `json:"nodeName"`
+
+	// PodUID is the UID of the pod the state belongs to.
+	PodUID k8stypes.UID `json:"podUID"`
+
+	// Volumes describes the pod's mounted volumes, in no particular order.
+	Volumes []PodMountStateVolume `json:"volumes"`
+}
+
+// PodMountStateVolume describes the mount state of one volume of the
+// exported pod.
+type PodMountStateVolume struct {
+	// VolumeName is the unique name of the volume.
+	VolumeName v1.UniqueVolumeName `json:"volumeName"`
+
+	// PluginName is the unescaped qualified name of the volume plugin that
+	// mounted the volume, e.g. "kubernetes.io/csi".
+	PluginName string `json:"pluginName"`
+
+	// OuterVolumeSpecName is the pod.Spec.Volumes[x].Name of the volume.
+	OuterVolumeSpecName string `json:"outerVolumeSpecName"`
+
+	// MountPath is the host path the volume is mounted at (filesystem
+	// volumes) or the device symlink path it is mapped to (block volumes).
+	MountPath string `json:"mountPath"`
+
+	// Block is true for raw block volume mappings.
+	Block bool `json:"block,omitempty"`
+
+	// DevicePath is the path the backing device is attached at on the
+	// node. Empty for non-attachable volumes.
+	DevicePath string `json:"devicePath,omitempty"`
+
+	// DeviceMountPath is the path the device is globally mounted at. For
+	// CSI volumes this is the staging path.
+	DeviceMountPath string `json:"deviceMountPath,omitempty"`
+
+	// PublishContext is the CSI ControllerPublishVolume publish context
+	// persisted next to the staged volume, if any.
+	PublishContext map[string]string `json:"publishContext,omitempty"`
+}
+
+// ExportPodMountState returns the volume mount state of the given pod. It
+// returns an error when the pod has no mounted volumes.
+func (vm *volumeManager) ExportPodMountState(podUID k8stypes.UID) (PodMountState, error) {
+	state := PodMountState{
+		SchemaVersion: PodMountStateSchemaVersion,
+		NodeName:      string(vm.nodeName),
+		PodUID:        podUID,
+		Volumes:       []PodMountStateVolume{},
+	}
+
+	devices := make(map[v1.UniqueVolumeName]PodMountStateVolume)
+	for _, attachedVolume := range vm.actualStateOfWorld.GetGloballyMountedVolumes() {
+		device := PodMountStateVolume{
+			DevicePath:      attachedVolume.DevicePath,
+			DeviceMountPath: attachedVolume.DeviceMountPath,
+		}
+		if attachedVolume.PluginName == csi.CSIPluginName && attachedVolume.DeviceMountPath != "" {
+			device.PublishContext, _ = csi.LoadPublishContext(filepath.Dir(attachedVolume.DeviceMountPath))
+		}
+		devices[attachedVolume.VolumeName] = device
+	}
+
+	for _, mountedVolume := range vm.actualStateOfWorld.GetMountedVolumesForPod(volumetypes.UniquePodName(podUID)) {
+		stateVolume := PodMountStateVolume{
+			VolumeName:          mountedVolume.VolumeName,
+			PluginName:          mountedVolume.PluginName,
+			OuterVolumeSpecName: mountedVolume.OuterVolumeSpecName,
+		}
+		if mountedVolume.Mounter != nil {
+			stateVolume.MountPath = mountedVolume.Mounter.GetPath()
+		} else if mountedVolume.BlockVolumeMapper != nil {
+			mapPath, linkName := mountedVolume.BlockVolumeMapper.GetPodDeviceMapPath()
+			stateVolume.MountPath = filepath.Join(mapPath, linkName)
+			stateVolume.Block = true
+		}
+		if device, exists := devices[mountedVolume.VolumeName]; exists {
+			stateVolume.DevicePath = device.DevicePath
+			stateVolume.DeviceMountPath = device.DeviceMountPath
+			stateVolume.PublishContext = device.PublishContext
+		}
+		state.Volumes = append(state.Volumes, stateVolume)
+	}
+
+	if len(state.Volumes) == 0 {
+		return PodMountState{}, fmt.Errorf("pod %q has no mounted volumes", podUID)
+	}
+	return state, nil
+}
+
+// ImportPodMountState validates and records mount state exported from
+// another node. The imported state is kept for inspection only; acting on it
+// during pod startup is left to the future migration flow.
+func (vm *volumeManager) ImportPodMountState(state PodMountState) error {
+	if state.SchemaVersion != PodMountStateSchemaVersion {
+		return fmt.Errorf("unsupported pod mount state schema version %q, expected %q", state.SchemaVersion, PodMountStateSchemaVersion)
+	}
+	if state.PodUID == "" {
+		return fmt.Errorf("pod mount state has no pod UID")
+	}
+	if state.NodeName == string(vm.nodeName) {
+		return fmt.Errorf("pod mount state of pod %q was exported from this node", state.PodUID)
+	}
+	for _, stateVolume := range state.Volumes {
+		if vm.actualStateOfWorld.VolumeExists(stateVolume.VolumeName) {
+			return fmt.Errorf("volume %q of pod %q is already known to this node", stateVolume.VolumeName, state.PodUID)
+		}
+	}
+
+	vm.importedMountStatesLock.Lock()
+	defer vm.importedMountStatesLock.Unlock()
+	if vm.importedMountStates == nil {
+		vm.importedMountStates = map[k8stypes.UID]PodMountState{}
+	}
+	vm.importedMountStates[state.PodUID] = state
+	klog.InfoS("Imported pod mount state", "podUID", state.PodUID, "sourceNode", state.NodeName, "volumes", len(state.Volumes))
+	return nil
+}
+
+// GetImportedPodMountState returns the mount state previously imported for
+// the given pod, if any.
+func (vm *volumeManager) GetImportedPodMountState(podUID k8stypes.UID) (PodMountState, bool) {
+	vm.importedMountStatesLock.Lock()
+	defer vm.importedMountStatesLock.Unlock()
+	state, exists := vm.importedMountStates[podUID]
+	return state, exists
+}
+
+// forgetImportedPodMountState drops the imported mount state of the pod, if
+// any, e.g. when the pod is removed from the node before the migration flow
+// consumed the state.
+func (vm *volumeManager) forgetImportedPodMountState(podUID k8stypes.UID) {
+	vm.importedMountStatesLock.Lock()
+	defer vm.importedMountStatesLock.Unlock()
+	delete(vm.importedMountStates, podUID)
+}
@@ -32,6 +32,7 @@ import (
 	"k8s.io/kubernetes/pkg/features"
 
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -41,6 +42,7 @@ import (
 	"k8s.io/kubernetes/pkg/kubelet/config"
 	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
 	"k8s.io/kubernetes/pkg/kubelet/volumemanager/cache"
+	"k8s.io/kubernetes/pkg/kubelet/volumemanager/metrics"
 	"k8s.io/kubernetes/pkg/volume"
 	"k8s.io/kubernetes/pkg/volume/csimigration"
 	"k8s.io/kubernetes/pkg/volume/util"
@@ -103,7 +105,8 @@ func NewDesiredStateOfWorldPopulator(
 	kubeContainerRuntime kubecontainer.Runtime,
 	csiMigratedPluginManager csimigration.PluginManager,
 	intreeToCSITranslator csimigration.InTreeToCSITranslator,
-	volumePluginMgr *volume.VolumePluginMgr) DesiredStateOfWorldPopulator {
+	volumePluginMgr *volume.VolumePluginMgr,
+	volumeObjectCache *ObjectCache) DesiredStateOfWorldPopulator {
 	return &desiredStateOfWorldPopulator{
 		kubeClient:          kubeClient,
 		loopSleepDuration:   loopSleepDuration,
@@ -119,6 +122,9 @@ func NewDesiredStateOfWorldPopulator(
 		csiMigratedPluginManager: csiMigratedPluginManager,
 		intreeToCSITranslator:    intreeToCSITranslator,
 		volumePluginMgr:          volumePluginMgr,
+		objectCache:              volumeObjectCache,
+		podStatusTimeout:         podTerminationStatusTimeout(),
+		podTerminationWaits:      make(map[volumetypes.UniquePodName]time.Time),
 	}
 }
 
@@ -136,6 +142,16 @@ type desiredStateOfWorldPopulator struct {
 	csiMigratedPluginManager csimigration.PluginManager
 	intreeToCSITranslator    csimigration.InTreeToCSITranslator
 	volumePluginMgr          *volume.VolumePluginMgr
+	// objectCache optionally serves PVC and PV lookups from informer
+	// caches; nil when the feature is disabled.
+	objectCache *ObjectCache
+	// podStatusTimeout is how long a deleted pod may wait on container
+	// runtime status confirmation before it is treated as terminated for
+	// volume cleanup; zero disables the timeout.
+	podStatusTimeout time.Duration
+	// podTerminationWaits records when a deleted pod was first seen waiting
+	// on runtime status confirmation. Only accessed from the populator loop.
+	podTerminationWaits map[volumetypes.UniquePodName]time.Time
 }
 
 type processedPods struct {
@@ -147,6 +163,9 @@ func (dswp *desiredStateOfWorldPopulator) Run(ctx context.Context, sourcesReady
 	// Wait for the completion of a loop that started after sources are all ready, then set hasAddedPods accordingly
 	logger := klog.FromContext(ctx)
 	logger.Info("Desired state populator starts to run")
+	if dswp.objectCache != nil {
+		dswp.objectCache.Start(ctx.Done())
+	}
 	_ = wait.PollUntilContextCancel(ctx, dswp.loopSleepDuration, false, func(ctx context.Context) (bool, error) {
 		done := sourcesReady.AllReady()
 		dswp.populatorLoop(ctx)
@@ -230,8 +249,16 @@ func (dswp *desiredStateOfWorldPopulator) findAndRemoveDeletedPods() {
 		// pod state provider to verify that all containers in the pod have been
 		// terminated.
 		if !dswp.podStateProvider.ShouldPodRuntimeBeRemoved(volumeToMount.Pod.UID) {
-			klog.V(4).InfoS("Pod still has one or more containers in the non-exited state and will not be removed from desired state", "pod", klog.KObj(volumeToMount.Pod))
-			continue
+			// The pod is already gone from the pod manager (checked above), so
+			// the only thing keeping its volumes around is the container
+			// runtime not confirming termination. Apply the configured timeout
+			// so that a runtime stuck reporting unknown status does not stall
+			// volume cleanup forever.
+			if !dswp.podTerminationStatusTimedOut(volumeToMount.PodName) {
+				klog.V(4).InfoS("Pod still has one or more containers in the non-exited state and will not be removed from desired state", "pod", klog.KObj(volumeToMount.Pod))
+				continue
+			}
+			klog.InfoS("Container runtime did not confirm pod termination within the configured timeout, treating pod as terminated for volume cleanup", "pod", klog.KObj(volumeToMount.Pod), "podUID", volumeToMount.Pod.UID, "timeout", dswp.podStatusTimeout)
 		}
 		var volumeToMountSpecName string
 		if volumeToMount.VolumeSpec != nil {
@@ -265,6 +292,13 @@ func (dswp *desiredStateOfWorldPopulator) findAndRemoveDeletedPods() {
 		}
 	}
 
+	// Drop termination wait records of pods whose volumes already left the
+	// desired state of the world.
+	dswp.sweepPodTerminationWaits(podsFromCache)
+
+	// Allow cleanup of spec-missing volumes whose pods terminated.
+	dswp.sweepSpecMissingPods()
+
 	podsWithError := dswp.desiredStateOfWorld.GetPodsWithErrors()
 	for _, podName := range podsWithError {
 		if _, podExists := dswp.podManager.GetPodByUID(types.UID(podName)); !podExists {
@@ -304,6 +338,12 @@ func (dswp *desiredStateOfWorldPopulator) processPodVolumes(ctx context.Context,
 			logger.Error(err, "Error processing volume", "pod", klog.KObj(pod), "volumeName", podVolume.Name)
 			dswp.desiredStateOfWorld.AddErrorToPod(uniquePodName, err.Error())
 			allVolumesAdded = false
+			if apierrors.IsNotFound(err) {
+				// The PV or PVC backing the volume was deleted. When the
+				// volume is still mounted, it must stay mounted until the
+				// pod terminates.
+				dswp.markPodVolumeSpecMissing(logger, pod, uniquePodName, podVolume.Name)
+			}
 			continue
 		}
 
@@ -318,7 +358,9 @@ func (dswp *desiredStateOfWorldPopulator) processPodVolumes(ctx context.Context,
 		}
 
 		logger.V(4).Info("Added volume to desired state", "pod", klog.KObj(pod), "volumeName", podVolume.Name, "volumeSpecName", volumeSpec.Name())
+		metrics.PodVolumeStartupLatency.RecordVolumeAdded(uniquePodName)
 		dswp.checkVolumeFSResize(pod, podVolume, pvc, volumeSpec, uniqueVolumeName)
+		dswp.checkVolumeAttributesClass(logger, pod, podVolume, pvc, uniqueVolumeName)
 	}
 
 	// some of the volume additions may have failed, should not mark this pod as fully processed
@@ -419,6 +461,7 @@ func (dswp *desiredStateOfWorldPopulator) deleteProcessedPod(
 	defer dswp.pods.Unlock()
 
 	delete(dswp.pods.processedPods, podName)
+	metrics.PodVolumeStartupLatency.ForgetPod(podName)
 }
 
 // createVolumeSpec creates and returns a mutable volume.Spec object for the
@@ -444,7 +487,7 @@ func (dswp *desiredStateOfWorldPopulator) createVolumeSpec(
 			pod.Namespace, pvcSource.ClaimName)
 		if err != nil {
 			return nil, nil, "", fmt.Errorf(
-				"error processing PVC %s/%s: %v",
+				"error processing PVC %s/%s: %w",
 				pod.Namespace,
 				pvcSource.ClaimName,
 				err)
@@ -461,7 +504,7 @@ func (dswp *desiredStateOfWorldPopulator) createVolumeSpec(
 			dswp.getPVSpec(pvName, pvcSource.ReadOnly, pvcUID)
 		if err != nil {
 			return nil, nil, "", fmt.Errorf(
-				"error processing PVC %s/%s: %v",
+				"error processing PVC %s/%s: %w",
 				pod.Namespace,
 				pvcSource.ClaimName,
 				err)
@@ -516,16 +559,37 @@ func (dswp *desiredStateOfWorldPopulator) createVolumeSpec(
 	return nil, spec, "", nil
 }
 
+// fetchPVC returns the PVC with the given namespace and name, served from
+// the informer cache when available and falling back to a direct GET.
+func (dswp *desiredStateOfWorldPopulator) fetchPVC(namespace, claimName string) (*v1.PersistentVolumeClaim, error) {
+	if dswp.objectCache != nil {
+		if pvc, ok := dswp.objectCache.GetPVC(namespace, claimName); ok {
+			return pvc, nil
+		}
+	}
+	return dswp.kubeClient.CoreV1().PersistentVolumeClaims(namespace).Get(context.TODO(), claimName, metav1.GetOptions{})
+}
+
+// fetchPV returns the PV with the given name, served from the informer cache
+// when available and falling back to a direct GET.
+func (dswp *desiredStateOfWorldPopulator) fetchPV(name string) (*v1.PersistentVolume, error) {
+	if dswp.objectCache != nil {
+		if pv, ok := dswp.objectCache.GetPV(name); ok {
+			return pv, nil
+		}
+	}
+	return dswp.kubeClient.CoreV1().PersistentVolumes().Get(context.TODO(), name, metav1.GetOptions{})
+}
+
 // getPVCExtractPV fetches the PVC object with the given namespace and name from
 // the API server, checks whether PVC is being deleted, extracts the name of the PV
 // it is pointing to and returns it.
 // An error is returned if the PVC object's phase is not "Bound".
 func (dswp *desiredStateOfWorldPopulator) getPVCExtractPV(
 	namespace string, claimName string) (*v1.PersistentVolumeClaim, error) {
-	pvc, err :=
-		dswp.kubeClient.CoreV1().PersistentVolumeClaims(namespace).Get(context.TODO(), claimName, metav1.GetOptions{})
+	pvc, err := dswp.fetchPVC(namespace, claimName)
 	if err != nil || pvc == nil {
-		return nil, fmt.Errorf("failed to fetch PVC from API server: %v", err)
+		return nil, fmt.Errorf("failed to fetch PVC from API server: %w", err)
 	}
 
 	// Pods that uses a PVC that is being deleted must not be started.
@@ -557,10 +621,10 @@ func (dswp *desiredStateOfWorldPopulator) getPVSpec(
 	name string,
 	pvcReadOnly bool,
 	expectedClaimUID types.UID) (*volume.Spec, string, error) {
-	pv, err := dswp.kubeClient.CoreV1().PersistentVolumes().Get(context.TODO(), name, metav1.GetOptions{})
+	pv, err := dswp.fetchPV(name)
 	if err != nil || pv == nil {
 		return nil, "", fmt.Errorf(
-			"failed to fetch PV %s from API server: %v", name, err)
+			"failed to fetch PV %s from API server: %w", name, err)
 	}
 
 	if pv.Spec.ClaimRef == nil {
@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package populator
+
+import (
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/client-go/informers"
+	clientset "k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/klog/v2"
+
+	"k8s.io/kubernetes/pkg/features"
+	"k8s.io/kubernetes/pkg/kubelet/volumemanager/metrics"
+)
+
+// Results of cached volume object lookups, used as the result label of the
+// lookup counter.
+const (
+	objectCacheHit      = "hit"
+	objectCacheFallback = "fallback"
+)
+
+// ObjectCache serves PVC and PV lookups from shared informer caches. It is
+// created once per kubelet and shared between the desired state of world
+// populator and admission handlers that need to resolve PVCs and PVs.
+type ObjectCache struct {
+	factory   informers.SharedInformerFactory
+	pvcLister corelisters.PersistentVolumeClaimLister
+	pvLister  corelisters.PersistentVolumeLister
+	pvcSynced func() bool
+	pvSynced  func() bool
+	startOnce sync.Once
+}
+
+// NewVolumeObjectCache returns a volume object cache backed by the given
+// client, or nil when the VolumeObjectInformers feature gate is disabled or
+// no client is available. The informers watch all PVCs and PVs (the API
+// offers no node-scoped filter for them), so the feature trades kubelet
+// memory for API server load. Lookups fall back to direct GETs while the
+// caches are not synced or when an object is missing from them. The
+// informers are started on the first Start call.
+func NewVolumeObjectCache(kubeClient clientset.Interface) *ObjectCache {
+	if kubeClient == nil || !utilfeature.DefaultFeatureGate.Enabled(features.VolumeObjectInformers) {
+		return nil
+	}
+	factory := informers.NewSharedInformerFactory(kubeClient, 0)
+	pvcInformer := factory.Core().V1().PersistentVolumeClaims()
+	pvInformer := factory.Core().V1().PersistentVolumes()
+	return &ObjectCache{
+		factory:   factory,
+		pvcLister: pvcInformer.Lister(),
+		pvLister:  pvInformer.Lister(),
+		pvcSynced: pvcInformer.Informer().HasSynced,
+		pvSynced:  pvInformer.Informer().HasSynced,
+	}
+}
+
+// Start runs the informers until stopCh is closed. Lookups before the caches
+// are synced fall back to direct GETs, so there is no need to block on the
+// sync here.
+func (voc *ObjectCache) Start(stopCh <-chan struct{}) {
+	voc.startOnce.Do(func() {
+		klog.InfoS("Starting PVC and PV informers for the desired state of world populator")
+		voc.factory.Start(stopCh)
+	})
+}
+
+// GetPVC returns the PVC with the given namespace and name from the informer
+// cache, or false when the cache cannot serve the lookup and the caller must
+// fall back to a direct GET.
+func (voc *ObjectCache) GetPVC(namespace, name string) (*v1.PersistentVolumeClaim, bool) {
+	if !voc.pvcSynced() {
+		metrics.VolumeObjectCacheLookupsTotal.WithLabelValues("persistentvolumeclaim", objectCacheFallback).Inc()
+		return nil, false
+	}
+	pvc, err := voc.pvcLister.PersistentVolumeClaims(namespace).Get(name)
+	if err != nil {
+		metrics.VolumeObjectCacheLookupsTotal.WithLabelValues("persistentvolumeclaim", objectCacheFallback).Inc()
+		return nil, false
+	}
+	metrics.VolumeObjectCacheLookupsTotal.WithLabelValues("persistentvolumeclaim", objectCacheHit).Inc()
+	return pvc, true
+}
+
+// GetPV returns the PV with the given name from the informer cache, or false
+// when the cache cannot serve the lookup and the caller must fall back to a
+// direct GET.
+func (voc *ObjectCache) GetPV(name string) (*v1.PersistentVolume, bool) {
+	if !voc.pvSynced() {
+		metrics.VolumeObjectCacheLookupsTotal.WithLabelValues("persistentvolume", objectCacheFallback).Inc()
+		return nil, false
+	}
+	pv, err := voc.pvLister.Get(name)
+	if err != nil {
+		metrics.VolumeObjectCacheLookupsTotal.WithLabelValues("persistentvolume", objectCacheFallback).Inc()
+		return nil, false
+	}
+	metrics.VolumeObjectCacheLookupsTotal.WithLabelValues("persistentvolume", objectCacheHit).Inc()
+	return pv, true
+}
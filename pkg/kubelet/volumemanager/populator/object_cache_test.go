@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package populator
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/client-go/kubernetes/fake"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+	"k8s.io/kubernetes/pkg/features"
+)
+
+func TestNewVolumeObjectCacheDisabledByDefault(t *testing.T) {
+	if cache := NewVolumeObjectCache(fake.NewSimpleClientset()); cache != nil {
+		t.Errorf("expected no volume object cache without opt-in")
+	}
+}
+
+func TestVolumeObjectCacheLookups(t *testing.T) {
+	featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, features.VolumeObjectInformers, true)
+
+	pvc := &v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "claim", Namespace: "ns"},
+	}
+	pv := &v1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "volume"},
+	}
+	client := fake.NewSimpleClientset(pvc, pv)
+	cache := NewVolumeObjectCache(client)
+	if cache == nil {
+		t.Fatalf("expected a volume object cache when opted in")
+	}
+
+	// Before the informers run, lookups must report a fallback.
+	if _, ok := cache.GetPVC("ns", "claim"); ok {
+		t.Errorf("expected a fallback before the caches are synced")
+	}
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	cache.Start(stopCh)
+	if err := wait.PollImmediate(10*time.Millisecond, 10*time.Second, func() (bool, error) {
+		return cache.pvcSynced() && cache.pvSynced(), nil
+	}); err != nil {
+		t.Fatalf("informer caches did not sync: %v", err)
+	}
+
+	if cached, ok := cache.GetPVC("ns", "claim"); !ok || cached.Name != "claim" {
+		t.Errorf("expected the PVC to be served from the cache, got %v, %v", cached, ok)
+	}
+	if cached, ok := cache.GetPV("volume"); !ok || cached.Name != "volume" {
+		t.Errorf("expected the PV to be served from the cache, got %v, %v", cached, ok)
+	}
+	if _, ok := cache.GetPV("missing"); ok {
+		t.Errorf("expected a fallback for an object missing from the cache")
+	}
+}
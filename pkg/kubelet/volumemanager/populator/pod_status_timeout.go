@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package populator
+
+import (
+	"time"
+
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/kubernetes/pkg/features"
+	"k8s.io/kubernetes/pkg/kubelet/volumemanager/metrics"
+	volumetypes "k8s.io/kubernetes/pkg/volume/util/types"
+)
+
+// defaultPodTerminationStatusTimeout is how long a pod that was deleted from
+// the pod manager, but whose termination the container runtime cannot
+// confirm, waits before it is treated as terminated for volume cleanup
+// purposes.
+const defaultPodTerminationStatusTimeout = 10 * time.Minute
+
+// podTerminationStatusTimeout returns the timeout after which a deleted pod
+// without runtime status confirmation is treated as terminated, or zero
+// (disabled) when the TerminatedPodStatusTimeout feature gate is off.
+func podTerminationStatusTimeout() time.Duration {
+	if !utilfeature.DefaultFeatureGate.Enabled(features.TerminatedPodStatusTimeout) {
+		return 0
+	}
+	return defaultPodTerminationStatusTimeout
+}
+
+// podTerminationStatusTimedOut returns true when the given deleted pod has
+// been waiting on container runtime status confirmation for longer than the
+// configured timeout. The first call for a pod starts its wait. With the
+// timeout disabled it always returns false. Only called from the populator
+// loop.
+func (dswp *desiredStateOfWorldPopulator) podTerminationStatusTimedOut(podName volumetypes.UniquePodName) bool {
+	if dswp.podStatusTimeout == 0 {
+		return false
+	}
+	firstSeen, exists := dswp.podTerminationWaits[podName]
+	if !exists {
+		dswp.podTerminationWaits[podName] = time.Now()
+		return false
+	}
+	if time.Since(firstSeen) < dswp.podStatusTimeout {
+		return false
+	}
+	delete(dswp.podTerminationWaits, podName)
+	metrics.TerminatedPodStatusTimeoutsTotal.Inc()
+	return true
+}
+
+// sweepPodTerminationWaits drops wait records of pods that are no longer in
+// the desired state of the world, e.g. because the runtime confirmed their
+// termination before the timeout elapsed. Only called from the populator
+// loop.
+func (dswp *desiredStateOfWorldPopulator) sweepPodTerminationWaits(podsFromCache map[volumetypes.UniquePodName]struct{}) {
+	for podName := range dswp.podTerminationWaits {
+		if _, exists := podsFromCache[podName]; !exists {
+			delete(dswp.podTerminationWaits, podName)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package populator
+
+import (
+	"testing"
+	"time"
+
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+	"k8s.io/kubernetes/pkg/features"
+	volumetypes "k8s.io/kubernetes/pkg/volume/util/types"
+)
+
+func TestPodTerminationStatusTimeout(t *testing.T) {
+	if timeout := podTerminationStatusTimeout(); timeout != 0 {
+		t.Errorf("expected the timeout to be disabled by default, got %v", timeout)
+	}
+	featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, features.TerminatedPodStatusTimeout, true)
+	if timeout := podTerminationStatusTimeout(); timeout != defaultPodTerminationStatusTimeout {
+		t.Errorf("expected timeout %v with the feature enabled, got %v", defaultPodTerminationStatusTimeout, timeout)
+	}
+}
+
+func TestPodTerminationStatusTimedOut(t *testing.T) {
+	podName := volumetypes.UniquePodName("pod1uid")
+	dswp := &desiredStateOfWorldPopulator{
+		podStatusTimeout:    time.Minute,
+		podTerminationWaits: make(map[volumetypes.UniquePodName]time.Time),
+	}
+
+	// The first call starts the wait and must not time out.
+	if dswp.podTerminationStatusTimedOut(podName) {
+		t.Errorf("expected no timeout on the first call")
+	}
+	if dswp.podTerminationStatusTimedOut(podName) {
+		t.Errorf("expected no timeout before the configured duration elapsed")
+	}
+
+	// Backdating the wait start makes the next call time out and clears the
+	// wait record.
+	dswp.podTerminationWaits[podName] = time.Now().Add(-2 * time.Minute)
+	if !dswp.podTerminationStatusTimedOut(podName) {
+		t.Errorf("expected a timeout after the configured duration elapsed")
+	}
+	if _, exists := dswp.podTerminationWaits[podName]; exists {
+		t.Errorf("expected the wait record to be cleared after the timeout")
+	}
+
+	// With the timeout disabled, no wait is ever recorded.
+	disabled := &desiredStateOfWorldPopulator{
+		podTerminationWaits: make(map[volumetypes.UniquePodName]time.Time),
+	}
+	if disabled.podTerminationStatusTimedOut(podName) {
+		t.Errorf("expected no timeout with the policy disabled")
+	}
+	if len(disabled.podTerminationWaits) != 0 {
+		t.Errorf("expected no wait records with the policy disabled")
+	}
+}
+
+func TestSweepPodTerminationWaits(t *testing.T) {
+	dswp := &desiredStateOfWorldPopulator{
+		podStatusTimeout: time.Minute,
+		podTerminationWaits: map[volumetypes.UniquePodName]time.Time{
+			"pod1uid": time.Now(),
+			"pod2uid": time.Now(),
+		},
+	}
+	dswp.sweepPodTerminationWaits(map[volumetypes.UniquePodName]struct{}{
+		"pod1uid": {},
+	})
+	if _, exists := dswp.podTerminationWaits["pod1uid"]; !exists {
+		t.Errorf("expected the wait record of a pod still in the desired state to be kept")
+	}
+	if _, exists := dswp.podTerminationWaits["pod2uid"]; exists {
+		t.Errorf("expected the wait record of a removed pod to be swept")
+	}
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package populator
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	volumetypes "k8s.io/kubernetes/pkg/volume/util/types"
+)
+
+// volumeSpecMissingReason is the event reason used when the API objects
+// backing a mounted volume were deleted while the pod still runs.
+const volumeSpecMissingReason = "VolumeSpecMissing"
+
+// markPodVolumeSpecMissing marks the mounted volume of the pod that matches
+// the given outer volume spec name as spec-missing in the actual state of the
+// world. The reconciler keeps such volumes mounted until the pod terminates,
+// because without the PV and PVC objects the desired state of the world can
+// no longer be repopulated and the volume would otherwise be cleaned up under
+// a running pod. An event is emitted on the pod the first time the volume is
+// marked.
+func (dswp *desiredStateOfWorldPopulator) markPodVolumeSpecMissing(
+	logger klog.Logger, pod *v1.Pod, uniquePodName volumetypes.UniquePodName, outerVolumeSpecName string) {
+	for _, mountedVolume := range dswp.actualStateOfWorld.GetMountedVolumesForPod(uniquePodName) {
+		if mountedVolume.OuterVolumeSpecName != outerVolumeSpecName {
+			continue
+		}
+		if dswp.actualStateOfWorld.PodVolumeSpecMissing(uniquePodName, mountedVolume.VolumeName) {
+			// Already marked; the event was emitted when the deletion was
+			// first observed.
+			return
+		}
+		if err := dswp.actualStateOfWorld.MarkVolumeSpecMissing(uniquePodName, mountedVolume.VolumeName); err != nil {
+			logger.Error(err, "Failed to mark mounted volume as spec-missing", "pod", klog.KObj(pod), "volumeName", mountedVolume.VolumeName)
+			return
+		}
+		logger.Error(nil, "Objects backing a mounted volume were deleted while the pod still runs; keeping the volume mounted until the pod terminates", "pod", klog.KObj(pod), "volumeName", mountedVolume.VolumeName)
+		if recorder := dswp.volumePluginMgr.Host.GetEventRecorder(); recorder != nil {
+			recorder.Eventf(pod, v1.EventTypeWarning, volumeSpecMissingReason, "Objects backing mounted volume %q were deleted; the volume stays mounted until the pod terminates", outerVolumeSpecName)
+		}
+		return
+	}
+}
+
+// sweepSpecMissingPods clears the spec-missing marks of pods that terminated
+// or were removed, so the reconciler can clean their volumes up.
+func (dswp *desiredStateOfWorldPopulator) sweepSpecMissingPods() {
+	for _, podName := range dswp.actualStateOfWorld.GetSpecMissingPods() {
+		pod, podExists := dswp.podManager.GetPodByUID(types.UID(podName))
+		if podExists && !dswp.podStateProvider.ShouldPodContainersBeTerminating(pod.UID) {
+			continue
+		}
+		klog.V(2).InfoS("Pod with spec-missing volumes terminated, allowing volume cleanup", "podName", podName)
+		dswp.actualStateOfWorld.ClearVolumeSpecMissing(podName)
+	}
+}
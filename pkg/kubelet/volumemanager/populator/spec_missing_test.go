@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package populator
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+	"k8s.io/kubernetes/pkg/volume/util"
+	"k8s.io/kubernetes/test/utils/ktesting"
+
+	kubetypes "k8s.io/apimachinery/pkg/types"
+)
+
+func TestPVDeletedWhilePodRuns(t *testing.T) {
+	dswp, fakePodState, pod, expectedVolumeName, _ := prepareDSWPWithPodPV(t)
+	podName := util.GetUniquePodName(pod)
+
+	// Mount the volume by reconciling with the actual state.
+	reconcileASW(dswp.actualStateOfWorld, dswp.desiredStateOfWorld, t)
+
+	// Delete the PVC and PV while the pod still runs.
+	deletedClient := &fake.Clientset{}
+	deletedClient.AddReactor("get", "persistentvolumeclaims", func(action core.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewNotFound(v1.Resource("persistentvolumeclaims"), "file-bound")
+	})
+	deletedClient.AddReactor("get", "persistentvolumes", func(action core.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewNotFound(v1.Resource("persistentvolumes"), "dswp-test-volume-name")
+	})
+	dswp.kubeClient = deletedClient
+
+	// Reprocessing the pod fails to recreate the volume spec and must mark
+	// the mounted volume as spec-missing.
+	tCtx := ktesting.Init(t)
+	dswp.ReprocessPod(podName)
+	dswp.findAndAddNewPods(tCtx)
+
+	if !dswp.actualStateOfWorld.PodVolumeSpecMissing(podName, expectedVolumeName) {
+		t.Fatalf("expected volume %q to be marked spec-missing after the PV was deleted", expectedVolumeName)
+	}
+
+	// While the pod still runs, the mark must survive the removal sweep.
+	dswp.findAndRemoveDeletedPods()
+	if !dswp.actualStateOfWorld.PodVolumeSpecMissing(podName, expectedVolumeName) {
+		t.Fatalf("expected spec-missing mark of volume %q to survive while the pod runs", expectedVolumeName)
+	}
+
+	// Once the pod terminates, the mark is cleared so the reconciler can
+	// clean the volume up.
+	fakePodState.terminating = map[kubetypes.UID]struct{}{pod.UID: {}}
+	dswp.findAndRemoveDeletedPods()
+	if dswp.actualStateOfWorld.PodVolumeSpecMissing(podName, expectedVolumeName) {
+		t.Fatalf("expected spec-missing mark of volume %q to be cleared after the pod terminated", expectedVolumeName)
+	}
+}
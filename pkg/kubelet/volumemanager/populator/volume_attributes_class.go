@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package populator
+
+import (
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/volume/util"
+)
+
+const (
+	// RemountOnAttributesChangeAnnotation is a pod annotation opting volumes
+	// into a remount when their VolumeAttributesClass changes, so that
+	// drivers that derive mount options from the class parameters can
+	// renegotiate them. The value is either "true" to cover all volumes of
+	// the pod, or a comma-separated list of pod volume names.
+	RemountOnAttributesChangeAnnotation = "volumes.kubernetes.io/remount-on-attributes-change"
+
+	// volumeAttributesClassChangedReason is the event reason used when the
+	// VolumeAttributesClass of a mounted volume changed.
+	volumeAttributesClassChangedReason = "VolumeAttributesClassChanged"
+)
+
+// checkVolumeAttributesClass records the current VolumeAttributesClass of a
+// volume mounted by the pod. When a ModifyVolume operation completed and the
+// class reported in pvc.Status.CurrentVolumeAttributesClassName changed, the
+// change is surfaced as a pod event, and pods that opted in via the
+// RemountOnAttributesChangeAnnotation get the volume marked for a remount so
+// the driver can renegotiate mount options derived from the class parameters.
+func (dswp *desiredStateOfWorldPopulator) checkVolumeAttributesClass(
+	logger klog.Logger,
+	pod *v1.Pod,
+	podVolume v1.Volume,
+	pvc *v1.PersistentVolumeClaim,
+	uniqueVolumeName v1.UniqueVolumeName) {
+	if pvc == nil {
+		// Only PVC-backed volumes have a VolumeAttributesClass.
+		return
+	}
+	className := ""
+	if pvc.Status.CurrentVolumeAttributesClassName != nil {
+		className = *pvc.Status.CurrentVolumeAttributesClassName
+	}
+
+	previous, changed := dswp.actualStateOfWorld.UpdateVolumeAttributesClass(uniqueVolumeName, className)
+	if !changed || previous == "" {
+		// Either nothing changed, or this is the first time the class is
+		// recorded (e.g. after kubelet restart) and no transition happened
+		// while the volume was mounted.
+		return
+	}
+
+	logger.V(2).Info("VolumeAttributesClass of mounted volume changed", "pod", klog.KObj(pod), "volumeName", podVolume.Name, "previousClass", previous, "currentClass", className)
+	if recorder := dswp.volumePluginMgr.Host.GetEventRecorder(); recorder != nil {
+		recorder.Eventf(pod, v1.EventTypeNormal, volumeAttributesClassChangedReason, "Volume %q now uses VolumeAttributesClass %q (was %q)", podVolume.Name, className, previous)
+	}
+
+	if remountOnAttributesChangeRequested(pod, podVolume.Name) {
+		logger.V(2).Info("Marking volume for remount after VolumeAttributesClass change", "pod", klog.KObj(pod), "volumeName", podVolume.Name)
+		dswp.actualStateOfWorld.MarkRemountRequiredForVolume(util.GetUniquePodName(pod), uniqueVolumeName)
+	}
+}
+
+// remountOnAttributesChangeRequested returns true if the pod opted the given
+// volume into a remount when its VolumeAttributesClass changes.
+func remountOnAttributesChangeRequested(pod *v1.Pod, outerVolumeSpecName string) bool {
+	value, ok := pod.Annotations[RemountOnAttributesChangeAnnotation]
+	if !ok {
+		return false
+	}
+	if value == "true" {
+		return true
+	}
+	for _, name := range strings.Split(value, ",") {
+		if strings.TrimSpace(name) == outerVolumeSpecName {
+			return true
+		}
+	}
+	return false
+}
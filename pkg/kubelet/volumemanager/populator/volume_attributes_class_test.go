@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package populator
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/kubernetes/pkg/kubelet/volumemanager/cache"
+	"k8s.io/kubernetes/pkg/volume/util"
+	"k8s.io/kubernetes/test/utils/ktesting"
+	"k8s.io/utils/ptr"
+)
+
+func TestCheckVolumeAttributesClass(t *testing.T) {
+	dswp, _, pod, expectedVolumeName, _ := prepareDSWPWithPodPV(t)
+	podName := util.GetUniquePodName(pod)
+	logger, _ := ktesting.NewTestContext(t)
+
+	// Mount the volume by reconciling with the actual state.
+	reconcileASW(dswp.actualStateOfWorld, dswp.desiredStateOfWorld, t)
+
+	pvc := &v1.PersistentVolumeClaim{
+		Status: v1.PersistentVolumeClaimStatus{
+			Phase:                            v1.ClaimBound,
+			CurrentVolumeAttributesClassName: ptr.To("gold"),
+		},
+	}
+
+	// The first observation only records the class, it is not a transition.
+	dswp.checkVolumeAttributesClass(logger, pod, pod.Spec.Volumes[0], pvc, expectedVolumeName)
+	if previous, changed := dswp.actualStateOfWorld.UpdateVolumeAttributesClass(expectedVolumeName, "gold"); previous != "gold" || changed {
+		t.Fatalf("expected class %q to be recorded without change, got previous=%q changed=%v", "gold", previous, changed)
+	}
+
+	// A class change without the remount annotation must not mark the volume
+	// for a remount.
+	pvc.Status.CurrentVolumeAttributesClassName = ptr.To("silver")
+	dswp.checkVolumeAttributesClass(logger, pod, pod.Spec.Volumes[0], pvc, expectedVolumeName)
+	if _, _, err := dswp.actualStateOfWorld.PodExistsInVolume(podName, expectedVolumeName, resource.Quantity{}, ""); cache.IsRemountRequiredError(err) {
+		t.Fatalf("expected no remount to be required without the %s annotation", RemountOnAttributesChangeAnnotation)
+	}
+
+	// With the annotation, a class change marks the volume for a remount.
+	pod.Annotations = map[string]string{RemountOnAttributesChangeAnnotation: "true"}
+	pvc.Status.CurrentVolumeAttributesClassName = ptr.To("bronze")
+	dswp.checkVolumeAttributesClass(logger, pod, pod.Spec.Volumes[0], pvc, expectedVolumeName)
+	if _, _, err := dswp.actualStateOfWorld.PodExistsInVolume(podName, expectedVolumeName, resource.Quantity{}, ""); !cache.IsRemountRequiredError(err) {
+		t.Fatalf("expected a remount to be required after the class change, got %v", err)
+	}
+}
+
+func TestRemountOnAttributesChangeRequested(t *testing.T) {
+	tests := []struct {
+		name       string
+		annotation string
+		hasAnnot   bool
+		volume     string
+		expected   bool
+	}{
+		{name: "no annotation", hasAnnot: false, volume: "vol-1", expected: false},
+		{name: "all volumes", annotation: "true", hasAnnot: true, volume: "vol-1", expected: true},
+		{name: "listed volume", annotation: "vol-1, vol-2", hasAnnot: true, volume: "vol-2", expected: true},
+		{name: "unlisted volume", annotation: "vol-1", hasAnnot: true, volume: "vol-3", expected: false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pod := &v1.Pod{}
+			if tc.hasAnnot {
+				pod.Annotations = map[string]string{RemountOnAttributesChangeAnnotation: tc.annotation}
+			}
+			if requested := remountOnAttributesChangeRequested(pod, tc.volume); requested != tc.expected {
+				t.Errorf("expected %v for annotation %q and volume %q, got %v", tc.expected, tc.annotation, tc.volume, requested)
+			}
+		})
+	}
+}
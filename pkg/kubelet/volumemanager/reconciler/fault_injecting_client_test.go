@@ -0,0 +1,238 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/kubernetes/pkg/kubelet/volumemanager/cache"
+	"k8s.io/kubernetes/pkg/volume"
+	volumetesting "k8s.io/kubernetes/pkg/volume/testing"
+	"k8s.io/kubernetes/pkg/volume/util"
+	"k8s.io/kubernetes/pkg/volume/util/hostutil"
+	"k8s.io/kubernetes/pkg/volume/util/operationexecutor"
+	"k8s.io/mount-utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// faultInjectingClient is a fake clientset for reconciler tests that serves
+// the node object with injectable failure modes: API latency, transient
+// errors, and node status swapped mid-test to simulate a stale or
+// inconsistent node.Status.VolumesAttached. The generic fake clientset can
+// only serve one canned answer, which is not enough to exercise the retry
+// behavior of the node status reconstruction paths.
+type faultInjectingClient struct {
+	*fake.Clientset
+
+	mutex      sync.Mutex
+	node       *v1.Node
+	getLatency time.Duration
+	failGets   int
+	failErr    error
+	nodeGets   int
+}
+
+func newFaultInjectingClient(node *v1.Node) *faultInjectingClient {
+	client := &faultInjectingClient{
+		Clientset: &fake.Clientset{},
+		node:      node,
+	}
+	client.AddReactor("get", "nodes",
+		func(action core.Action) (bool, runtime.Object, error) {
+			client.mutex.Lock()
+			latency := client.getLatency
+			client.nodeGets++
+			var err error
+			if client.failGets > 0 {
+				client.failGets--
+				err = client.failErr
+			}
+			node := client.node.DeepCopy()
+			client.mutex.Unlock()
+
+			if latency > 0 {
+				time.Sleep(latency)
+			}
+			if err != nil {
+				return true, nil, err
+			}
+			return true, node, nil
+		},
+	)
+	client.AddReactor("*", "*", func(action core.Action) (bool, runtime.Object, error) {
+		return true, nil, fmt.Errorf("no reaction implemented for %s", action)
+	})
+	return client
+}
+
+// setNodeStatus swaps the node served to subsequent GETs.
+func (c *faultInjectingClient) setNodeStatus(node *v1.Node) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.node = node
+}
+
+// injectLatency delays every subsequent node GET by the given duration.
+func (c *faultInjectingClient) injectLatency(latency time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.getLatency = latency
+}
+
+// failNextNodeGets makes the next count node GETs return err.
+func (c *faultInjectingClient) failNextNodeGets(count int, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.failGets = count
+	c.failErr = err
+}
+
+// nodeGetCount returns how many node GETs were served, including failed
+// ones.
+func (c *faultInjectingClient) nodeGetCount() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.nodeGets
+}
+
+func TestUpdateReconstructedFromNodeStatusWithFaults(t *testing.T) {
+	// Arrange: two uncertain reconstructed volumes, only fake-device1 is
+	// listed as attached in node status.
+	staleNode := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: string(nodeName)},
+	}
+	currentNode := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: string(nodeName)},
+		Status: v1.NodeStatus{
+			VolumesAttached: []v1.AttachedVolume{
+				{
+					Name:       "fake-plugin/fake-device1",
+					DevicePath: "fake/path",
+				},
+			},
+		},
+	}
+	volumePluginMgr, fakePlugin := volumetesting.GetTestKubeletVolumePluginMgrWithNode(t, currentNode)
+	seLinuxTranslator := util.NewFakeSELinuxLabelTranslator()
+	dsw := cache.NewDesiredStateOfWorld(volumePluginMgr, seLinuxTranslator)
+	asw := cache.NewActualStateOfWorld(nodeName, volumePluginMgr)
+	kubeClient := newFaultInjectingClient(staleNode)
+	fakeRecorder := &record.FakeRecorder{}
+	fakeHandler := volumetesting.NewBlockVolumePathHandler()
+	oex := operationexecutor.NewOperationExecutor(operationexecutor.NewOperationGenerator(
+		kubeClient,
+		volumePluginMgr,
+		fakeRecorder,
+		fakeHandler))
+	rc := NewReconciler(
+		kubeClient,
+		true, /* controllerAttachDetachEnabled */
+		reconcilerLoopSleepDuration,
+		waitForAttachTimeout,
+		nodeName,
+		dsw,
+		asw,
+		hasAddedPods,
+		oex,
+		mount.NewFakeMounter(nil),
+		hostutil.NewFakeHostUtil(nil),
+		volumePluginMgr,
+		kubeletPodsDir)
+	rcInstance := rc.(*reconciler)
+
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "pod1",
+			UID:  "pod1uid",
+		},
+		Spec: v1.PodSpec{
+			Volumes: []v1.Volume{
+				{
+					Name: "volume-name",
+					VolumeSource: v1.VolumeSource{
+						GCEPersistentDisk: &v1.GCEPersistentDiskVolumeSource{
+							PDName: "fake-device1",
+						},
+					},
+				},
+				{
+					Name: "volume-name2",
+					VolumeSource: v1.VolumeSource{
+						GCEPersistentDisk: &v1.GCEPersistentDiskVolumeSource{
+							PDName: "fake-device2",
+						},
+					},
+				},
+			},
+		},
+	}
+	volumeSpec1 := &volume.Spec{Volume: &pod.Spec.Volumes[0]}
+	volumeName1 := util.GetUniqueVolumeName(fakePlugin.GetPluginName(), "fake-device1")
+	volumeSpec2 := &volume.Spec{Volume: &pod.Spec.Volumes[1]}
+	volumeName2 := util.GetUniqueVolumeName(fakePlugin.GetPluginName(), "fake-device2")
+
+	assert.NoError(t, asw.AddAttachUncertainReconstructedVolume(volumeName1, volumeSpec1, nodeName, ""))
+	assert.NoError(t, asw.MarkDeviceAsUncertain(volumeName1, "/dev/badly/reconstructed", "/var/lib/kubelet/plugins/global1", ""))
+	assert.NoError(t, asw.AddAttachUncertainReconstructedVolume(volumeName2, volumeSpec2, nodeName, ""))
+	assert.NoError(t, asw.MarkDeviceAsUncertain(volumeName2, "/dev/reconstructed", "/var/lib/kubelet/plugins/global2", ""))
+
+	rcInstance.volumesNeedUpdateFromNodeStatus = append(rcInstance.volumesNeedUpdateFromNodeStatus, volumeName1, volumeName2)
+
+	// Act 1: node GETs fail with a conflict, the update must be retried
+	// later and the queue must stay intact.
+	kubeClient.failNextNodeGets(2, apierrors.NewConflict(
+		schema.GroupResource{Resource: "nodes"}, string(nodeName), fmt.Errorf("the object has been modified")))
+	rcInstance.updateReconstructedFromNodeStatus()
+	rcInstance.updateReconstructedFromNodeStatus()
+	assert.Len(t, rcInstance.volumesNeedUpdateFromNodeStatus, 2, "queue must survive failed node GETs")
+	assert.Equal(t, 2, kubeClient.nodeGetCount())
+
+	// Act 2: the current node status is served, slowly. fake-device2 is
+	// missing from node.Status.VolumesAttached even though it is mounted —
+	// the injected inconsistency — so it ends up non-attachable with its
+	// reconstructed device path, while fake-device1 is fixed up.
+	kubeClient.setNodeStatus(currentNode)
+	kubeClient.injectLatency(10 * time.Millisecond)
+	rcInstance.updateReconstructedFromNodeStatus()
+	assert.Empty(t, rcInstance.volumesNeedUpdateFromNodeStatus)
+	assert.Equal(t, 3, kubeClient.nodeGetCount())
+
+	attachedVolumes := asw.GetAttachedVolumes()
+	assert.Lenf(t, attachedVolumes, 2, "two volumes in ASW expected")
+	for _, vol := range attachedVolumes {
+		if vol.VolumeName == volumeName1 {
+			assert.True(t, vol.PluginIsAttachable)
+			assert.Equal(t, "fake/path", vol.DevicePath)
+		}
+		if vol.VolumeName == volumeName2 {
+			assert.False(t, vol.PluginIsAttachable)
+			assert.Equal(t, "/dev/reconstructed", vol.DevicePath)
+		}
+	}
+}
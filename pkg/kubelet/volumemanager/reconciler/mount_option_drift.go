@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/features"
+	"k8s.io/kubernetes/pkg/kubelet/volumemanager/metrics"
+	"k8s.io/mount-utils"
+)
+
+// mountOptionDriftCheckInterval is how often the mount options of mounted
+// device paths are compared against the volume spec.
+const mountOptionDriftCheckInterval = time.Minute
+
+// mountOptionAction is what the kubelet does about a drifted mount option.
+type mountOptionAction string
+
+const (
+	mountOptionActionRemount mountOptionAction = "remount"
+	mountOptionActionWarn    mountOptionAction = "warn"
+	mountOptionActionIgnore  mountOptionAction = "ignore"
+)
+
+// mountOptionClass groups mount options by the consequence of getting them
+// wrong, so the drift policy can treat a missing noexec differently from a
+// missing noatime.
+type mountOptionClass string
+
+const (
+	mountOptionClassSecurity    mountOptionClass = "security"
+	mountOptionClassPerformance mountOptionClass = "performance"
+	mountOptionClassOther       mountOptionClass = "other"
+)
+
+var (
+	securityMountOptions    = sets.New("ro", "rw", "exec", "noexec", "suid", "nosuid", "dev", "nodev", "nosymfollow")
+	performanceMountOptions = sets.New("atime", "noatime", "diratime", "nodiratime", "relatime", "norelatime", "strictatime", "nostrictatime", "lazytime", "nolazytime", "sync", "async", "dirsync")
+)
+
+func classifyMountOption(option string) mountOptionClass {
+	name, _, _ := strings.Cut(option, "=")
+	switch {
+	case securityMountOptions.Has(name):
+		return mountOptionClassSecurity
+	case performanceMountOptions.Has(name):
+		return mountOptionClassPerformance
+	}
+	return mountOptionClassOther
+}
+
+// mountOptionDriftPolicy returns the action taken per option class. Drifted
+// security options are warned about, or remounted with the spec's options
+// when the RemountOnMountOptionDrift feature gate is enabled; other classes
+// are ignored.
+func mountOptionDriftPolicy() map[mountOptionClass]mountOptionAction {
+	securityAction := mountOptionActionWarn
+	if utilfeature.DefaultFeatureGate.Enabled(features.RemountOnMountOptionDrift) {
+		securityAction = mountOptionActionRemount
+	}
+	return map[mountOptionClass]mountOptionAction{
+		mountOptionClassSecurity:    securityAction,
+		mountOptionClassPerformance: mountOptionActionIgnore,
+		mountOptionClassOther:       mountOptionActionIgnore,
+	}
+}
+
+// strongerMountOptionAction returns the more intrusive of the two actions;
+// a volume with several drifted options gets the strongest action any of
+// them asks for.
+func strongerMountOptionAction(a, b mountOptionAction) mountOptionAction {
+	if a == mountOptionActionRemount || b == mountOptionActionRemount {
+		return mountOptionActionRemount
+	}
+	if a == mountOptionActionWarn || b == mountOptionActionWarn {
+		return mountOptionActionWarn
+	}
+	return mountOptionActionIgnore
+}
+
+// checkMountOptionDrift periodically compares the mount options of every
+// globally mounted device against the mount options its PV spec asks for.
+// PV mount options edited while the kubelet was down survive reconstruction
+// unnoticed, because reconstruction takes the existing mount as the desired
+// state. Depending on the per-class policy a drifted device is remounted
+// with the spec's options, warned about, or ignored.
+func (rc *reconciler) checkMountOptionDrift() {
+	if time.Since(rc.timeOfLastMountOptionDriftCheck) < mountOptionDriftCheckInterval {
+		return
+	}
+	rc.timeOfLastMountOptionDriftCheck = time.Now()
+
+	mountPoints, err := rc.mounter.List()
+	if err != nil {
+		klog.ErrorS(err, "Failed to list mount points while checking for mount option drift")
+		return
+	}
+	mountPointsByPath := make(map[string]mount.MountPoint, len(mountPoints))
+	for _, mountPoint := range mountPoints {
+		mountPointsByPath[mountPoint.Path] = mountPoint
+	}
+
+	policy := mountOptionDriftPolicy()
+	for _, attachedVolume := range rc.actualStateOfWorld.GetGloballyMountedVolumes() {
+		spec := attachedVolume.VolumeSpec
+		if spec == nil || spec.PersistentVolume == nil || len(spec.PersistentVolume.Spec.MountOptions) == 0 {
+			continue
+		}
+		if attachedVolume.DeviceMountPath == "" {
+			continue
+		}
+		mountPoint, ok := mountPointsByPath[attachedVolume.DeviceMountPath]
+		if !ok {
+			continue
+		}
+
+		actualOptions := sets.New(mountPoint.Opts...)
+		action := mountOptionActionIgnore
+		var drifted []string
+		for _, option := range spec.PersistentVolume.Spec.MountOptions {
+			if actualOptions.Has(option) {
+				continue
+			}
+			// "rw" is implied by the absence of "ro".
+			if option == "rw" && !actualOptions.Has("ro") {
+				continue
+			}
+			drifted = append(drifted, option)
+			action = strongerMountOptionAction(action, policy[classifyMountOption(option)])
+		}
+		if len(drifted) == 0 {
+			continue
+		}
+
+		metrics.MountOptionDriftTotal.WithLabelValues(attachedVolume.PluginName, string(action)).Inc()
+		switch action {
+		case mountOptionActionRemount:
+			klog.InfoS("Mount options drifted from the volume spec, remounting with the spec's options",
+				"volumeName", attachedVolume.VolumeName, "deviceMountPath", attachedVolume.DeviceMountPath, "driftedOptions", drifted)
+			options := append([]string{"remount"}, spec.PersistentVolume.Spec.MountOptions...)
+			if remountErr := rc.mounter.Mount(mountPoint.Device, mountPoint.Path, mountPoint.Type, options); remountErr != nil {
+				klog.ErrorS(remountErr, "Failed to remount device with the mount options from the volume spec",
+					"volumeName", attachedVolume.VolumeName, "deviceMountPath", attachedVolume.DeviceMountPath)
+			}
+		case mountOptionActionWarn:
+			klog.ErrorS(nil, "Mount options drifted from the volume spec",
+				"volumeName", attachedVolume.VolumeName, "deviceMountPath", attachedVolume.DeviceMountPath, "driftedOptions", drifted)
+		default:
+			klog.V(4).InfoS("Ignoring mount option drift per policy",
+				"volumeName", attachedVolume.VolumeName, "deviceMountPath", attachedVolume.DeviceMountPath, "driftedOptions", drifted)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+	"k8s.io/klog/v2/ktesting"
+	"k8s.io/kubernetes/pkg/features"
+	"k8s.io/kubernetes/pkg/kubelet/volumemanager/cache"
+	"k8s.io/kubernetes/pkg/volume"
+	volumetesting "k8s.io/kubernetes/pkg/volume/testing"
+	"k8s.io/kubernetes/pkg/volume/util"
+	"k8s.io/mount-utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyMountOption(t *testing.T) {
+	tests := []struct {
+		option   string
+		expected mountOptionClass
+	}{
+		{"ro", mountOptionClassSecurity},
+		{"noexec", mountOptionClassSecurity},
+		{"noatime", mountOptionClassPerformance},
+		{"lazytime", mountOptionClassPerformance},
+		{"commit=5", mountOptionClassOther},
+		{"discard", mountOptionClassOther},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, classifyMountOption(test.option), "option %q", test.option)
+	}
+}
+
+func TestMountOptionDriftPolicy(t *testing.T) {
+	// Defaults: drifted security options are warned about.
+	policy := mountOptionDriftPolicy()
+	assert.Equal(t, mountOptionActionWarn, policy[mountOptionClassSecurity])
+	assert.Equal(t, mountOptionActionIgnore, policy[mountOptionClassPerformance])
+	assert.Equal(t, mountOptionActionIgnore, policy[mountOptionClassOther])
+
+	// With the feature gate enabled, drifted security options are remounted.
+	featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, features.RemountOnMountOptionDrift, true)
+	policy = mountOptionDriftPolicy()
+	assert.Equal(t, mountOptionActionRemount, policy[mountOptionClassSecurity])
+	assert.Equal(t, mountOptionActionIgnore, policy[mountOptionClassPerformance])
+	assert.Equal(t, mountOptionActionIgnore, policy[mountOptionClassOther])
+}
+
+func TestCheckMountOptionDrift(t *testing.T) {
+	logger, _ := ktesting.NewTestContext(t)
+	volumePluginMgr, fakePlugin := volumetesting.GetTestKubeletVolumePluginMgr(t)
+	asw := cache.NewActualStateOfWorld(nodeName, volumePluginMgr)
+
+	deviceMountPath := "/var/lib/kubelet/plugins/global1"
+	pv := &v1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "pv1"},
+		Spec: v1.PersistentVolumeSpec{
+			MountOptions: []string{"ro", "noexec", "noatime"},
+			PersistentVolumeSource: v1.PersistentVolumeSource{
+				GCEPersistentDisk: &v1.GCEPersistentDiskVolumeSource{PDName: "fake-device1"},
+			},
+		},
+	}
+	volumeSpec := &volume.Spec{PersistentVolume: pv}
+	volumeName := util.GetUniqueVolumeName(fakePlugin.GetPluginName(), "fake-device1")
+	assert.NoError(t, asw.MarkVolumeAsAttached(logger, volumeName, volumeSpec, nodeName, "/dev/sda1"))
+	assert.NoError(t, asw.MarkDeviceAsMounted(volumeName, "/dev/sda1", deviceMountPath, ""))
+
+	// The device is mounted rw without noexec: the security options drifted.
+	mounter := mount.NewFakeMounter([]mount.MountPoint{
+		{Device: "/dev/sda1", Path: deviceMountPath, Type: "ext4", Opts: []string{"rw", "relatime"}},
+	})
+	rc := &reconciler{
+		actualStateOfWorld: asw,
+		mounter:            mounter,
+	}
+
+	// The default policy only warns, so nothing is remounted.
+	rc.checkMountOptionDrift()
+	assert.Empty(t, mounter.GetLog())
+
+	// With the remount gate enabled the device is remounted with the
+	// options from the spec.
+	featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, features.RemountOnMountOptionDrift, true)
+	rc.timeOfLastMountOptionDriftCheck = time.Time{}
+	rc.checkMountOptionDrift()
+	log := mounter.GetLog()
+	if assert.Len(t, log, 1) {
+		assert.Equal(t, mount.FakeActionMount, log[0].Action)
+		assert.Equal(t, deviceMountPath, log[0].Target)
+	}
+
+	// A device already mounted with the desired options is left alone.
+	mounter.ResetLog()
+	mounter.MountPoints = []mount.MountPoint{
+		{Device: "/dev/sda1", Path: deviceMountPath, Type: "ext4", Opts: []string{"ro", "noexec", "noatime"}},
+	}
+	rc.timeOfLastMountOptionDriftCheck = time.Time{}
+	rc.checkMountOptionDrift()
+	assert.Empty(t, mounter.GetLog())
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/features"
+	volumepkg "k8s.io/kubernetes/pkg/volume"
+	"k8s.io/kubernetes/pkg/volume/csi"
+	"k8s.io/kubernetes/pkg/volume/util"
+)
+
+// offlineExpansionInterval limits how often attached volumes are scanned for
+// pending expansions.
+const offlineExpansionInterval = time.Minute
+
+// expandOfflineVolumes finishes pending filesystem expansions for volumes
+// that are attached to this node but not referenced by any pod. Such volumes
+// otherwise stay in FileSystemResizePending until a pod mounts them again.
+// The scan runs in its own goroutine so the reconciliation loop never blocks
+// on API calls or expansion operations.
+func (rc *reconciler) expandOfflineVolumes() {
+	if !utilfeature.DefaultFeatureGate.Enabled(features.OfflineVolumeExpansion) {
+		return
+	}
+	if time.Since(rc.timeOfLastOfflineExpansion) < offlineExpansionInterval {
+		return
+	}
+	rc.timeOfLastOfflineExpansion = time.Now()
+	if rc.kubeClient == nil {
+		return
+	}
+	if !rc.offlineExpansionInFlight.CompareAndSwap(false, true) {
+		return
+	}
+	go func() {
+		defer rc.offlineExpansionInFlight.Store(false)
+		rc.offlineExpansionScan()
+	}()
+}
+
+// offlineExpansionScan walks the volumes the attach/detach controller reports
+// as attached to this node and checks each of them for a pending expansion.
+// Only per-volume GETs are issued; the VolumeAttachment of each volume is
+// fetched under its deterministic name instead of listing all attachments in
+// the cluster.
+func (rc *reconciler) offlineExpansionScan() {
+	node, err := rc.kubeClient.CoreV1().Nodes().Get(context.TODO(), string(rc.nodeName), metav1.GetOptions{})
+	if err != nil {
+		klog.ErrorS(err, "Offline expansion failed to get Node", "nodeName", rc.nodeName)
+		return
+	}
+	for _, attachedVolume := range node.Status.VolumesAttached {
+		driverName, volumeHandle, ok := parseCSIVolumeID(string(attachedVolume.Name))
+		if !ok {
+			continue
+		}
+		attachmentName := csi.GetVolumeAttachmentName(volumeHandle, driverName, string(rc.nodeName))
+		attachment, err := rc.kubeClient.StorageV1().VolumeAttachments().Get(context.TODO(), attachmentName, metav1.GetOptions{})
+		if err != nil {
+			klog.V(4).InfoS("Offline expansion failed to get VolumeAttachment", "attachmentName", attachmentName, "volumeName", attachedVolume.Name, "err", err)
+			continue
+		}
+		if !attachment.Status.Attached || attachment.Spec.Source.PersistentVolumeName == nil {
+			continue
+		}
+		rc.expandOfflineVolume(*attachment.Spec.Source.PersistentVolumeName)
+	}
+}
+
+// parseCSIVolumeID splits the unique volume name of an attached CSI volume,
+// kubernetes.io/csi/<driver>^<volume handle>, into driver name and volume
+// handle.
+func parseCSIVolumeID(uniqueVolumeName string) (driverName, volumeHandle string, ok bool) {
+	trimmed, found := strings.CutPrefix(uniqueVolumeName, csi.CSIPluginName+"/")
+	if !found {
+		return "", "", false
+	}
+	driverName, volumeHandle, found = strings.Cut(trimmed, "^")
+	if !found || driverName == "" || volumeHandle == "" {
+		return "", "", false
+	}
+	return driverName, volumeHandle, true
+}
+
+// expandOfflineVolume starts an offline expansion through the operation
+// executor when the volume's claim has a pending filesystem resize and no
+// pod on this node wants the volume. The executor serializes the expansion
+// with any other operation on the same volume.
+func (rc *reconciler) expandOfflineVolume(pvName string) {
+	if rc.volumeDesiredByPod(pvName) {
+		// A pod wants this volume; the regular in-use expansion path covers
+		// it once the volume is mounted.
+		return
+	}
+	pv, err := rc.kubeClient.CoreV1().PersistentVolumes().Get(context.TODO(), pvName, metav1.GetOptions{})
+	if err != nil {
+		klog.V(4).InfoS("Offline expansion failed to get PersistentVolume", "volumeName", pvName, "err", err)
+		return
+	}
+	if pv.Spec.ClaimRef == nil {
+		return
+	}
+	pvc, err := rc.kubeClient.CoreV1().PersistentVolumeClaims(pv.Spec.ClaimRef.Namespace).Get(context.TODO(), pv.Spec.ClaimRef.Name, metav1.GetOptions{})
+	if err != nil {
+		klog.V(4).InfoS("Offline expansion failed to get PersistentVolumeClaim", "volumeName", pvName, "err", err)
+		return
+	}
+	if !pendingFileSystemResize(pvc) {
+		return
+	}
+
+	spec := volumepkg.NewSpecFromPersistentVolume(pv, false)
+	volumePlugin, err := rc.volumePluginMgr.FindPluginBySpec(spec)
+	if err != nil || volumePlugin == nil {
+		return
+	}
+	uniqueVolumeName, err := util.GetUniqueVolumeNameFromSpec(volumePlugin, spec)
+	if err != nil {
+		klog.V(4).InfoS("Offline expansion failed to get unique volume name", "volumeName", pvName, "err", err)
+		return
+	}
+
+	newSize := pvc.Spec.Resources.Requests[v1.ResourceStorage]
+	if err := rc.operationExecutor.OfflineExpandVolume(uniqueVolumeName, spec, pvc, newSize); err != nil {
+		klog.V(4).InfoS("Offline volume expansion could not be started", "volumeName", pvName, "err", err)
+	}
+}
+
+// volumeDesiredByPod returns true when any pod in the desired state of the
+// world references the given persistent volume.
+func (rc *reconciler) volumeDesiredByPod(pvName string) bool {
+	for _, volumeToMount := range rc.desiredStateOfWorld.GetVolumesToMount() {
+		if volumeToMount.VolumeSpec != nil && volumeToMount.VolumeSpec.Name() == pvName {
+			return true
+		}
+	}
+	return false
+}
+
+// pendingFileSystemResize returns true when the claim is waiting for a node
+// filesystem resize.
+func pendingFileSystemResize(pvc *v1.PersistentVolumeClaim) bool {
+	for _, condition := range pvc.Status.Conditions {
+		if condition.Type == v1.PersistentVolumeClaimFileSystemResizePending && condition.Status == v1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
@@ -51,6 +51,22 @@ func (rc *reconciler) reconcile() {
 
 		// Clean up any orphan volumes that failed reconstruction.
 		rc.cleanOrphanVolumes()
+
+		// Surface volumes that were skipped during reconstruction and still
+		// did not reach the actual state of the world.
+		rc.checkSkippedReconstructionVolumes()
+
+		// Periodically verify that block volume pod symlinks still resolve
+		// to the attached devices, e.g. after device renumbering.
+		rc.verifyBlockVolumeSymlinks()
+
+		// Periodically collapse identical mounts stacked on the same pod
+		// volume path by a plugin retrying SetUp after a failure.
+		rc.collapseStackedMounts()
+
+		// Periodically compare device mount options against the volume
+		// specs, catching PV mount options edited while kubelet was down.
+		rc.checkMountOptionDrift()
 	}
 
 	if len(rc.volumesNeedUpdateFromNodeStatus) != 0 {
@@ -61,5 +77,19 @@ func (rc *reconciler) reconcile() {
 		// were reconstructed from the API server.
 		// This will start reconciliation of node.status.volumesInUse.
 		rc.updateLastSyncTime()
+
+		// With reconstruction fully settled, any CSI staging directory that
+		// neither state cache references is orphaned; clean it up once per
+		// kubelet start.
+		if readyToUnmount && !rc.stagingScavengeDone {
+			rc.stagingScavengeDone = true
+			rc.scavengeStaleCSIStagingDirs()
+		}
+
+		// Finish pending filesystem expansions for attached volumes that no
+		// pod on this node uses (opt-in via environment).
+		if readyToUnmount {
+			rc.expandOfflineVolumes()
+		}
 	}
 }
@@ -19,19 +19,28 @@ package reconciler
 import (
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/types"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	clientset "k8s.io/client-go/kubernetes"
+	csitrans "k8s.io/csi-translation-lib"
 	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/features"
+	kevents "k8s.io/kubernetes/pkg/kubelet/events"
 	"k8s.io/kubernetes/pkg/kubelet/volumemanager/cache"
 	"k8s.io/kubernetes/pkg/util/goroutinemap/exponentialbackoff"
 	volumepkg "k8s.io/kubernetes/pkg/volume"
+	"k8s.io/kubernetes/pkg/volume/csi"
+	"k8s.io/kubernetes/pkg/volume/csimigration"
+	"k8s.io/kubernetes/pkg/volume/util"
 	"k8s.io/kubernetes/pkg/volume/util/hostutil"
 	"k8s.io/kubernetes/pkg/volume/util/nestedpendingoperations"
 	"k8s.io/kubernetes/pkg/volume/util/operationexecutor"
+	"k8s.io/kubernetes/pkg/volume/util/volumepathhandler"
 	"k8s.io/mount-utils"
 )
 
@@ -54,6 +63,30 @@ type Reconciler interface {
 	// StatesHasBeenSynced returns true only after syncStates process starts to sync
 	// states at least once after kubelet starts
 	StatesHasBeenSynced() bool
+
+	// StartupVerificationProgress returns how many volumes were reconstructed
+	// from disk during kubelet startup and how many of them have been
+	// verified since, i.e. left the uncertain device mount state or were
+	// cleaned up from the actual state of the world.
+	StartupVerificationProgress() (reconstructed, verified int)
+
+	// UnresolvedReconstructionVolumes returns the number of volumes
+	// reconstructed at kubelet startup that are still in uncertain or
+	// skipped state.
+	UnresolvedReconstructionVolumes() int
+
+	// MarkShutdownImminent informs the reconciler that the node is about to
+	// shut down. When the SkipVolumeUnmountOnNodeShutdown feature is enabled,
+	// subsequent UnmountDevice operations are skipped for volumes whose
+	// plugins declare this to be safe; filesystem buffers are synced to disk
+	// instead.
+	MarkShutdownImminent()
+
+	// SetStorageMaintenanceMode puts the reconciler in (or takes it out of)
+	// storage maintenance mode. While enabled, no new attach or mount
+	// operations are started; unmount operations continue so that the node
+	// drains its kubelet-owned mounts.
+	SetStorageMaintenanceMode(enabled bool)
 }
 
 // NewReconciler returns a new instance of Reconciler.
@@ -101,6 +134,7 @@ func NewReconciler(
 	hostutil hostutil.HostUtils,
 	volumePluginMgr *volumepkg.VolumePluginMgr,
 	kubeletPodsDir string) Reconciler {
+	intreeToCSITranslator := csitrans.New()
 	return &reconciler{
 		kubeClient:                      kubeClient,
 		controllerAttachDetachEnabled:   controllerAttachDetachEnabled,
@@ -113,12 +147,15 @@ func NewReconciler(
 		operationExecutor:               operationExecutor,
 		mounter:                         mounter,
 		hostutil:                        hostutil,
-		skippedDuringReconstruction:     map[v1.UniqueVolumeName]*globalVolumeInfo{},
+		skippedDuringReconstruction:     map[v1.UniqueVolumeName]*skippedVolumeInfo{},
+		snapshotDeferrals:               map[v1.UniqueVolumeName]time.Time{},
 		volumePluginMgr:                 volumePluginMgr,
 		kubeletPodsDir:                  kubeletPodsDir,
 		timeOfLastSync:                  time.Time{},
 		volumesFailedReconstruction:     make([]podVolume, 0),
 		volumesNeedUpdateFromNodeStatus: make([]v1.UniqueVolumeName, 0),
+		csiMigratedPluginManager:        csimigration.NewPluginManager(intreeToCSITranslator, utilfeature.DefaultFeatureGate),
+		intreeToCSITranslator:           intreeToCSITranslator,
 	}
 }
 
@@ -135,13 +172,227 @@ type reconciler struct {
 	mounter                       mount.Interface
 	hostutil                      hostutil.HostUtils
 	volumePluginMgr               *volumepkg.VolumePluginMgr
-	skippedDuringReconstruction   map[v1.UniqueVolumeName]*globalVolumeInfo
-	kubeletPodsDir                string
+	// skippedDuringReconstruction holds volumes that could not be added to
+	// the actual state of the world during reconstruction, together with the
+	// reason and timestamp of the skip. Only accessed from the reconciliation
+	// loop.
+	skippedDuringReconstruction map[v1.UniqueVolumeName]*skippedVolumeInfo
+	kubeletPodsDir              string
 	// lock protects timeOfLastSync for updating and checking
 	timeOfLastSyncLock              sync.Mutex
 	timeOfLastSync                  time.Time
 	volumesFailedReconstruction     []podVolume
 	volumesNeedUpdateFromNodeStatus []v1.UniqueVolumeName
+	// startupReconstructedLock protects startupReconstructedVolumes, which
+	// is written once by the reconciliation loop after reconstruction and
+	// read by the startup verification barrier.
+	startupReconstructedLock sync.Mutex
+	// startupReconstructedVolumes is a snapshot of the names of the volumes
+	// that were reconstructed from disk during kubelet startup.
+	startupReconstructedVolumes []v1.UniqueVolumeName
+	// skippedReconstructionCount mirrors the size of
+	// skippedDuringReconstruction for readers outside the reconciliation
+	// loop.
+	skippedReconstructionCount atomic.Int64
+	// shutdownImminent is set when the node is about to shut down; it makes
+	// unmountDetachDevices skip UnmountDevice for volumes whose plugins
+	// declare this to be safe.
+	shutdownImminent atomic.Bool
+	// storageMaintenance is set while the node is in storage maintenance
+	// mode; it makes mountOrAttachVolumes skip starting new operations while
+	// unmounts continue, so that the node drains its kubelet-owned mounts.
+	storageMaintenance atomic.Bool
+	// syncFilesystemsOnce ensures filesystem buffers are flushed to disk
+	// exactly once before the first skipped unmount.
+	syncFilesystemsOnce sync.Once
+	// stagingScavengeDone is set after stale CSI staging directories were
+	// scavenged once after reconstruction settled. Only accessed from the
+	// reconciliation loop.
+	stagingScavengeDone bool
+	// snapshotDeferrals records, per volume, when UnmountDevice was first
+	// deferred because an external component signalled an in-progress
+	// snapshot of the staged volume. Only accessed from the reconciliation
+	// loop.
+	snapshotDeferrals map[v1.UniqueVolumeName]time.Time
+	// timeOfLastSymlinkVerification is when block volume pod symlinks were
+	// last verified against the attached device numbers. Only accessed from
+	// the reconciliation loop.
+	timeOfLastSymlinkVerification time.Time
+	// timeOfLastStackedMountCheck is when pod volume mounts were last
+	// scanned for stacked duplicate mounts. Only accessed from the
+	// reconciliation loop.
+	timeOfLastStackedMountCheck time.Time
+	// timeOfLastMountOptionDriftCheck is when device mount options were
+	// last compared against the volume specs. Only accessed from the
+	// reconciliation loop.
+	timeOfLastMountOptionDriftCheck time.Time
+	// timeOfLastOfflineExpansion is when attached volumes were last scanned
+	// for pending filesystem expansions that can be finished without a pod.
+	// Only accessed from the reconciliation loop.
+	timeOfLastOfflineExpansion time.Time
+	// offlineExpansionInFlight is true while an offline expansion scan runs
+	// in its own goroutine, so the reconciliation loop never starts a second
+	// scan and never blocks on one.
+	offlineExpansionInFlight atomic.Bool
+	// csiMigratedPluginManager keeps track of the CSI migration status of
+	// in-tree plugins, used to translate volumes reconstructed from in-tree
+	// paths that an older kubelet mounted before the plugin was migrated.
+	csiMigratedPluginManager csimigration.PluginManager
+	// intreeToCSITranslator translates in-tree volume specs to CSI.
+	intreeToCSITranslator csimigration.InTreeToCSITranslator
+}
+
+// scavengeStaleCSIStagingDirs removes CSI staging directories that are
+// referenced by neither the actual nor the desired state of the world, e.g.
+// leftovers of a corrupt vol_data.json that blocked reconstruction. It must
+// only run after reconstruction has settled, otherwise staging directories of
+// not-yet-reconstructed volumes would be considered stale.
+func (rc *reconciler) scavengeStaleCSIStagingDirs() {
+	inUse := make(map[v1.UniqueVolumeName]bool)
+	for _, attachedVolume := range rc.actualStateOfWorld.GetAttachedVolumes() {
+		inUse[attachedVolume.VolumeName] = true
+	}
+	for _, volumeToMount := range rc.desiredStateOfWorld.GetVolumesToMount() {
+		inUse[volumeToMount.VolumeName] = true
+	}
+	err := csi.ScavengeStaleStagingDirs(
+		rc.volumePluginMgr.Host,
+		rc.mounter,
+		func(volumeName v1.UniqueVolumeName) bool { return inUse[volumeName] },
+		false /* dryRun */)
+	if err != nil {
+		klog.ErrorS(err, "Failed to scavenge stale CSI staging directories")
+	}
+}
+
+// blockSymlinkVerificationInterval is how often the pod device map symlinks
+// of mounted block volumes are verified against the attached device numbers.
+const blockSymlinkVerificationInterval = time.Minute
+
+// verifyBlockVolumeSymlinks periodically checks that the pod device map
+// symlink of every mounted block volume still resolves to a device with the
+// major/minor number of the attached device. After SAN events the kernel may
+// renumber devices, leaving the symlink pointing at the wrong (or no) device.
+// Stale symlinks are reported with an event on the pod and remapped to the
+// current device path.
+func (rc *reconciler) verifyBlockVolumeSymlinks() {
+	if time.Since(rc.timeOfLastSymlinkVerification) < blockSymlinkVerificationInterval {
+		return
+	}
+	rc.timeOfLastSymlinkVerification = time.Now()
+
+	blkutil := volumepathhandler.NewBlockVolumePathHandler()
+	for _, mountedVolume := range rc.actualStateOfWorld.GetAllMountedVolumes() {
+		if mountedVolume.BlockVolumeMapper == nil {
+			continue
+		}
+		attachedVolume, exists := rc.actualStateOfWorld.GetAttachedVolume(mountedVolume.VolumeName)
+		if !exists || attachedVolume.DevicePath == "" {
+			continue
+		}
+		podDeviceMapPath, volName := mountedVolume.BlockVolumeMapper.GetPodDeviceMapPath()
+		current, err := blkutil.IsDeviceSymlinkCurrent(podDeviceMapPath, volName, attachedVolume.DevicePath)
+		if err != nil {
+			klog.V(4).InfoS("Failed to verify block volume symlink", "volumeName", mountedVolume.VolumeName, "podName", mountedVolume.PodName, "err", err)
+			continue
+		}
+		if current {
+			continue
+		}
+		klog.ErrorS(nil, "Block volume pod symlink no longer resolves to the attached device, remapping",
+			"volumeName", mountedVolume.VolumeName, "podName", mountedVolume.PodName,
+			"podDeviceMapPath", podDeviceMapPath, "devicePath", attachedVolume.DevicePath)
+		rc.recordStaleSymlinkEvent(mountedVolume.MountedVolume)
+		if mapErr := blkutil.MapDevice(attachedVolume.DevicePath, podDeviceMapPath, volName, false); mapErr != nil {
+			klog.ErrorS(mapErr, "Failed to remap block volume pod symlink",
+				"volumeName", mountedVolume.VolumeName, "podName", mountedVolume.PodName)
+		} else {
+			klog.InfoS("Remapped block volume pod symlink to the attached device",
+				"volumeName", mountedVolume.VolumeName, "podName", mountedVolume.PodName,
+				"devicePath", attachedVolume.DevicePath)
+		}
+	}
+}
+
+// recordStaleSymlinkEvent emits a warning event on the pod that uses the
+// block volume whose device symlink went stale. The pod object is looked up
+// in the desired state of the world; a pod that is already deleted gets no
+// event.
+func (rc *reconciler) recordStaleSymlinkEvent(mountedVolume operationexecutor.MountedVolume) {
+	recorder := rc.volumePluginMgr.Host.GetEventRecorder()
+	if recorder == nil {
+		return
+	}
+	for _, volumeToMount := range rc.desiredStateOfWorld.GetVolumesToMount() {
+		if volumeToMount.VolumeName != mountedVolume.VolumeName || volumeToMount.PodName != mountedVolume.PodName {
+			continue
+		}
+		recorder.Eventf(
+			volumeToMount.Pod, v1.EventTypeWarning, kevents.FailedMapVolume,
+			"Block volume %q device symlink no longer resolves to the attached device, attempting remap", mountedVolume.OuterVolumeSpecName)
+		return
+	}
+}
+
+func (rc *reconciler) MarkShutdownImminent() {
+	rc.shutdownImminent.Store(true)
+}
+
+func (rc *reconciler) SetStorageMaintenanceMode(enabled bool) {
+	rc.storageMaintenance.Store(enabled)
+}
+
+// skipUnmountDeviceForShutdown returns true if UnmountDevice should be
+// skipped for the given volume because the node is about to shut down and the
+// volume's plugin declares that leaving the device mounted is safe. Before
+// the first skip, dirty filesystem buffers are synced to disk.
+func (rc *reconciler) skipUnmountDeviceForShutdown(attachedVolume cache.AttachedVolume) bool {
+	if !rc.shutdownImminent.Load() ||
+		!utilfeature.DefaultFeatureGate.Enabled(features.SkipVolumeUnmountOnNodeShutdown) {
+		return false
+	}
+	if attachedVolume.VolumeSpec == nil {
+		return false
+	}
+	plugin, err := rc.volumePluginMgr.FindDeviceMountablePluginBySpec(attachedVolume.VolumeSpec)
+	if err != nil || plugin == nil {
+		return false
+	}
+	skipper, ok := plugin.(volumepkg.ShutdownUnmountSkippingVolumePlugin)
+	if !ok || !skipper.SafeToSkipUnmountOnShutdown(attachedVolume.VolumeSpec) {
+		return false
+	}
+	rc.syncFilesystemsOnce.Do(syncFilesystems)
+	return true
+}
+
+// snapshotUnstageDeferralTimeout bounds how long UnmountDevice is deferred
+// for a volume whose PV signals an in-progress snapshot. After the timeout
+// the unstage proceeds even if the annotation is still present, so a stuck
+// snapshotter cannot pin devices to the node forever.
+const snapshotUnstageDeferralTimeout = 5 * time.Minute
+
+// deferUnmountDeviceForSnapshot returns true if UnmountDevice for the given
+// volume should be deferred because an external component signalled, via the
+// SnapshotInProgressAnnotation on the PV, that a snapshot of the staged
+// volume is in progress. Each volume's deferral is bounded by
+// snapshotUnstageDeferralTimeout.
+func (rc *reconciler) deferUnmountDeviceForSnapshot(attachedVolume cache.AttachedVolume) bool {
+	if attachedVolume.VolumeSpec == nil || !util.SnapshotInProgress(attachedVolume.VolumeSpec.PersistentVolume) {
+		delete(rc.snapshotDeferrals, attachedVolume.VolumeName)
+		return false
+	}
+	firstDeferred, ok := rc.snapshotDeferrals[attachedVolume.VolumeName]
+	if !ok {
+		rc.snapshotDeferrals[attachedVolume.VolumeName] = time.Now()
+		return true
+	}
+	if time.Since(firstDeferred) > snapshotUnstageDeferralTimeout {
+		klog.InfoS(attachedVolume.GenerateMsgDetailed("Snapshot-in-progress deferral of UnmountDevice exceeded its bound, proceeding with unstage", ""))
+		delete(rc.snapshotDeferrals, attachedVolume.VolumeName)
+		return false
+	}
+	return true
 }
 
 func (rc *reconciler) unmountVolumes() {
@@ -151,6 +402,21 @@ func (rc *reconciler) unmountVolumes() {
 			continue
 		}
 		if !rc.desiredStateOfWorld.PodExistsInVolume(mountedVolume.PodName, mountedVolume.VolumeName, mountedVolume.SELinuxMountContext) {
+			if rc.actualStateOfWorld.PodVolumeSpecMissing(mountedVolume.PodName, mountedVolume.VolumeName) {
+				// The objects backing the volume were deleted while the pod
+				// still runs, so the volume cannot be repopulated into the
+				// desired state of the world. Keep it mounted; the populator
+				// clears the mark when the pod terminates.
+				klog.V(4).InfoS(mountedVolume.GenerateMsgDetailed("Skipping unmount of volume with missing spec until the pod terminates", ""))
+				continue
+			}
+			// Unmount nested mounts first: tearing down a mount point
+			// while another mount still lives inside it can leak the
+			// inner mount or wedge the unmount altogether.
+			if dependents := rc.actualStateOfWorld.GetDependentMounts(mountedVolume.PodName, mountedVolume.VolumeName); len(dependents) > 0 {
+				klog.V(4).InfoS(mountedVolume.GenerateMsgDetailed("Deferring unmount until nested mounts are unmounted", fmt.Sprintf("nested volumes: %v", dependents)))
+				continue
+			}
 			// Volume is mounted, unmount it
 			klog.V(5).InfoS(mountedVolume.GenerateMsgDetailed("Starting operationExecutor.UnmountVolume", ""))
 			err := rc.operationExecutor.UnmountVolume(
@@ -166,6 +432,10 @@ func (rc *reconciler) unmountVolumes() {
 }
 
 func (rc *reconciler) mountOrAttachVolumes() {
+	if rc.storageMaintenance.Load() {
+		klog.V(4).InfoS("Node is in storage maintenance mode, not starting new mount or attach operations")
+		return
+	}
 	// Ensure volumes that should be attached/mounted are attached/mounted.
 	for _, volumeToMount := range rc.desiredStateOfWorld.GetVolumesToMount() {
 		if rc.operationExecutor.IsOperationPending(volumeToMount.VolumeName, nestedpendingoperations.EmptyUniquePodName, nestedpendingoperations.EmptyNodeName) {
@@ -209,6 +479,12 @@ func (rc *reconciler) mountAttachedVolumes(volumeToMount cache.VolumeToMount, po
 	isRemount := cache.IsRemountRequiredError(podExistError)
 	if isRemount {
 		remountingLogStr = "Volume is already mounted to pod, but remount was requested."
+		// Remounts only verify state; shed them while the driver is saturated
+		// with critical node operations. The next reconciliation retries.
+		if csi.ShedNonCriticalOperations(volumeToMount.VolumeSpec) {
+			klog.V(4).InfoS(volumeToMount.GenerateMsgDetailed("Skipping remount, CSI driver is saturated with in-flight node operations", ""), "pod", klog.KObj(volumeToMount.Pod))
+			return
+		}
 	}
 	klog.V(4).InfoS(volumeToMount.GenerateMsgDetailed("Starting operationExecutor.MountVolume", remountingLogStr), "pod", klog.KObj(volumeToMount.Pod))
 	err := rc.operationExecutor.MountVolume(
@@ -281,6 +557,14 @@ func (rc *reconciler) unmountDetachDevices() {
 			// could start in the meantime. The only goroutine that adds new operations is this reconciler.
 			attachedVolume, _ = rc.actualStateOfWorld.GetAttachedVolume(attachedVolume.VolumeName)
 			if attachedVolume.DeviceMayBeMounted() {
+				if rc.skipUnmountDeviceForShutdown(attachedVolume) {
+					klog.InfoS(attachedVolume.GenerateMsgDetailed("Skipping operationExecutor.UnmountDevice, node shutdown is imminent and plugin declares skipping the unmount to be safe", ""))
+					continue
+				}
+				if rc.deferUnmountDeviceForSnapshot(attachedVolume) {
+					klog.V(4).InfoS(attachedVolume.GenerateMsgDetailed("Deferring operationExecutor.UnmountDevice, a snapshot of the staged volume is in progress", ""))
+					continue
+				}
 				// Volume is globally mounted to device, unmount it
 				klog.V(5).InfoS(attachedVolume.GenerateMsgDetailed("Starting operationExecutor.UnmountDevice", ""))
 				err := rc.operationExecutor.UnmountDevice(
@@ -2413,3 +2413,97 @@ func TestReconcileWithUpdateReconstructedFromAPIServer(t *testing.T) {
 		}
 	}
 }
+
+func TestDeferUnmountDeviceForSnapshot(t *testing.T) {
+	pvWithSnapshot := &v1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "pv-snapshot",
+			Annotations: map[string]string{util.SnapshotInProgressAnnotation: "true"},
+		},
+	}
+	pvWithoutSnapshot := &v1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "pv-plain"},
+	}
+	snapshottedVolume := cache.AttachedVolume{
+		AttachedVolume: operationexecutor.AttachedVolume{
+			VolumeName: v1.UniqueVolumeName("fake-plugin/pv-snapshot"),
+			VolumeSpec: volume.NewSpecFromPersistentVolume(pvWithSnapshot, false),
+		},
+	}
+	plainVolume := cache.AttachedVolume{
+		AttachedVolume: operationexecutor.AttachedVolume{
+			VolumeName: v1.UniqueVolumeName("fake-plugin/pv-plain"),
+			VolumeSpec: volume.NewSpecFromPersistentVolume(pvWithoutSnapshot, false),
+		},
+	}
+
+	rc := &reconciler{snapshotDeferrals: map[v1.UniqueVolumeName]time.Time{}}
+
+	// A volume without the annotation is never deferred.
+	assert.False(t, rc.deferUnmountDeviceForSnapshot(plainVolume))
+	assert.Empty(t, rc.snapshotDeferrals)
+
+	// A volume with the annotation is deferred and tracked.
+	assert.True(t, rc.deferUnmountDeviceForSnapshot(snapshottedVolume))
+	assert.Contains(t, rc.snapshotDeferrals, snapshottedVolume.VolumeName)
+	assert.True(t, rc.deferUnmountDeviceForSnapshot(snapshottedVolume))
+
+	// Once the deferral has lasted longer than the bound, unstage proceeds
+	// and the tracking entry is dropped.
+	rc.snapshotDeferrals[snapshottedVolume.VolumeName] = time.Now().Add(-snapshotUnstageDeferralTimeout - time.Minute)
+	assert.False(t, rc.deferUnmountDeviceForSnapshot(snapshottedVolume))
+	assert.Empty(t, rc.snapshotDeferrals)
+
+	// Removing the annotation clears any pending deferral immediately.
+	rc.snapshotDeferrals[snapshottedVolume.VolumeName] = time.Now()
+	assert.False(t, rc.deferUnmountDeviceForSnapshot(plainVolume))
+	rc.snapshotDeferrals[plainVolume.VolumeName] = time.Now()
+	assert.False(t, rc.deferUnmountDeviceForSnapshot(plainVolume))
+	assert.NotContains(t, rc.snapshotDeferrals, plainVolume.VolumeName)
+}
+
+func TestStartupVerificationProgress(t *testing.T) {
+	volumePluginMgr, fakePlugin := volumetesting.GetTestKubeletVolumePluginMgr(t)
+	asw := cache.NewActualStateOfWorld(nodeName, volumePluginMgr)
+
+	volumes := []v1.Volume{
+		{
+			Name: "volume-name",
+			VolumeSource: v1.VolumeSource{
+				GCEPersistentDisk: &v1.GCEPersistentDiskVolumeSource{PDName: "fake-device1"},
+			},
+		},
+		{
+			Name: "volume-name2",
+			VolumeSource: v1.VolumeSource{
+				GCEPersistentDisk: &v1.GCEPersistentDiskVolumeSource{PDName: "fake-device2"},
+			},
+		},
+	}
+	volumeSpec1 := &volume.Spec{Volume: &volumes[0]}
+	volumeName1 := util.GetUniqueVolumeName(fakePlugin.GetPluginName(), "fake-device1")
+	volumeSpec2 := &volume.Spec{Volume: &volumes[1]}
+	volumeName2 := util.GetUniqueVolumeName(fakePlugin.GetPluginName(), "fake-device2")
+	volumeNameGone := v1.UniqueVolumeName("fake-plugin/cleaned-up")
+
+	assert.NoError(t, asw.AddAttachUncertainReconstructedVolume(volumeName1, volumeSpec1, nodeName, ""))
+	assert.NoError(t, asw.MarkDeviceAsUncertain(volumeName1, "/dev/reconstructed1", "/var/lib/kubelet/plugins/global1", ""))
+	assert.NoError(t, asw.AddAttachUncertainReconstructedVolume(volumeName2, volumeSpec2, nodeName, ""))
+	assert.NoError(t, asw.MarkDeviceAsUncertain(volumeName2, "/dev/reconstructed2", "/var/lib/kubelet/plugins/global2", ""))
+
+	rc := &reconciler{
+		actualStateOfWorld:          asw,
+		startupReconstructedVolumes: []v1.UniqueVolumeName{volumeName1, volumeName2, volumeNameGone},
+	}
+
+	// One of the three reconstructed volumes is gone from ASW already.
+	reconstructed, verified := rc.StartupVerificationProgress()
+	assert.Equal(t, 3, reconstructed)
+	assert.Equal(t, 1, verified)
+
+	// Confirming the device mount of a volume verifies it.
+	assert.NoError(t, asw.MarkDeviceAsMounted(volumeName1, "/dev/reconstructed1", "/var/lib/kubelet/plugins/global1", ""))
+	reconstructed, verified = rc.StartupVerificationProgress()
+	assert.Equal(t, 3, reconstructed)
+	assert.Equal(t, 2, verified)
+}
@@ -18,12 +18,15 @@ package reconciler
 
 import (
 	"context"
+	"fmt"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/volume/util"
 	"k8s.io/kubernetes/pkg/volume/util/operationexecutor"
+	volumetelemetry "k8s.io/kubernetes/pkg/volume/util/telemetry"
 )
 
 // readyToUnmount returns true when reconciler can start unmounting volumes.
@@ -85,18 +88,53 @@ func (rc *reconciler) reconstructVolumes() {
 
 		reconstructedVolumeNames = append(reconstructedVolumeNames, reconstructedVolume.volumeName)
 		reconstructedVolumes[reconstructedVolume.volumeName] = gvl
+		volumetelemetry.Record(volumetelemetry.Event{
+			Transition: volumetelemetry.TransitionReconstructed,
+			VolumeName: string(reconstructedVolume.volumeName),
+			PluginName: volume.pluginName,
+		})
 	}
 
 	if len(reconstructedVolumes) > 0 {
+		util.CrashAtFailpoint(util.FailpointReconstructBeforeASWUpdate)
+
 		// Add the volumes to ASW
 		rc.updateStates(reconstructedVolumes)
 
 		// Remember to update devicePath from node.status.volumesAttached
 		rc.volumesNeedUpdateFromNodeStatus = reconstructedVolumeNames
 	}
+
+	rc.startupReconstructedLock.Lock()
+	rc.startupReconstructedVolumes = reconstructedVolumeNames
+	rc.startupReconstructedLock.Unlock()
+
 	klog.V(2).InfoS("Volume reconstruction finished")
 }
 
+// StartupVerificationProgress returns how many volumes were reconstructed
+// from disk during kubelet startup and how many of them have been verified
+// since. A reconstructed volume counts as verified once it left the uncertain
+// device mount state in the actual state of the world, or was removed from it
+// entirely.
+func (rc *reconciler) StartupVerificationProgress() (reconstructed, verified int) {
+	rc.startupReconstructedLock.Lock()
+	reconstructedVolumes := rc.startupReconstructedVolumes
+	rc.startupReconstructedLock.Unlock()
+
+	attachedVolumes := make(map[v1.UniqueVolumeName]operationexecutor.DeviceMountState)
+	for _, attachedVolume := range rc.actualStateOfWorld.GetAttachedVolumes() {
+		attachedVolumes[attachedVolume.VolumeName] = attachedVolume.DeviceMountState
+	}
+	for _, volumeName := range reconstructedVolumes {
+		deviceMountState, exists := attachedVolumes[volumeName]
+		if !exists || deviceMountState != operationexecutor.DeviceMountUncertain {
+			verified++
+		}
+	}
+	return len(reconstructedVolumes), verified
+}
+
 func (rc *reconciler) updateStates(reconstructedVolumes map[v1.UniqueVolumeName]*globalVolumeInfo) {
 	for _, gvl := range reconstructedVolumes {
 		err := rc.actualStateOfWorld.AddAttachUncertainReconstructedVolume(
@@ -104,6 +142,7 @@ func (rc *reconciler) updateStates(reconstructedVolumes map[v1.UniqueVolumeName]
 			gvl.volumeName, gvl.volumeSpec, rc.nodeName, gvl.devicePath)
 		if err != nil {
 			klog.ErrorS(err, "Could not add volume information to actual state of world", "volumeName", gvl.volumeName)
+			rc.markSkippedDuringReconstruction(gvl, fmt.Sprintf("could not add volume to actual state of world: %v", err))
 			continue
 		}
 		var seLinuxMountContext string
@@ -124,6 +163,7 @@ func (rc *reconciler) updateStates(reconstructedVolumes map[v1.UniqueVolumeName]
 			_, err = rc.actualStateOfWorld.CheckAndMarkVolumeAsUncertainViaReconstruction(markVolumeOpts)
 			if err != nil {
 				klog.ErrorS(err, "Could not add pod to volume information to actual state of world", "pod", klog.KObj(volume.pod))
+				rc.markSkippedDuringReconstruction(gvl, fmt.Sprintf("could not add pod %s to volume in actual state of world: %v", volume.podName, err))
 				continue
 			}
 			seLinuxMountContext = volume.seLinuxMountContext
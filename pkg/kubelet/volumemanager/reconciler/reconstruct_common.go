@@ -27,10 +27,12 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/klog/v2"
 	"k8s.io/kubernetes/pkg/kubelet/config"
 	"k8s.io/kubernetes/pkg/kubelet/volumemanager/metrics"
 	volumepkg "k8s.io/kubernetes/pkg/volume"
+	"k8s.io/kubernetes/pkg/volume/csimigration"
 	"k8s.io/kubernetes/pkg/volume/util"
 	"k8s.io/kubernetes/pkg/volume/util/operationexecutor"
 	volumetypes "k8s.io/kubernetes/pkg/volume/util/types"
@@ -43,6 +45,16 @@ import (
 var _ logr.Marshaler = podVolume{}
 var _ logr.Marshaler = reconstructedVolume{}
 var _ logr.Marshaler = globalVolumeInfo{}
+var _ logr.Marshaler = malformedPodVolumeEntry{}
+
+// Reasons why an entry under a pod's volumes directory does not match the
+// expected {escapeQualifiedPluginName}/{volumeName} layout. Used as the
+// reason label of the malformed_pod_volume_dirs_total metric.
+const (
+	malformedVolumeDirStrayFile     = "stray_file"
+	malformedVolumeDirBadPluginName = "bad_plugin_name"
+	malformedVolumeDirUnreadable    = "unreadable_plugin_dir"
+)
 
 type podVolume struct {
 	podName        volumetypes.UniquePodName
@@ -68,6 +80,27 @@ func (p podVolume) MarshalLog() interface{} {
 	}
 }
 
+// malformedPodVolumeEntry records an entry under a pod's volumes directory
+// that does not match the expected layout. Such entries are skipped by
+// reconstruction and usually indicate a volume plugin leaving garbage behind.
+type malformedPodVolumeEntry struct {
+	podName volumetypes.UniquePodName
+	path    string
+	reason  string
+}
+
+func (m malformedPodVolumeEntry) MarshalLog() interface{} {
+	return struct {
+		PodName string `json:"podName"`
+		Path    string `json:"path"`
+		Reason  string `json:"reason"`
+	}{
+		PodName: string(m.podName),
+		Path:    m.path,
+		Reason:  m.reason,
+	}
+}
+
 type reconstructedVolume struct {
 	volumeName          v1.UniqueVolumeName
 	podName             volumetypes.UniquePodName
@@ -193,13 +226,16 @@ func getDeviceMountPath(gvi *globalVolumeInfo) (string, error) {
 
 // getVolumesFromPodDir scans through the volumes directories under the given pod directory.
 // It returns a list of pod volume information including pod's uid, volume's plugin name, mount path,
-// and volume spec name.
+// and volume spec name. Entries that do not match the expected directory
+// layout are reported via the malformed_pod_volume_dirs_total metric and a
+// structured debug listing instead of being silently skipped.
 func getVolumesFromPodDir(podDir string) ([]podVolume, error) {
 	podsDirInfo, err := os.ReadDir(podDir)
 	if err != nil {
 		return nil, err
 	}
 	volumes := []podVolume{}
+	malformed := []malformedPodVolumeEntry{}
 	for i := range podsDirInfo {
 		if !podsDirInfo[i].IsDir() {
 			continue
@@ -225,13 +261,39 @@ func getVolumesFromPodDir(podDir string) ([]podVolume, error) {
 			for _, volumeDir := range volumesDirInfo {
 				pluginName := volumeDir.Name()
 				volumePluginPath := filepath.Join(volumesDir, pluginName)
+				if !volumeDir.IsDir() {
+					malformed = append(malformed, malformedPodVolumeEntry{
+						podName: volumetypes.UniquePodName(podName),
+						path:    volumePluginPath,
+						reason:  malformedVolumeDirStrayFile,
+					})
+					continue
+				}
+				unescapePluginName := utilstrings.UnescapeQualifiedName(pluginName)
+				if errs := utilvalidation.IsQualifiedName(unescapePluginName); len(errs) > 0 {
+					malformed = append(malformed, malformedPodVolumeEntry{
+						podName: volumetypes.UniquePodName(podName),
+						path:    volumePluginPath,
+						reason:  malformedVolumeDirBadPluginName,
+					})
+					continue
+				}
 				volumePluginDirs, err := utilpath.ReadDirNoStat(volumePluginPath)
 				if err != nil {
 					klog.ErrorS(err, "Could not read volume plugin directory", "volumePluginPath", volumePluginPath)
+					malformed = append(malformed, malformedPodVolumeEntry{
+						podName: volumetypes.UniquePodName(podName),
+						path:    volumePluginPath,
+						reason:  malformedVolumeDirUnreadable,
+					})
 					continue
 				}
-				unescapePluginName := utilstrings.UnescapeQualifiedName(pluginName)
 				for _, volumeName := range volumePluginDirs {
+					if volumepkg.IsOwnershipCheckpointFile(volumeName) {
+						// Progress marker of an interrupted ownership change,
+						// not a volume.
+						continue
+					}
 					volumePath := filepath.Join(volumePluginPath, volumeName)
 					klog.V(5).InfoS("Volume path from volume plugin directory", "podName", podName, "volumePath", volumePath)
 					volumes = append(volumes, podVolume{
@@ -248,9 +310,32 @@ func getVolumesFromPodDir(podDir string) ([]podVolume, error) {
 	for _, volume := range volumes {
 		klog.V(4).InfoS("Get volume from pod directory", "path", podDir, "volume", volume)
 	}
+	for _, entry := range malformed {
+		metrics.MalformedPodVolumeDirsTotal.WithLabelValues(entry.reason).Inc()
+		klog.V(2).InfoS("Malformed entry under pod volumes directory", "path", podDir, "entry", entry)
+	}
 	return volumes, nil
 }
 
+// translateReconstructedSpec translates the given volume spec through the CSI
+// translation layer when it belongs to an in-tree plugin whose CSI migration
+// is enabled. It returns the (possibly translated) spec and whether a
+// translation happened. Specs of non-migrated plugins are returned unchanged.
+func (rc *reconciler) translateReconstructedSpec(volumeSpec *volumepkg.Spec) (*volumepkg.Spec, bool, error) {
+	migratable, err := rc.csiMigratedPluginManager.IsMigratable(volumeSpec)
+	if err != nil || !migratable {
+		return volumeSpec, false, err
+	}
+	// The pod namespace is not known during reconstruction; it is only used
+	// when translating inline volumes of drivers that reference namespaced
+	// secrets, which do not support reconstruction anyway.
+	translatedSpec, err := csimigration.TranslateInTreeSpecToCSI(klog.TODO(), volumeSpec, "" /* podNamespace */, rc.intreeToCSITranslator)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to translate in-tree volume spec %q to CSI during reconstruction: %v", volumeSpec.Name(), err)
+	}
+	return translatedSpec, true, nil
+}
+
 // Reconstruct volume data structure by reading the pod's volume directories
 func (rc *reconciler) reconstructVolume(volume podVolume) (rvolume *reconstructedVolume, rerr error) {
 	metrics.ReconstructVolumeOperationsTotal.Inc()
@@ -297,6 +382,31 @@ func (rc *reconciler) reconstructVolume(volume podVolume) (rvolume *reconstructe
 		return nil, fmt.Errorf("failed to reconstruct volume for plugin %q (spec.Name: %q) pod %q (UID: %q): got nil", volume.pluginName, volume.volumeSpecName, volume.podName, pod.UID)
 	}
 
+	// Volumes mounted from in-tree paths by an older kubelet, before their
+	// plugin was migrated to CSI, must be translated through the CSI
+	// translation layer. Otherwise the reconstructed volume would carry the
+	// in-tree unique volume name, never match the CSI name the desired state
+	// of the world uses, and be orphaned after the upgrade.
+	volumeSpec, translated, err := rc.translateReconstructedSpec(volumeSpec)
+	if err != nil {
+		return nil, err
+	}
+	if translated {
+		// The CSI plugin handles the translated spec from now on.
+		plugin, err = rc.volumePluginMgr.FindPluginBySpec(volumeSpec)
+		if err != nil {
+			return nil, err
+		}
+		mapperPlugin, err = rc.volumePluginMgr.FindMapperPluginBySpec(volumeSpec)
+		if err != nil {
+			return nil, err
+		}
+		if volume.volumeMode == v1.PersistentVolumeBlock && mapperPlugin == nil {
+			return nil, fmt.Errorf("could not find block volume plugin for translated spec %q (spec.Name: %q) pod %q (UID: %q)", volume.pluginName, volume.volumeSpecName, volume.podName, pod.UID)
+		}
+		klog.V(4).InfoS("Translated reconstructed in-tree volume spec to CSI", "podName", volume.podName, "volumeSpecName", volume.volumeSpecName, "inTreePluginName", volume.pluginName, "csiPluginName", plugin.GetPluginName())
+	}
+
 	// We have to find the plugins by volume spec (NOT by plugin name) here
 	// in order to correctly reconstruct ephemeral volume types.
 	// Searching by spec checks whether the volume is actually attachable
@@ -375,15 +485,25 @@ func (rc *reconciler) reconstructVolume(volume podVolume) (rvolume *reconstructe
 		}
 	}
 
+	// volume.volumeSpecName is actually InnerVolumeSpecName. Check the mapping
+	// persisted at SetUp time for the real outer name; it may differ from the
+	// inner one for ephemeral volumes or expanded-in-use PVCs. If no mapping
+	// was persisted, fall back to the inner name; in case reconciler calls
+	// mountOrAttachVolumes, outerVolumeSpecName will be updated from dsw
+	// information in ASW.MarkVolumeAsMounted().
+	outerVolumeSpecName := volume.volumeSpecName
+	podDir := filepath.Join(rc.kubeletPodsDir, string(volume.podName))
+	if persistedNames, err := util.GetPersistedOuterVolumeSpecNames(podDir); err != nil {
+		klog.V(4).InfoS("Could not read persisted outer volume spec names", "podName", volume.podName, "err", err)
+	} else if name, ok := persistedNames[volume.volumeSpecName]; ok {
+		outerVolumeSpecName = name
+	}
+
 	reconstructedVolume := &reconstructedVolume{
-		volumeName: uniqueVolumeName,
-		podName:    volume.podName,
-		volumeSpec: volumeSpec,
-		// volume.volumeSpecName is actually InnerVolumeSpecName. It will not be used
-		// for volume cleanup.
-		// in case reconciler calls mountOrAttachVolumes, outerVolumeSpecName will
-		// be updated from dsw information in ASW.MarkVolumeAsMounted().
-		outerVolumeSpecName: volume.volumeSpecName,
+		volumeName:          uniqueVolumeName,
+		podName:             volume.podName,
+		volumeSpec:          volumeSpec,
+		outerVolumeSpecName: outerVolumeSpecName,
 		pod:                 pod,
 		deviceMounter:       deviceMounter,
 		volumeGIDValue:      "",
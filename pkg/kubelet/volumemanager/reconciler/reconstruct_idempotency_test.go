@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2/ktesting"
+	"k8s.io/kubernetes/pkg/volume"
+	volumetesting "k8s.io/kubernetes/pkg/volume/testing"
+	"k8s.io/kubernetes/pkg/volume/util"
+)
+
+// TestMountIdempotencyAfterRestart is a harness that simulates a kubelet
+// restart at every interesting point of the mount flow and asserts that the
+// reconciler converges to a fully mounted volume after reconstruction. Each
+// interruption point lays out the on-disk state a crash at that point would
+// leave behind; the restarted reconciler only sees the disk, so the fragile
+// recovery paths in reconstruct.go are exercised end to end. New interruption
+// points can be added as table entries.
+func TestMountIdempotencyAfterRestart(t *testing.T) {
+	tests := []struct {
+		name string
+		// prepare lays out on-disk state left behind by the interrupted
+		// mount. The pod volume directory itself is always created.
+		prepare func(t *testing.T, tmpKubeletDir, podVolumeDir string)
+	}{
+		{
+			name: "restart before SetUp, only the pod volume directory exists",
+		},
+		{
+			name: "restart after NodeStage, global device mount directory exists",
+			prepare: func(t *testing.T, tmpKubeletDir, podVolumeDir string) {
+				deviceMountPath := filepath.Join(tmpKubeletDir, "plugins", "fake-plugin", "mounts", "volumename")
+				if err := os.MkdirAll(deviceMountPath, 0755); err != nil {
+					t.Fatalf("can't create device mount path: %v", err)
+				}
+			},
+		},
+		{
+			name: "restart mid ownership change, volume contains partially chowned files",
+			prepare: func(t *testing.T, tmpKubeletDir, podVolumeDir string) {
+				// A crash during fsGroup application leaves files with mixed
+				// permissions behind. The remount must succeed regardless and
+				// must not remove the data.
+				if err := os.WriteFile(filepath.Join(podVolumeDir, "chowned"), []byte("data"), 0660); err != nil {
+					t.Fatalf("can't create file: %v", err)
+				}
+				if err := os.WriteFile(filepath.Join(podVolumeDir, "not-chowned"), []byte("data"), 0600); err != nil {
+					t.Fatalf("can't create file: %v", err)
+				}
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tmpKubeletDir, err := os.MkdirTemp("", "")
+			if err != nil {
+				t.Fatalf("can't make a temp directory for kubeletPods: %v", err)
+			}
+			defer os.RemoveAll(tmpKubeletDir)
+
+			// The pod volume directory always exists; a mount is only
+			// interruptible after it was created.
+			tmpKubeletPodDir := filepath.Join(tmpKubeletDir, "pods")
+			podVolumeDir := filepath.Join(tmpKubeletPodDir, "pod1uid", "volumes", "fake-plugin", "volumename")
+			if err := os.MkdirAll(podVolumeDir, 0755); err != nil {
+				t.Fatalf("can't create pod volume directory: %v", err)
+			}
+			if tc.prepare != nil {
+				tc.prepare(t, tmpKubeletDir, podVolumeDir)
+			}
+
+			pod, pv, pvc := getPodPVCAndPV(v1.PersistentVolumeFilesystem, "pod1", "volumename", "pvc1")
+			volumeSpec := &volume.Spec{PersistentVolume: pv}
+			kubeClient := createtestClientWithPVPVC(pv, pvc, v1.AttachedVolume{
+				Name:       v1.UniqueVolumeName(fmt.Sprintf("fake-plugin/%s", "volumename")),
+				DevicePath: "fake/path",
+			})
+
+			// A fresh reconciler over the old state directory is a restarted
+			// kubelet: ASW and DSW are empty, only the disk remembers.
+			rc, fakePlugin := getReconciler(tmpKubeletDir, t, []string{podVolumeDir}, kubeClient)
+			rcInstance, _ := rc.(*reconciler)
+
+			rcInstance.reconstructVolumes()
+
+			// Reconstruction must leave the volume uncertain, not mounted.
+			if mountedPods := rcInstance.actualStateOfWorld.GetMountedVolumes(); len(mountedPods) != 0 {
+				t.Errorf("expected 0 mounted volumes after reconstruction, got %+v", mountedPods)
+			}
+			if allPods := rcInstance.actualStateOfWorld.GetAllMountedVolumes(); len(allPods) != 1 {
+				t.Errorf("expected 1 uncertain volume after reconstruction, got %+v", allPods)
+			}
+
+			podName := util.GetUniquePodName(pod)
+			volumeName, err := rcInstance.desiredStateOfWorld.AddPodToVolume(
+				podName, pod, volumeSpec, volumeSpec.Name(), "" /* volumeGIDValue */, nil /* SELinuxContext */)
+			if err != nil {
+				t.Fatalf("Error adding volume %s to dsow: %v", volumeSpec.Name(), err)
+			}
+			logger, _ := ktesting.NewTestContext(t)
+			rcInstance.actualStateOfWorld.MarkVolumeAsAttached(logger, volumeName, volumeSpec, nodeName, "")
+			rcInstance.populatorHasAddedPods = func() bool { return true }
+			rcInstance.volumesNeedUpdateFromNodeStatus = nil
+
+			rcInstance.reconcile()
+
+			// The volume must converge to fully mounted regardless of where
+			// the previous mount was interrupted.
+			waitForMount(t, fakePlugin, volumeName, rcInstance.actualStateOfWorld)
+			if err := volumetesting.VerifySetUpCallCount(1, fakePlugin); err != nil {
+				t.Errorf("Expected SetUp() to be called, got %s", err)
+			}
+			mountedPods := rcInstance.actualStateOfWorld.GetMountedVolumes()
+			if len(mountedPods) != 1 {
+				t.Errorf("expected exactly 1 mounted volume after reconcile, got %+v", mountedPods)
+			}
+
+			// The interrupted mount must not be treated as an orphan: no
+			// teardown and no data loss.
+			verifyTearDownCalls(fakePlugin, 0)
+			entries, err := os.ReadDir(podVolumeDir)
+			if err != nil {
+				t.Fatalf("pod volume directory disappeared: %v", err)
+			}
+			if tc.name == "restart mid ownership change, volume contains partially chowned files" && len(entries) != 2 {
+				t.Errorf("expected partially chowned files to survive the remount, got %d entries", len(entries))
+			}
+		})
+	}
+}
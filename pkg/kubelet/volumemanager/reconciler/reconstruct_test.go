@@ -434,3 +434,98 @@ func getPodPVCAndPV(volumeMode v1.PersistentVolumeMode, podName, pvName, pvcName
 	}
 	return pod, pv, pvc
 }
+
+func TestTranslateReconstructedSpec(t *testing.T) {
+	// A node can carry a mix of volume paths mounted by the current kubelet
+	// and in-tree paths mounted by an older kubelet before the plugin was
+	// migrated to CSI. The former must pass through unchanged, the latter
+	// must come out as CSI specs.
+	tmpKubeletDir, err := os.MkdirTemp("", "")
+	if err != nil {
+		t.Fatalf("can't make a temp directory for kubeletPods: %v", err)
+	}
+	defer os.RemoveAll(tmpKubeletDir)
+	rc, _ := getReconciler(tmpKubeletDir, t, nil /* volumePaths */, nil /* custom kubeclient */)
+	rcInstance, _ := rc.(*reconciler)
+
+	// A spec of a non-migrated plugin is returned unchanged.
+	fakeSpec := &volume.Spec{
+		Volume: &v1.Volume{
+			Name: "fake-volume",
+			VolumeSource: v1.VolumeSource{
+				EmptyDir: &v1.EmptyDirVolumeSource{},
+			},
+		},
+	}
+	spec, translated, err := rcInstance.translateReconstructedSpec(fakeSpec)
+	if err != nil {
+		t.Fatalf("translateReconstructedSpec failed for a non-migrated spec: %v", err)
+	}
+	if translated || spec != fakeSpec {
+		t.Errorf("expected a non-migrated spec to pass through unchanged, got translated=%v", translated)
+	}
+
+	// An in-tree spec of a migrated plugin is translated to CSI.
+	portworxSpec := &volume.Spec{
+		PersistentVolume: &v1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "portworx-pv",
+			},
+			Spec: v1.PersistentVolumeSpec{
+				PersistentVolumeSource: v1.PersistentVolumeSource{
+					PortworxVolume: &v1.PortworxVolumeSource{
+						VolumeID: "portworx-vol-1",
+					},
+				},
+			},
+		},
+	}
+	spec, translated, err = rcInstance.translateReconstructedSpec(portworxSpec)
+	if err != nil {
+		t.Fatalf("translateReconstructedSpec failed for a migrated in-tree spec: %v", err)
+	}
+	if !translated {
+		t.Fatalf("expected the portworx spec to be translated to CSI")
+	}
+	if spec.PersistentVolume == nil || spec.PersistentVolume.Spec.CSI == nil {
+		t.Fatalf("expected a CSI persistent volume source after translation, got %+v", spec)
+	}
+	if driver := spec.PersistentVolume.Spec.CSI.Driver; driver != "pxd.portworx.com" {
+		t.Errorf("expected the translated spec to use the portworx CSI driver, got %q", driver)
+	}
+	if volumeHandle := spec.PersistentVolume.Spec.CSI.VolumeHandle; volumeHandle != "portworx-vol-1" {
+		t.Errorf("expected the volume handle to carry the in-tree volume ID, got %q", volumeHandle)
+	}
+}
+
+func TestGetVolumesFromPodDirMalformed(t *testing.T) {
+	podsDir := t.TempDir()
+	volumesDir := filepath.Join(podsDir, "pod1uid", "volumes")
+
+	// A well-formed volume directory.
+	if err := os.MkdirAll(filepath.Join(volumesDir, "kubernetes.io~csi", "pvc-abcdef"), 0755); err != nil {
+		t.Fatalf("can't make a volume dir: %v", err)
+	}
+	// A stray file where a plugin directory is expected.
+	if err := os.WriteFile(filepath.Join(volumesDir, "stray.json"), []byte("{}"), 0644); err != nil {
+		t.Fatalf("can't make a stray file: %v", err)
+	}
+	// A plugin directory whose name does not unescape to a qualified plugin name.
+	if err := os.MkdirAll(filepath.Join(volumesDir, "kubernetes.io~~bad", "pvc-zzzzzz"), 0755); err != nil {
+		t.Fatalf("can't make a volume dir: %v", err)
+	}
+
+	volumes, err := getVolumesFromPodDir(podsDir)
+	if err != nil {
+		t.Fatalf("getVolumesFromPodDir failed: %v", err)
+	}
+	if len(volumes) != 1 {
+		t.Fatalf("expected only the well-formed volume to be returned, got %+v", volumes)
+	}
+	if volumes[0].pluginName != "kubernetes.io/csi" {
+		t.Errorf("expected plugin name %q, got %q", "kubernetes.io/csi", volumes[0].pluginName)
+	}
+	if volumes[0].volumeSpecName != "pvc-abcdef" {
+		t.Errorf("expected volume spec name %q, got %q", "pvc-abcdef", volumes[0].volumeSpecName)
+	}
+}
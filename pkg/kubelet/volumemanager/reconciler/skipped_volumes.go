@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	kevents "k8s.io/kubernetes/pkg/kubelet/events"
+	"k8s.io/kubernetes/pkg/kubelet/volumemanager/metrics"
+)
+
+// skippedReconstructionEscalationThreshold is how long a volume may stay in
+// skippedDuringReconstruction before the watchdog escalates it to an event on
+// the pods that use it.
+const skippedReconstructionEscalationThreshold = 10 * time.Minute
+
+var _ logr.Marshaler = skippedVolumeInfo{}
+
+// skippedVolumeInfo records why a reconstructed volume was left out of the
+// actual state of the world, and when, so that the omission can be surfaced
+// instead of lingering silently until the next kubelet restart.
+type skippedVolumeInfo struct {
+	gvl       *globalVolumeInfo
+	reason    string
+	skippedAt time.Time
+	// escalated is set after the watchdog reported the volume with an event
+	// so that each volume is escalated at most once.
+	escalated bool
+}
+
+func (s skippedVolumeInfo) MarshalLog() interface{} {
+	return struct {
+		VolumeName string `json:"volumeName"`
+		Reason     string `json:"reason"`
+		SkippedAt  string `json:"skippedAt"`
+	}{
+		VolumeName: string(s.gvl.volumeName),
+		Reason:     s.reason,
+		SkippedAt:  s.skippedAt.Format(time.RFC3339),
+	}
+}
+
+// markSkippedDuringReconstruction records that the given reconstructed volume
+// (or one of its pod mounts) could not be added to the actual state of the
+// world. The watchdog in checkSkippedReconstructionVolumes surfaces entries
+// that stay skipped for too long.
+func (rc *reconciler) markSkippedDuringReconstruction(gvl *globalVolumeInfo, reason string) {
+	if _, exists := rc.skippedDuringReconstruction[gvl.volumeName]; exists {
+		return
+	}
+	info := &skippedVolumeInfo{
+		gvl:       gvl,
+		reason:    reason,
+		skippedAt: time.Now(),
+	}
+	klog.InfoS("Volume skipped during reconstruction", "volume", info)
+	rc.skippedDuringReconstruction[gvl.volumeName] = info
+	rc.skippedReconstructionCount.Store(int64(len(rc.skippedDuringReconstruction)))
+}
+
+// checkSkippedReconstructionVolumes is the watchdog for
+// skippedDuringReconstruction. Volumes whose pod mounts reached the actual
+// state of the world in the meantime (e.g. because reconcile mounted them for
+// their pods) are dropped; volumes that stay skipped longer than
+// skippedReconstructionEscalationThreshold are escalated to an event on the
+// pods that use them.
+func (rc *reconciler) checkSkippedReconstructionVolumes() {
+	for volumeName, info := range rc.skippedDuringReconstruction {
+		if rc.skippedVolumeResolved(info) {
+			klog.V(4).InfoS("Volume skipped during reconstruction reached the actual state of the world", "volume", info)
+			delete(rc.skippedDuringReconstruction, volumeName)
+			continue
+		}
+		if info.escalated || time.Since(info.skippedAt) < skippedReconstructionEscalationThreshold {
+			continue
+		}
+		info.escalated = true
+		klog.ErrorS(nil, "Volume is still missing from the actual state of the world long after it was skipped during reconstruction; its mounts will not be cleaned up until the pods are restarted or kubelet restarts", "volume", info)
+		rc.recordSkippedReconstructionEvent(info)
+	}
+	rc.skippedReconstructionCount.Store(int64(len(rc.skippedDuringReconstruction)))
+	metrics.SkippedDuringReconstructionVolumes.Set(float64(len(rc.skippedDuringReconstruction)))
+}
+
+// UnresolvedReconstructionVolumes returns the number of volumes reconstructed
+// at kubelet startup that are still in uncertain or skipped state.
+func (rc *reconciler) UnresolvedReconstructionVolumes() int {
+	reconstructed, verified := rc.StartupVerificationProgress()
+	return (reconstructed - verified) + int(rc.skippedReconstructionCount.Load())
+}
+
+// skippedVolumeResolved returns true when the skipped volume and all its pod
+// mounts are known to the actual state of the world.
+func (rc *reconciler) skippedVolumeResolved(info *skippedVolumeInfo) bool {
+	if !rc.actualStateOfWorld.VolumeExists(info.gvl.volumeName) {
+		return false
+	}
+	for podName := range info.gvl.podVolumes {
+		if !rc.actualStateOfWorld.VolumeExistsWithSpecName(podName, info.gvl.volumeSpec.Name()) {
+			return false
+		}
+	}
+	return true
+}
+
+// recordSkippedReconstructionEvent emits a warning event on every pod in the
+// desired state of the world that uses the skipped volume. Pods that are
+// already deleted get no event.
+func (rc *reconciler) recordSkippedReconstructionEvent(info *skippedVolumeInfo) {
+	recorder := rc.volumePluginMgr.Host.GetEventRecorder()
+	if recorder == nil {
+		return
+	}
+	for _, volumeToMount := range rc.desiredStateOfWorld.GetVolumesToMount() {
+		if volumeToMount.VolumeName != info.gvl.volumeName {
+			continue
+		}
+		recorder.Eventf(
+			volumeToMount.Pod, v1.EventTypeWarning, kevents.FailedMountVolume,
+			"Volume %q was skipped during kubelet state reconstruction %s ago: %s", volumeToMount.OuterVolumeSpecName, time.Since(info.skippedAt).Round(time.Second), info.reason)
+	}
+}
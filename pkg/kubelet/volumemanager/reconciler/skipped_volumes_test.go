@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2/ktesting"
+	volumepkg "k8s.io/kubernetes/pkg/volume"
+)
+
+func TestCheckSkippedReconstructionVolumes(t *testing.T) {
+	tmpKubeletDir, err := os.MkdirTemp("", "")
+	if err != nil {
+		t.Fatalf("can't make a temp directory for kubeletPods: %v", err)
+	}
+	defer os.RemoveAll(tmpKubeletDir)
+
+	rc, _ := getReconciler(tmpKubeletDir, t, nil /*volumePaths*/, nil /*custom kubeclient*/)
+	rcInstance, _ := rc.(*reconciler)
+
+	volumeSpec := &volumepkg.Spec{
+		Volume: &v1.Volume{
+			Name: "volume-name",
+			VolumeSource: v1.VolumeSource{
+				EmptyDir: &v1.EmptyDirVolumeSource{},
+			},
+		},
+	}
+	gvl := &globalVolumeInfo{
+		volumeName: "fake-plugin/volume-name",
+		volumeSpec: volumeSpec,
+	}
+
+	rcInstance.markSkippedDuringReconstruction(gvl, "first reason")
+	// Marking the same volume again must not overwrite the original entry.
+	rcInstance.markSkippedDuringReconstruction(gvl, "second reason")
+	info, exists := rcInstance.skippedDuringReconstruction[gvl.volumeName]
+	if !exists {
+		t.Fatalf("expected the volume to be recorded as skipped")
+	}
+	if info.reason != "first reason" {
+		t.Errorf("expected the original skip reason to be kept, got %q", info.reason)
+	}
+
+	// A freshly skipped volume is kept but not escalated yet.
+	rcInstance.checkSkippedReconstructionVolumes()
+	if info.escalated {
+		t.Errorf("expected no escalation before the threshold elapsed")
+	}
+
+	// Once the threshold elapsed the watchdog escalates the volume, once.
+	info.skippedAt = time.Now().Add(-2 * skippedReconstructionEscalationThreshold)
+	rcInstance.checkSkippedReconstructionVolumes()
+	if !info.escalated {
+		t.Errorf("expected the volume to be escalated after the threshold elapsed")
+	}
+	if _, exists := rcInstance.skippedDuringReconstruction[gvl.volumeName]; !exists {
+		t.Errorf("expected the escalated volume to stay recorded until it is resolved")
+	}
+
+	// A volume that reached the actual state of the world is dropped.
+	logger, _ := ktesting.NewTestContext(t)
+	if err := rcInstance.actualStateOfWorld.MarkVolumeAsAttached(logger, gvl.volumeName, volumeSpec, nodeName, "" /*devicePath*/); err != nil {
+		t.Fatalf("MarkVolumeAsAttached failed: %v", err)
+	}
+	rcInstance.checkSkippedReconstructionVolumes()
+	if _, exists := rcInstance.skippedDuringReconstruction[gvl.volumeName]; exists {
+		t.Errorf("expected the resolved volume to be removed from the skipped volumes")
+	}
+}
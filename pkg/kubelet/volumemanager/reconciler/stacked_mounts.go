@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"path/filepath"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/kubelet/volumemanager/metrics"
+	utilstrings "k8s.io/utils/strings"
+)
+
+// stackedMountCheckInterval is how often the mount table is scanned for
+// stacked duplicate mounts on pod volume paths.
+const stackedMountCheckInterval = time.Minute
+
+// collapseStackedMounts scans the mount table for pod volume paths that are
+// mounted more than once with the same source. A plugin whose SetUp is
+// retried after a failure mid-mount can stack a second identical mount on
+// the same target; the extra layers keep the backing device busy after the
+// top mount is removed and make unmounts appear to succeed while the volume
+// stays mounted. Extra layers of identical mounts are unmounted; targets
+// with differing sources are left alone, these are not simple stacking.
+func (rc *reconciler) collapseStackedMounts() {
+	if time.Since(rc.timeOfLastStackedMountCheck) < stackedMountCheckInterval {
+		return
+	}
+	rc.timeOfLastStackedMountCheck = time.Now()
+
+	mountPoints, err := rc.mounter.List()
+	if err != nil {
+		klog.ErrorS(err, "Failed to list mount points while checking for stacked mounts")
+		return
+	}
+
+	type mountedTarget struct {
+		device string
+		count  int
+		mixed  bool
+	}
+	targets := map[string]*mountedTarget{}
+	podsDirPrefix := rc.kubeletPodsDir + string(filepath.Separator)
+	for _, mountPoint := range mountPoints {
+		if !strings.HasPrefix(mountPoint.Path, podsDirPrefix) {
+			continue
+		}
+		target, exists := targets[mountPoint.Path]
+		if !exists {
+			targets[mountPoint.Path] = &mountedTarget{device: mountPoint.Device, count: 1}
+			continue
+		}
+		target.count++
+		if target.device != mountPoint.Device {
+			target.mixed = true
+		}
+	}
+
+	for path, target := range targets {
+		if target.count < 2 {
+			continue
+		}
+		if target.mixed {
+			klog.InfoS("Mount target is mounted multiple times with differing sources, not collapsing",
+				"path", path, "mounts", target.count)
+			continue
+		}
+		pluginName := pluginNameFromPodVolumePath(path)
+		metrics.StackedMountsTotal.WithLabelValues(pluginName).Inc()
+		klog.InfoS("Detected stacked identical mounts on pod volume path, collapsing extra layers",
+			"path", path, "mounts", target.count, "pluginName", pluginName)
+		for layer := 0; layer < target.count-1; layer++ {
+			if err := rc.mounter.Unmount(path); err != nil {
+				klog.ErrorS(err, "Failed to unmount stacked mount layer", "path", path)
+				break
+			}
+		}
+	}
+}
+
+// pluginNameFromPodVolumePath extracts the unescaped volume plugin name from
+// a pod volume mount path, i.e. the path segment after "volumes" in
+// .../pods/{podUID}/volumes/{escapedPluginName}/{volumeSpecName}. It returns
+// "N/A" for paths with an unexpected layout.
+func pluginNameFromPodVolumePath(path string) string {
+	segments := strings.Split(path, string(filepath.Separator))
+	for i := 0; i < len(segments)-1; i++ {
+		if segments[i] == "volumes" {
+			return utilstrings.UnescapeQualifiedName(segments[i+1])
+		}
+	}
+	return "N/A"
+}
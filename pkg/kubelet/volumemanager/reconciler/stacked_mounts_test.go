@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"testing"
+
+	"k8s.io/mount-utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollapseStackedMounts(t *testing.T) {
+	podsDir := "/var/lib/kubelet/pods"
+	stackedPath := podsDir + "/pod1uid/volumes/kubernetes.io~csi/pv1/mount"
+	mixedPath := podsDir + "/pod1uid/volumes/kubernetes.io~empty-dir/cache"
+	singlePath := podsDir + "/pod2uid/volumes/kubernetes.io~csi/pv2/mount"
+	mounter := mount.NewFakeMounter([]mount.MountPoint{
+		// Three identical layers stacked on the same target.
+		{Device: "/dev/sda1", Path: stackedPath},
+		{Device: "/dev/sda1", Path: stackedPath},
+		{Device: "/dev/sda1", Path: stackedPath},
+		// Same target mounted with differing sources must be left alone.
+		{Device: "tmpfs", Path: mixedPath},
+		{Device: "overlay", Path: mixedPath},
+		// Healthy single mount.
+		{Device: "/dev/sdb1", Path: singlePath},
+		// Duplicates outside the pods directory are not kubelet's business.
+		{Device: "/dev/sdc1", Path: "/mnt/data"},
+		{Device: "/dev/sdc1", Path: "/mnt/data"},
+	})
+
+	rc := &reconciler{
+		mounter:        mounter,
+		kubeletPodsDir: podsDir,
+	}
+
+	rc.collapseStackedMounts()
+
+	unmounts := map[string]int{}
+	for _, action := range mounter.GetLog() {
+		if action.Action == mount.FakeActionUnmount {
+			unmounts[action.Target]++
+		}
+	}
+	assert.Equal(t, map[string]int{stackedPath: 2}, unmounts, "only the extra identical layers must be unmounted")
+
+	// The check is rate limited; an immediate second pass must not touch
+	// the mount table again.
+	mounter.ResetLog()
+	rc.collapseStackedMounts()
+	assert.Empty(t, mounter.GetLog())
+}
+
+func TestPluginNameFromPodVolumePath(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{"/var/lib/kubelet/pods/uid/volumes/kubernetes.io~csi/pv1/mount", "kubernetes.io/csi"},
+		{"/var/lib/kubelet/pods/uid/volumes/kubernetes.io~empty-dir/cache", "kubernetes.io/empty-dir"},
+		{"/var/lib/kubelet/pods/uid/volumes", "N/A"},
+		{"/mnt/data", "N/A"},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, pluginNameFromPodVolumePath(test.path), "path %q", test.path)
+	}
+}
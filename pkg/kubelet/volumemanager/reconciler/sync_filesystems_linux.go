@@ -0,0 +1,32 @@
+//go:build linux
+// +build linux
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"golang.org/x/sys/unix"
+	"k8s.io/klog/v2"
+)
+
+// syncFilesystems flushes dirty filesystem buffers to disk so that skipping
+// UnmountDevice during node shutdown does not lose data.
+func syncFilesystems() {
+	klog.InfoS("Syncing filesystem buffers before skipping device unmounts for node shutdown")
+	unix.Sync()
+}
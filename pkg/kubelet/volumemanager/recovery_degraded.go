@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumemanager
+
+import (
+	"time"
+
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/kubernetes/pkg/features"
+)
+
+const (
+	// volumeRecoveryDegradedCount is the number of volumes that may remain
+	// in uncertain or skipped reconstruction state before the node is
+	// considered degraded. Zero, i.e. any volume stuck in recovery counts.
+	volumeRecoveryDegradedCount = 0
+
+	// volumeRecoveryDegradedAfter is how long the count must stay above the
+	// threshold before the VolumeRecoveryDegraded node condition turns
+	// true.
+	volumeRecoveryDegradedAfter = 10 * time.Minute
+)
+
+// VolumeRecoveryDegradedConditionEnabled returns true when the kubelet
+// should manage the VolumeRecoveryDegraded node condition.
+func VolumeRecoveryDegradedConditionEnabled() bool {
+	return utilfeature.DefaultFeatureGate.Enabled(features.VolumeRecoveryDegradedCondition)
+}
+
+// VolumeRecoveryDegraded returns true when more than the configured number of
+// volumes have remained in uncertain or skipped reconstruction state for
+// longer than the configured duration, together with how many such volumes
+// there are. It is called from the node status update loop.
+func (vm *volumeManager) VolumeRecoveryDegraded() (bool, int) {
+	unresolved := vm.reconciler.UnresolvedReconstructionVolumes()
+
+	vm.recoveryDegradedLock.Lock()
+	defer vm.recoveryDegradedLock.Unlock()
+	if unresolved <= vm.recoveryDegradedCount {
+		vm.recoveryDegradedSince = time.Time{}
+		return false, unresolved
+	}
+	if vm.recoveryDegradedSince.IsZero() {
+		vm.recoveryDegradedSince = time.Now()
+	}
+	return time.Since(vm.recoveryDegradedSince) >= vm.recoveryDegradedAfter, unresolved
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumemanager
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+
+	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
+	"k8s.io/kubernetes/pkg/kubelet/volumemanager/cache"
+)
+
+const (
+	// RestartOnRemountAnnotation is a pod annotation opting containers into a
+	// restart when one of their volumes is remounted, e.g. after recovery
+	// from a mount failure or after credential rotation, so they do not keep
+	// using stale file handles. The value is either "true" to cover all
+	// volumes of the pod, or a comma-separated list of pod volume names.
+	RestartOnRemountAnnotation = "volumes.kubernetes.io/restart-on-remount"
+
+	// ContainersRestartedOnRemountReason is the event reason used when
+	// containers are restarted because a volume they mount was remounted.
+	ContainersRestartedOnRemountReason = "ContainersRestartedOnRemount"
+
+	// remountRestartPollInterval is how often the restarter drains the
+	// remounted volumes recorded in the actual state of the world.
+	remountRestartPollInterval = time.Second
+)
+
+// remountRestarter restarts the containers of a pod that mount a volume that
+// was remounted, for pods that opted in via the RestartOnRemountAnnotation.
+// The affected running containers are killed; the kubelet sync loop starts
+// them again according to the pod's restart policy.
+type remountRestarter struct {
+	actualStateOfWorld   cache.ActualStateOfWorld
+	podManager           PodManager
+	kubeContainerRuntime kubecontainer.Runtime
+	recorder             record.EventRecorder
+}
+
+func newRemountRestarter(
+	actualStateOfWorld cache.ActualStateOfWorld,
+	podManager PodManager,
+	kubeContainerRuntime kubecontainer.Runtime,
+	recorder record.EventRecorder) *remountRestarter {
+	return &remountRestarter{
+		actualStateOfWorld:   actualStateOfWorld,
+		podManager:           podManager,
+		kubeContainerRuntime: kubeContainerRuntime,
+		recorder:             recorder,
+	}
+}
+
+// Run drains remounted volumes from the actual state of the world and
+// restarts affected containers until stopCh is closed.
+func (rr *remountRestarter) Run(stopCh <-chan struct{}) {
+	wait.Until(func() {
+		for _, remounted := range rr.actualStateOfWorld.PopRemountedVolumes() {
+			rr.handleRemount(remounted)
+		}
+	}, remountRestartPollInterval, stopCh)
+}
+
+func (rr *remountRestarter) handleRemount(remounted cache.RemountedVolume) {
+	pod, podExists := rr.podManager.GetPodByUID(remounted.PodUID)
+	if !podExists {
+		return
+	}
+	if !restartOnRemountRequested(pod, remounted.OuterVolumeSpecName) {
+		return
+	}
+
+	containerNames := containersMountingVolume(pod, remounted.OuterVolumeSpecName)
+	if containerNames.Len() == 0 {
+		return
+	}
+	if err := rr.killContainers(pod, containerNames); err != nil {
+		klog.ErrorS(err, "Failed to restart containers after volume remount, will not retry", "pod", klog.KObj(pod), "volumeName", remounted.OuterVolumeSpecName)
+		return
+	}
+	rr.recorder.Eventf(pod, v1.EventTypeNormal, ContainersRestartedOnRemountReason,
+		"Restarting containers %v because volume %q was remounted", sets.List(containerNames), remounted.OuterVolumeSpecName)
+	klog.V(2).InfoS("Restarted containers after volume remount", "pod", klog.KObj(pod), "volumeName", remounted.OuterVolumeSpecName, "containers", sets.List(containerNames))
+}
+
+// killContainers kills the named running containers of the pod. Only the
+// containers are killed, not the pod sandbox, so the sync loop recreates
+// them in place.
+func (rr *remountRestarter) killContainers(pod *v1.Pod, containerNames sets.Set[string]) error {
+	ctx := context.Background()
+	runningPods, err := rr.kubeContainerRuntime.GetPods(ctx, false)
+	if err != nil {
+		return fmt.Errorf("listing running pods failed: %w", err)
+	}
+	runningPod := kubecontainer.Pods(runningPods).FindPodByID(pod.UID)
+	if runningPod.IsEmpty() {
+		return nil
+	}
+
+	affectedPod := kubecontainer.Pod{
+		ID:        runningPod.ID,
+		Name:      runningPod.Name,
+		Namespace: runningPod.Namespace,
+	}
+	for _, container := range runningPod.Containers {
+		if containerNames.Has(container.Name) {
+			affectedPod.Containers = append(affectedPod.Containers, container)
+		}
+	}
+	if len(affectedPod.Containers) == 0 {
+		return nil
+	}
+	return rr.kubeContainerRuntime.KillPod(ctx, pod, affectedPod, nil)
+}
+
+// restartOnRemountRequested returns true if the pod opted the given volume
+// into container restarts on remount.
+func restartOnRemountRequested(pod *v1.Pod, outerVolumeSpecName string) bool {
+	value, ok := pod.Annotations[RestartOnRemountAnnotation]
+	if !ok {
+		return false
+	}
+	if value == "true" {
+		return true
+	}
+	for _, name := range strings.Split(value, ",") {
+		if strings.TrimSpace(name) == outerVolumeSpecName {
+			return true
+		}
+	}
+	return false
+}
+
+// containersMountingVolume returns the names of the containers of the pod
+// that mount the given pod volume.
+func containersMountingVolume(pod *v1.Pod, outerVolumeSpecName string) sets.Set[string] {
+	names := sets.New[string]()
+	for _, container := range pod.Spec.Containers {
+		for _, volumeMount := range container.VolumeMounts {
+			if volumeMount.Name == outerVolumeSpecName {
+				names.Insert(container.Name)
+			}
+		}
+	}
+	return names
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumemanager
+
+import (
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	kubecontainer "k8s.io/kubernetes/pkg/kubelet/container"
+	containertest "k8s.io/kubernetes/pkg/kubelet/container/testing"
+	kubepod "k8s.io/kubernetes/pkg/kubelet/pod"
+	"k8s.io/kubernetes/pkg/kubelet/volumemanager/cache"
+)
+
+// newRemountTestPod returns a pod with one volume named "volume-name".
+func newRemountTestPod() *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod1",
+			Namespace: "ns",
+			UID:       "pod1uid",
+		},
+		Spec: v1.PodSpec{
+			Volumes: []v1.Volume{
+				{
+					Name: "volume-name",
+					VolumeSource: v1.VolumeSource{
+						EmptyDir: &v1.EmptyDirVolumeSource{},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestRestartOnRemountRequested(t *testing.T) {
+	pod := &v1.Pod{}
+	if restartOnRemountRequested(pod, "data") {
+		t.Errorf("expected no restart without the annotation")
+	}
+	pod.Annotations = map[string]string{RestartOnRemountAnnotation: "true"}
+	if !restartOnRemountRequested(pod, "data") {
+		t.Errorf("expected a restart for any volume with value true")
+	}
+	pod.Annotations[RestartOnRemountAnnotation] = "logs, data"
+	if !restartOnRemountRequested(pod, "data") {
+		t.Errorf("expected a restart for a listed volume")
+	}
+	if restartOnRemountRequested(pod, "scratch") {
+		t.Errorf("expected no restart for an unlisted volume")
+	}
+}
+
+func TestHandleRemountRestartsAffectedContainers(t *testing.T) {
+	pod := newRemountTestPod()
+	pod.Annotations = map[string]string{RestartOnRemountAnnotation: "true"}
+	pod.Spec.Containers = []v1.Container{
+		{
+			Name:         "uses-volume",
+			VolumeMounts: []v1.VolumeMount{{Name: "volume-name", MountPath: "/data"}},
+		},
+		{
+			Name: "no-volume",
+		},
+	}
+	podManager := kubepod.NewBasicPodManager()
+	podManager.SetPods(nil)
+	podManager.AddPod(pod)
+
+	fakeRuntime := &containertest.FakeRuntime{}
+	fakeRuntime.PodList = []*containertest.FakePod{
+		{
+			Pod: &kubecontainer.Pod{
+				ID:        pod.UID,
+				Name:      pod.Name,
+				Namespace: pod.Namespace,
+				Containers: []*kubecontainer.Container{
+					{Name: "uses-volume"},
+					{Name: "no-volume"},
+				},
+			},
+		},
+	}
+	recorder := record.NewFakeRecorder(10)
+	restarter := newRemountRestarter(nil, podManager, fakeRuntime, recorder)
+
+	restarter.handleRemount(cache.RemountedVolume{
+		PodName:             "pod1",
+		PodUID:              pod.UID,
+		VolumeName:          "fake-plugin/volume-name",
+		OuterVolumeSpecName: "volume-name",
+	})
+
+	if !fakeRuntime.AssertKilledPods([]string{string(pod.UID)}) {
+		t.Errorf("expected the containers of the pod to be killed, killed pods: %v", fakeRuntime.KilledPods)
+	}
+	select {
+	case event := <-recorder.Events:
+		if !strings.Contains(event, ContainersRestartedOnRemountReason) {
+			t.Errorf("expected %s event, got %q", ContainersRestartedOnRemountReason, event)
+		}
+	default:
+		t.Error("expected an event for the restarted containers")
+	}
+}
+
+func TestHandleRemountIgnoresPodsWithoutOptIn(t *testing.T) {
+	pod := newRemountTestPod()
+	podManager := kubepod.NewBasicPodManager()
+	podManager.SetPods(nil)
+	podManager.AddPod(pod)
+	fakeRuntime := &containertest.FakeRuntime{}
+	restarter := newRemountRestarter(nil, podManager, fakeRuntime, record.NewFakeRecorder(10))
+
+	restarter.handleRemount(cache.RemountedVolume{
+		PodUID:              pod.UID,
+		OuterVolumeSpecName: "volume-name",
+	})
+
+	if len(fakeRuntime.KilledPods) != 0 {
+		t.Errorf("expected no containers to be killed without opt-in, killed pods: %v", fakeRuntime.KilledPods)
+	}
+}
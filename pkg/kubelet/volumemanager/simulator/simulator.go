@@ -0,0 +1,372 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package simulator replays synthetic pod and volume churn against the real
+// desired state of the world, actual state of the world, populator and
+// reconciler code using fake volume plugins. It is intended to catch
+// performance regressions in the volume manager (similar in spirit to the
+// scheduler_perf framework) by reporting mount and unmount latency
+// distributions for configurable amounts of churn.
+package simulator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubetypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/kubernetes/pkg/kubelet/config"
+	containertest "k8s.io/kubernetes/pkg/kubelet/container/testing"
+	kubepod "k8s.io/kubernetes/pkg/kubelet/pod"
+	"k8s.io/kubernetes/pkg/kubelet/volumemanager"
+	"k8s.io/kubernetes/pkg/volume"
+	volumetest "k8s.io/kubernetes/pkg/volume/testing"
+	"k8s.io/kubernetes/pkg/volume/util/hostutil"
+	"k8s.io/mount-utils"
+)
+
+const (
+	// simulatedNodeName is the name of the synthetic node all simulated pods
+	// run on.
+	simulatedNodeName = "volume-manager-simulator-node"
+
+	// reportedInUseInterval is how often the simulator marks desired volumes
+	// as reported in use, standing in for the node status updater.
+	reportedInUseInterval = 100 * time.Millisecond
+)
+
+// Config describes the synthetic churn a simulation run generates.
+type Config struct {
+	// Pods is the number of pods created in each churn round.
+	Pods int
+
+	// VolumesPerPod is the number of attachable persistent volumes each
+	// simulated pod mounts. Every pod gets its own set of PV/PVC pairs.
+	VolumesPerPod int
+
+	// Rounds is the number of create/mount/unmount/delete cycles to run.
+	Rounds int
+}
+
+// Results aggregates the per-pod latencies observed during a simulation run.
+type Results struct {
+	// MountLatencies holds one sample per pod per round measuring how long
+	// WaitForAttachAndMount took to see all the pod's volumes mounted.
+	MountLatencies LatencyDistribution
+
+	// UnmountLatencies holds one sample per pod per round measuring how long
+	// it took for all the pod's volumes to be unmounted after the pod was
+	// removed.
+	UnmountLatencies LatencyDistribution
+}
+
+// LatencyDistribution collects duration samples and reports simple
+// distribution statistics over them.
+type LatencyDistribution struct {
+	mu      sync.Mutex
+	samples []time.Duration
+}
+
+func (d *LatencyDistribution) observe(sample time.Duration) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.samples = append(d.samples, sample)
+}
+
+// Count returns the number of collected samples.
+func (d *LatencyDistribution) Count() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.samples)
+}
+
+// Percentile returns the latency at the given percentile (0-100). It returns
+// zero if no samples were collected.
+func (d *LatencyDistribution) Percentile(p float64) time.Duration {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if len(d.samples) == 0 {
+		return 0
+	}
+	sorted := make([]time.Duration, len(d.samples))
+	copy(sorted, d.samples)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	idx := int(float64(len(sorted)-1) * p / 100)
+	return sorted[idx]
+}
+
+// String summarizes the distribution for logging in CI output.
+func (d *LatencyDistribution) String() string {
+	return fmt.Sprintf("count=%d p50=%v p90=%v p99=%v max=%v",
+		d.Count(), d.Percentile(50), d.Percentile(90), d.Percentile(99), d.Percentile(100))
+}
+
+// simulatedPodStateProvider reports pods as terminated once the simulator has
+// deleted them, so that the desired state of world populator removes them.
+type simulatedPodStateProvider struct {
+	mu         sync.Mutex
+	terminated map[kubetypes.UID]struct{}
+}
+
+func (p *simulatedPodStateProvider) markTerminated(uid kubetypes.UID) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.terminated[uid] = struct{}{}
+}
+
+func (p *simulatedPodStateProvider) reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.terminated = map[kubetypes.UID]struct{}{}
+}
+
+func (p *simulatedPodStateProvider) ShouldPodContainersBeTerminating(uid kubetypes.UID) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	_, ok := p.terminated[uid]
+	return ok
+}
+
+func (p *simulatedPodStateProvider) ShouldPodRuntimeBeRemoved(uid kubetypes.UID) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	_, ok := p.terminated[uid]
+	return ok
+}
+
+// Simulator drives a real volume manager with synthetic pods and fake
+// plugins.
+type Simulator struct {
+	config        Config
+	volumeManager volumemanager.VolumeManager
+	podManager    kubepod.Manager
+	stateProvider *simulatedPodStateProvider
+	pods          []*v1.Pod
+}
+
+// New builds a Simulator wired to a real volume manager instance backed by a
+// fake attachable plugin, a fake mounter and a fake clientset pre-populated
+// with the synthetic node, PV and PVC objects the configured churn needs.
+func New(t *testing.T, tmpDir string, config Config) *Simulator {
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: simulatedNodeName},
+	}
+
+	objects := []runtime.Object{node}
+	pods := make([]*v1.Pod, 0, config.Pods)
+	for i := 0; i < config.Pods; i++ {
+		pod := &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("simulated-pod-%d", i),
+				Namespace: "simulator",
+				UID:       kubetypes.UID(fmt.Sprintf("simulated-pod-uid-%d", i)),
+			},
+			Spec: v1.PodSpec{
+				NodeName:   simulatedNodeName,
+				Containers: []v1.Container{{Name: "container"}},
+			},
+		}
+		for j := 0; j < config.VolumesPerPod; j++ {
+			deviceName := fmt.Sprintf("simulated-device-%d-%d", i, j)
+			pv, claim := createPVAndClaim(deviceName)
+			node.Status.VolumesAttached = append(node.Status.VolumesAttached, v1.AttachedVolume{
+				Name:       v1.UniqueVolumeName("fake/" + deviceName),
+				DevicePath: "fake/path",
+			})
+			pod.Spec.Volumes = append(pod.Spec.Volumes, v1.Volume{
+				Name: fmt.Sprintf("vol%d", j),
+				VolumeSource: v1.VolumeSource{
+					PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+						ClaimName: claim.Name,
+					},
+				},
+			})
+			pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, v1.VolumeMount{
+				Name:      fmt.Sprintf("vol%d", j),
+				MountPath: fmt.Sprintf("/mnt/vol%d", j),
+			})
+			objects = append(objects, pv, claim)
+		}
+		pods = append(pods, pod)
+	}
+
+	kubeClient := fake.NewSimpleClientset(objects...)
+
+	attachablePlug := &volumetest.FakeVolumePlugin{
+		PluginName: "fake",
+		Host:       nil,
+		CanSupportFn: func(spec *volume.Spec) bool {
+			return (spec.PersistentVolume != nil && spec.PersistentVolume.Spec.RBD != nil) ||
+				(spec.Volume != nil && spec.Volume.RBD != nil)
+		},
+	}
+	plugMgr := &volume.VolumePluginMgr{}
+	fakeVolumeHost := volumetest.NewFakeKubeletVolumeHost(t, tmpDir, kubeClient, nil)
+	fakeVolumeHost.WithNode(node)
+	plugMgr.InitPlugins([]volume.VolumePlugin{attachablePlug}, nil /* prober */, fakeVolumeHost)
+
+	podManager := kubepod.NewBasicPodManager()
+	stateProvider := &simulatedPodStateProvider{terminated: map[kubetypes.UID]struct{}{}}
+	vm := volumemanager.NewVolumeManager(
+		true, /* controllerAttachDetachEnabled */
+		simulatedNodeName,
+		podManager,
+		stateProvider,
+		kubeClient,
+		nil, /* volumeObjectCache */
+		plugMgr,
+		&containertest.FakeRuntime{},
+		mount.NewFakeMounter(nil),
+		hostutil.NewFakeHostUtil(nil),
+		"",
+		&record.FakeRecorder{},
+		volumetest.NewBlockVolumePathHandler())
+
+	return &Simulator{
+		config:        config,
+		volumeManager: vm,
+		podManager:    podManager,
+		stateProvider: stateProvider,
+		pods:          pods,
+	}
+}
+
+// Run executes the configured churn rounds and returns the observed latency
+// distributions. It blocks until all rounds complete or ctx is canceled.
+func (s *Simulator) Run(ctx context.Context) (*Results, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sourcesReady := config.NewSourcesReady(func(_ sets.Set[string]) bool { return true })
+	go s.volumeManager.Run(ctx, sourcesReady)
+	go s.reportVolumesInUse(ctx)
+
+	results := &Results{}
+	for round := 0; round < s.config.Rounds; round++ {
+		if err := s.runRound(ctx, results); err != nil {
+			return nil, fmt.Errorf("churn round %d: %w", round, err)
+		}
+	}
+	return results, nil
+}
+
+func (s *Simulator) runRound(ctx context.Context, results *Results) error {
+	s.stateProvider.reset()
+	s.podManager.SetPods(s.pods)
+
+	var wg sync.WaitGroup
+	errCh := make(chan error, len(s.pods))
+	for _, pod := range s.pods {
+		wg.Add(1)
+		go func(pod *v1.Pod) {
+			defer wg.Done()
+			start := time.Now()
+			if err := s.volumeManager.WaitForAttachAndMount(ctx, pod); err != nil {
+				errCh <- fmt.Errorf("mounting volumes for pod %q: %w", pod.Name, err)
+				return
+			}
+			results.MountLatencies.observe(time.Since(start))
+		}(pod)
+	}
+	wg.Wait()
+
+	// Simulate deletion: the populator observes termination via the pod
+	// state provider and removes the pods from the desired state of world.
+	for _, pod := range s.pods {
+		s.stateProvider.markTerminated(pod.UID)
+	}
+	s.podManager.SetPods(nil)
+
+	for _, pod := range s.pods {
+		wg.Add(1)
+		go func(pod *v1.Pod) {
+			defer wg.Done()
+			start := time.Now()
+			if err := s.volumeManager.WaitForUnmount(ctx, pod); err != nil {
+				errCh <- fmt.Errorf("unmounting volumes for pod %q: %w", pod.Name, err)
+				return
+			}
+			results.UnmountLatencies.observe(time.Since(start))
+		}(pod)
+	}
+	wg.Wait()
+
+	close(errCh)
+	for err := range errCh {
+		return err
+	}
+	return nil
+}
+
+// reportVolumesInUse periodically marks all desired volumes as reported in
+// use, standing in for the node status updater so that attachable volumes
+// become eligible for mounting.
+func (s *Simulator) reportVolumesInUse(ctx context.Context) {
+	ticker := time.NewTicker(reportedInUseInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.volumeManager.MarkVolumesAsReportedInUse(s.volumeManager.GetVolumesInUse())
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func createPVAndClaim(deviceName string) (*v1.PersistentVolume, *v1.PersistentVolumeClaim) {
+	fs := v1.PersistentVolumeFilesystem
+	pv := &v1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "pv-" + deviceName,
+		},
+		Spec: v1.PersistentVolumeSpec{
+			PersistentVolumeSource: v1.PersistentVolumeSource{
+				RBD: &v1.RBDPersistentVolumeSource{
+					RBDImage: deviceName,
+				},
+			},
+			ClaimRef: &v1.ObjectReference{
+				Namespace: "simulator",
+				Name:      "claim-" + deviceName,
+			},
+			VolumeMode: &fs,
+		},
+	}
+	claim := &v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "claim-" + deviceName,
+			Namespace: "simulator",
+		},
+		Spec: v1.PersistentVolumeClaimSpec{
+			VolumeName: pv.Name,
+			VolumeMode: &fs,
+		},
+		Status: v1.PersistentVolumeClaimStatus{
+			Phase: v1.ClaimBound,
+		},
+	}
+	return pv, claim
+}
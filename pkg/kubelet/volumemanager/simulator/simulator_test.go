@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	utiltesting "k8s.io/client-go/util/testing"
+)
+
+func TestPodVolumeChurn(t *testing.T) {
+	tests := []struct {
+		name   string
+		config Config
+	}{
+		{
+			name:   "single pod single volume",
+			config: Config{Pods: 1, VolumesPerPod: 1, Rounds: 1},
+		},
+		{
+			name:   "multiple pods multiple volumes",
+			config: Config{Pods: 5, VolumesPerPod: 2, Rounds: 2},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tmpDir, err := utiltesting.MkTmpdir("volumeManagerSimulatorTest")
+			if err != nil {
+				t.Fatalf("can't make a temp dir: %v", err)
+			}
+			defer os.RemoveAll(tmpDir)
+
+			sim := New(t, tmpDir, test.config)
+			ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+			defer cancel()
+
+			results, err := sim.Run(ctx)
+			if err != nil {
+				t.Fatalf("simulation failed: %v", err)
+			}
+
+			expectedSamples := test.config.Pods * test.config.Rounds
+			if results.MountLatencies.Count() != expectedSamples {
+				t.Errorf("Expected %d mount latency samples, got %d",
+					expectedSamples, results.MountLatencies.Count())
+			}
+			if results.UnmountLatencies.Count() != expectedSamples {
+				t.Errorf("Expected %d unmount latency samples, got %d",
+					expectedSamples, results.UnmountLatencies.Count())
+			}
+
+			t.Logf("mount latencies: %v", &results.MountLatencies)
+			t.Logf("unmount latencies: %v", &results.UnmountLatencies)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumemanager
+
+import (
+	"fmt"
+	"time"
+
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/features"
+	"k8s.io/kubernetes/pkg/volume"
+)
+
+const (
+	// startupVerifiedPercent is the percentage of reconstructed volumes
+	// that must be verified before the node reports Ready from the volumes
+	// perspective while the VolumeStartupVerificationBarrier feature gate
+	// is enabled. It prevents the scheduler from placing new stateful pods
+	// on a node that is still untangling its mounts after a kubelet
+	// restart.
+	startupVerifiedPercent = 100
+
+	// startupVerificationTimeout bounds how long the startup verification
+	// barrier may hold the node NotReady. After the timeout the barrier
+	// lifts regardless of progress.
+	startupVerificationTimeout = 10 * time.Minute
+
+	startupVerificationPollInterval = time.Second
+)
+
+// startupVerificationSatisfied returns true once the reconciler has run its
+// first sync and at least the given percentage of the volumes reconstructed
+// at startup have been verified.
+func (vm *volumeManager) startupVerificationSatisfied(threshold int) bool {
+	if !vm.reconciler.StatesHasBeenSynced() {
+		return false
+	}
+	reconstructed, verified := vm.reconciler.StartupVerificationProgress()
+	if reconstructed == 0 {
+		return true
+	}
+	return verified*100 >= reconstructed*threshold
+}
+
+// runStartupVerificationBarrier keeps the node NotReady from the volumes
+// perspective until the reconstruction pass has completed and the configured
+// percentage of reconstructed volumes has been verified. The barrier lifts
+// unconditionally after its timeout so a stuck volume cannot keep the node
+// NotReady forever.
+func (vm *volumeManager) runStartupVerificationBarrier(stopCh <-chan struct{}) {
+	if !utilfeature.DefaultFeatureGate.Enabled(features.VolumeStartupVerificationBarrier) {
+		return
+	}
+	threshold := startupVerifiedPercent
+	kvh, ok := vm.volumePluginMgr.Host.(volume.KubeletVolumeHost)
+	if !ok {
+		return
+	}
+
+	timeout := startupVerificationTimeout
+	deadline := time.Now().Add(timeout)
+	klog.InfoS("Holding node NotReady until reconstructed volumes are verified", "threshold", threshold, "timeout", timeout)
+	kvh.SetKubeletError(fmt.Errorf("volume manager has not verified %d%% of the volumes reconstructed at startup", threshold))
+
+	for {
+		if vm.startupVerificationSatisfied(threshold) {
+			reconstructed, verified := vm.reconciler.StartupVerificationProgress()
+			klog.InfoS("Startup volume verification barrier lifted", "reconstructedVolumes", reconstructed, "verifiedVolumes", verified)
+			kvh.SetKubeletError(nil)
+			return
+		}
+		if time.Now().After(deadline) {
+			reconstructed, verified := vm.reconciler.StartupVerificationProgress()
+			klog.InfoS("Startup volume verification barrier timed out, reporting node Ready anyway", "reconstructedVolumes", reconstructed, "verifiedVolumes", verified, "timeout", timeout)
+			kvh.SetKubeletError(nil)
+			return
+		}
+		select {
+		case <-stopCh:
+			return
+		case <-time.After(startupVerificationPollInterval):
+		}
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumemanager
+
+import (
+	"context"
+	"encoding/json"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	"k8s.io/kubernetes/pkg/volume"
+	"k8s.io/kubernetes/pkg/volume/util"
+)
+
+const (
+	// DataLocalityAnnotation is set by the kubelet on pods whose volumes are
+	// mounted. It holds a JSON map from pod volume name to the locality of
+	// the data backing the volume, so data-intensive workloads can read it
+	// via the downward API and adjust their behavior.
+	DataLocalityAnnotation = "volumes.kubernetes.io/data-locality"
+
+	// Locality classifications of a mounted volume, derived from the
+	// topology declared by its persistent volume: the data lives on the
+	// node itself, in the same zone, or behind storage without node or zone
+	// topology constraints.
+	localityNodeLocal = "node-local"
+	localityZoneLocal = "zone-local"
+	localityRemote    = "remote"
+)
+
+// updatePodDataLocality publishes the locality of every volume of the pod in
+// the DataLocalityAnnotation. It is called after the volumes of the pod were
+// mounted and is best-effort: a failed update is logged and retried on the
+// next pod sync.
+func (vm *volumeManager) updatePodDataLocality(ctx context.Context, pod *v1.Pod) {
+	if vm.kubeClient == nil {
+		return
+	}
+
+	localities := map[string]string{}
+	uniquePodName := util.GetUniquePodName(pod)
+	for _, volumeToMount := range vm.desiredStateOfWorld.GetVolumesToMount() {
+		if volumeToMount.PodName != uniquePodName {
+			continue
+		}
+		localities[volumeToMount.OuterVolumeSpecName] = volumeDataLocality(volumeToMount.VolumeSpec)
+	}
+	if len(localities) == 0 {
+		return
+	}
+
+	payload, err := json.Marshal(localities)
+	if err != nil {
+		klog.ErrorS(err, "Failed to marshal volume data locality of pod", "pod", klog.KObj(pod))
+		return
+	}
+	if pod.Annotations[DataLocalityAnnotation] == string(payload) {
+		return
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{DataLocalityAnnotation: string(payload)},
+		},
+	})
+	if err != nil {
+		klog.ErrorS(err, "Failed to marshal volume data locality patch of pod", "pod", klog.KObj(pod))
+		return
+	}
+	if _, err := vm.kubeClient.CoreV1().Pods(pod.Namespace).Patch(ctx, pod.Name, k8stypes.StrategicMergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		klog.V(2).InfoS("Failed to update volume data locality annotation of pod, will retry on the next sync", "pod", klog.KObj(pod), "err", err)
+		return
+	}
+	klog.V(4).InfoS("Updated volume data locality annotation of pod", "pod", klog.KObj(pod), "localities", string(payload))
+}
+
+// volumeDataLocality classifies where the data backing the given volume
+// lives relative to the node the volume is mounted on. Because the volume is
+// mounted here, any node affinity of its persistent volume is satisfied by
+// this node, so the strictest topology key of the affinity determines the
+// locality: a hostname constraint means the data is on this node, a zone
+// constraint means it is in this zone, anything weaker means the storage is
+// reachable from everywhere and classified as remote.
+func volumeDataLocality(spec *volume.Spec) string {
+	if spec == nil {
+		return localityRemote
+	}
+	if spec.Volume != nil {
+		// Pod-scoped volumes (emptyDir, configMap, secret, ...) are backed
+		// by the node's own filesystem. Inline persistent sources are rare
+		// and classified conservatively.
+		return localityNodeLocal
+	}
+	pv := spec.PersistentVolume
+	if pv == nil {
+		return localityRemote
+	}
+	if pv.Spec.Local != nil || pv.Spec.HostPath != nil {
+		return localityNodeLocal
+	}
+	if pv.Spec.NodeAffinity == nil || pv.Spec.NodeAffinity.Required == nil {
+		return localityRemote
+	}
+	locality := localityRemote
+	for _, term := range pv.Spec.NodeAffinity.Required.NodeSelectorTerms {
+		for _, expression := range term.MatchExpressions {
+			switch expression.Key {
+			case v1.LabelHostname:
+				return localityNodeLocal
+			case v1.LabelTopologyZone, v1.LabelFailureDomainBetaZone:
+				locality = localityZoneLocal
+			}
+		}
+	}
+	return locality
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumemanager
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubernetes/pkg/volume"
+)
+
+func TestVolumeDataLocality(t *testing.T) {
+	makePV := func(nodeAffinity *v1.VolumeNodeAffinity, source v1.PersistentVolumeSource) *volume.Spec {
+		return volume.NewSpecFromPersistentVolume(&v1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: "pv"},
+			Spec: v1.PersistentVolumeSpec{
+				PersistentVolumeSource: source,
+				NodeAffinity:           nodeAffinity,
+			},
+		}, false)
+	}
+	affinityOn := func(keys ...string) *v1.VolumeNodeAffinity {
+		expressions := []v1.NodeSelectorRequirement{}
+		for _, key := range keys {
+			expressions = append(expressions, v1.NodeSelectorRequirement{
+				Key:      key,
+				Operator: v1.NodeSelectorOpIn,
+				Values:   []string{"value"},
+			})
+		}
+		return &v1.VolumeNodeAffinity{
+			Required: &v1.NodeSelector{
+				NodeSelectorTerms: []v1.NodeSelectorTerm{{MatchExpressions: expressions}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		spec     *volume.Spec
+		expected string
+	}{
+		{
+			name:     "nil spec is remote",
+			expected: localityRemote,
+		},
+		{
+			name: "pod-scoped volume is node-local",
+			spec: volume.NewSpecFromVolume(&v1.Volume{
+				Name:         "scratch",
+				VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}},
+			}),
+			expected: localityNodeLocal,
+		},
+		{
+			name: "local volume source is node-local",
+			spec: makePV(nil, v1.PersistentVolumeSource{
+				Local: &v1.LocalVolumeSource{Path: "/mnt/disks/ssd0"},
+			}),
+			expected: localityNodeLocal,
+		},
+		{
+			name: "hostname affinity is node-local",
+			spec: makePV(affinityOn(v1.LabelHostname), v1.PersistentVolumeSource{
+				CSI: &v1.CSIPersistentVolumeSource{Driver: "local.csi.example.com", VolumeHandle: "vol1"},
+			}),
+			expected: localityNodeLocal,
+		},
+		{
+			name: "zone affinity is zone-local",
+			spec: makePV(affinityOn(v1.LabelTopologyZone), v1.PersistentVolumeSource{
+				CSI: &v1.CSIPersistentVolumeSource{Driver: "ebs.csi.aws.com", VolumeHandle: "vol1"},
+			}),
+			expected: localityZoneLocal,
+		},
+		{
+			name: "hostname wins over zone",
+			spec: makePV(affinityOn(v1.LabelTopologyZone, v1.LabelHostname), v1.PersistentVolumeSource{
+				CSI: &v1.CSIPersistentVolumeSource{Driver: "local.csi.example.com", VolumeHandle: "vol1"},
+			}),
+			expected: localityNodeLocal,
+		},
+		{
+			name: "region-only affinity is remote",
+			spec: makePV(affinityOn(v1.LabelTopologyRegion), v1.PersistentVolumeSource{
+				CSI: &v1.CSIPersistentVolumeSource{Driver: "efs.csi.aws.com", VolumeHandle: "vol1"},
+			}),
+			expected: localityRemote,
+		},
+		{
+			name: "no affinity is remote",
+			spec: makePV(nil, v1.PersistentVolumeSource{
+				NFS: &v1.NFSVolumeSource{Server: "server", Path: "/share"},
+			}),
+			expected: localityRemote,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if locality := volumeDataLocality(test.spec); locality != test.expected {
+				t.Errorf("expected locality %q, got %q", test.expected, locality)
+			}
+		})
+	}
+}
@@ -20,10 +20,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	utilfeature "k8s.io/apiserver/pkg/util/feature"
@@ -46,6 +49,7 @@ import (
 	"k8s.io/kubernetes/pkg/kubelet/volumemanager/populator"
 	"k8s.io/kubernetes/pkg/kubelet/volumemanager/reconciler"
 	"k8s.io/kubernetes/pkg/volume"
+	"k8s.io/kubernetes/pkg/volume/csi"
 	"k8s.io/kubernetes/pkg/volume/csimigration"
 	"k8s.io/kubernetes/pkg/volume/util"
 	"k8s.io/kubernetes/pkg/volume/util/hostutil"
@@ -129,6 +133,18 @@ type VolumeManager interface {
 	// volumes.
 	GetPossiblyMountedVolumesForPod(podName types.UniquePodName) container.VolumeMap
 
+	// GetVolumeMountStatusesForPod returns the volume manager's view of each
+	// volume referenced by the specified pod's containers: whether it is
+	// mounted, whether it is read-only and which SELinux mount context it was
+	// mounted with. Statuses are returned in the order volumes appear in the
+	// pod spec.
+	GetVolumeMountStatusesForPod(pod *v1.Pod) []VolumeMountStatus
+
+	// GetMountDeadlineExceededMessage returns the message of the pod's
+	// expired mount deadline and whether the deadline has expired, so that
+	// status generation can set the VolumeMountDeadlineExceeded condition.
+	GetMountDeadlineExceededMessage(pod *v1.Pod) (string, bool)
+
 	// GetExtraSupplementalGroupsForPod returns a list of the extra
 	// supplemental groups for the Pod. These extra supplemental groups come
 	// from annotations on persistent volumes that the pod depends on.
@@ -148,6 +164,14 @@ type VolumeManager interface {
 	// volume list retrieved from actual state.
 	ReconcilerStatesHasBeenSynced() bool
 
+	// VolumeRecoveryDegraded returns true when more than the configured
+	// number of volumes have remained in uncertain or skipped reconstruction
+	// state for longer than the configured duration, together with how many
+	// such volumes there are. The VolumeRecoveryDegraded node condition is
+	// derived from it so autoscalers and operators can avoid or remediate
+	// the node.
+	VolumeRecoveryDegraded() (bool, int)
+
 	// VolumeIsAttached returns true if the given volume is attached to this
 	// node.
 	VolumeIsAttached(volumeName v1.UniqueVolumeName) bool
@@ -155,6 +179,235 @@ type VolumeManager interface {
 	// Marks the specified volume as having successfully been reported as "in
 	// use" in the nodes's volume status.
 	MarkVolumesAsReportedInUse(volumesReportedAsInUse []v1.UniqueVolumeName)
+
+	// MarkShutdownImminent informs the volume manager that the node is about
+	// to shut down. When the SkipVolumeUnmountOnNodeShutdown feature is
+	// enabled, device unmounts are skipped for volumes whose plugins declare
+	// this to be safe; filesystem buffers are synced to disk instead.
+	MarkShutdownImminent()
+
+	// QuiescePodVolumes freezes the mounted filesystem volumes of the given
+	// pod so that an external snapshot controller can take consistent
+	// snapshots. The volumes are thawed automatically when the safety timeout
+	// elapses without an explicit ThawPodVolumes call. A non-positive timeout
+	// selects the default safety timeout.
+	QuiescePodVolumes(podName types.UniquePodName, timeout time.Duration) error
+
+	// ThawPodVolumes unfreezes the quiesced volumes of the given pod.
+	ThawPodVolumes(podName types.UniquePodName) error
+
+	// GetVolumeConsistencyGroupsForPod returns the mounted volumes of the
+	// given pod grouped by the driver serving them. External group-snapshot
+	// controllers use the groups to snapshot multi-volume applications
+	// consistently.
+	GetVolumeConsistencyGroupsForPod(podName types.UniquePodName) []VolumeConsistencyGroup
+
+	// QuiesceConsistencyGroup freezes the mounted filesystem volumes of the
+	// given pod that are served by the given driver, atomically: on failure,
+	// volumes frozen so far are thawed again. The same safety timeout rules
+	// as for QuiescePodVolumes apply.
+	QuiesceConsistencyGroup(podName types.UniquePodName, driver string, timeout time.Duration) error
+
+	// ThawConsistencyGroup unfreezes the quiesced volumes of the given pod
+	// that are served by the given driver.
+	ThawConsistencyGroup(podName types.UniquePodName, driver string) error
+
+	// GetPodsUsingPVC returns the pods on this node that should mount a
+	// volume backed by the persistent volume claim with the given UID,
+	// according to the desired state of the world. Node-level tooling such as
+	// backup agents uses this to discover the consumers of a claim without
+	// listing all pods.
+	GetPodsUsingPVC(pvcUID k8stypes.UID) []PVCUser
+
+	// GetVolumeInventory returns a schema-versioned snapshot of all pod
+	// volume mounts, globally mounted devices and CSI staging paths the
+	// kubelet believes it owns on this node, according to the actual state
+	// of the world. External auditing tools compare the inventory against
+	// the actual host state to detect drift.
+	GetVolumeInventory() VolumeInventory
+
+	// ExportPodMountState returns a schema-versioned snapshot of the given
+	// pod's volume mount state: device paths, staged paths and CSI publish
+	// contexts. Together with ImportPodMountState it is an experimental
+	// building block for checkpoint/restore-based pod migration.
+	ExportPodMountState(podUID k8stypes.UID) (PodMountState, error)
+
+	// ImportPodMountState validates and records mount state exported from
+	// another node. The imported state is kept for inspection only; acting
+	// on it during pod startup is left to the future migration flow.
+	ImportPodMountState(state PodMountState) error
+
+	// GetImportedPodMountState returns the mount state previously imported
+	// for the given pod, if any.
+	GetImportedPodMountState(podUID k8stypes.UID) (PodMountState, bool)
+
+	// SetStorageMaintenanceMode transitions the node into (or out of)
+	// storage maintenance mode. While enabled, no new mount or attach
+	// operations are started and pods waiting for their volumes fail fast,
+	// while unmounts proceed as usual so that the node drains its
+	// kubelet-owned mounts, e.g. for a storage firmware upgrade.
+	SetStorageMaintenanceMode(enabled bool)
+
+	// GetStorageMaintenanceStatus returns the current storage maintenance
+	// state, including whether the kubelet still owns any mounts.
+	GetStorageMaintenanceStatus() StorageMaintenanceStatus
+}
+
+// VolumeMountStatus reflects the volume manager's view of one volume
+// referenced by a pod's containers.
+type VolumeMountStatus struct {
+	// Name is the OuterVolumeSpecName (i.e. pod.Spec.Volumes[x].Name) of the
+	// volume.
+	Name string
+
+	// Mounted is true if the volume is mounted according to the actual state
+	// of the world.
+	Mounted bool
+
+	// ReadOnly is true if the volume is mounted read-only.
+	ReadOnly bool
+
+	// SELinuxMountContext is the value of the 'mount -o context=XYZ' mount
+	// option the volume was mounted with. It is empty if no SELinux label was
+	// applied.
+	SELinuxMountContext string
+
+	// BlockedOnCircuitBreaker is true when the volume is not mounted and new
+	// mount operations for its volume plugin are currently rejected because
+	// the plugin's mount circuit breaker is open.
+	BlockedOnCircuitBreaker bool
+
+	// RestoreInProgressMessage describes the data source population progress
+	// of the PVC behind an unmounted volume, e.g. while it is restored from a
+	// VolumeSnapshot. It is empty for mounted volumes and for volumes whose
+	// PVC is not being populated.
+	RestoreInProgressMessage string
+}
+
+// VolumeConsistencyGroup describes the mounted volumes of a pod that are
+// served by the same driver and can therefore be snapshotted together as a
+// consistency group.
+type VolumeConsistencyGroup struct {
+	// Driver is the full qualified plugin name serving the volumes of the
+	// group, e.g. "kubernetes.io/csi:ebs.csi.aws.com".
+	Driver string `json:"driver"`
+
+	// VolumeNames are the unique volume names of the group members.
+	VolumeNames []v1.UniqueVolumeName `json:"volumeNames"`
+
+	// Quiesced is true when every volume of the group is currently quiesced.
+	Quiesced bool `json:"quiesced"`
+}
+
+// PVCUser describes one pod on this node that uses a particular persistent
+// volume claim.
+type PVCUser struct {
+	// PodName is the name of the pod.
+	PodName string `json:"podName"`
+
+	// PodNamespace is the namespace of the pod.
+	PodNamespace string `json:"podNamespace"`
+
+	// PodUID is the UID of the pod.
+	PodUID k8stypes.UID `json:"podUID"`
+
+	// VolumeName is the unique name of the volume backed by the claim.
+	VolumeName v1.UniqueVolumeName `json:"volumeName"`
+}
+
+// VolumeInventorySchemaVersion identifies the layout of VolumeInventory. It
+// is bumped whenever fields are removed or change meaning, so that external
+// consumers can detect incompatible changes.
+const VolumeInventorySchemaVersion = "v1"
+
+// VolumeInventory is a point-in-time snapshot of the volume state the kubelet
+// believes it owns on a node. It is served by the /volumeinventory debug
+// endpoint so that external auditing tools can diff it against the mounts and
+// devices actually present on the host.
+type VolumeInventory struct {
+	// SchemaVersion identifies the layout of this structure, see
+	// VolumeInventorySchemaVersion.
+	SchemaVersion string `json:"schemaVersion"`
+
+	// NodeName is the name of the node the inventory was taken on.
+	NodeName string `json:"nodeName"`
+
+	// Mounts are the per-pod volume mounts and block volume mappings,
+	// ordered by pod UID and volume name.
+	Mounts []VolumeInventoryMount `json:"mounts"`
+
+	// Devices are the globally mounted devices backing the pod mounts,
+	// ordered by volume name.
+	Devices []VolumeInventoryDevice `json:"devices"`
+
+	// CSIStagingPaths are the staging directories of the CSI volumes staged
+	// on this node, i.e. the paths passed to NodeStageVolume, in sorted
+	// order.
+	CSIStagingPaths []string `json:"csiStagingPaths"`
+}
+
+// VolumeInventoryMount describes one per-pod volume mount or block volume
+// mapping in the actual state of the world.
+type VolumeInventoryMount struct {
+	// PodUID is the UID of the pod the volume is mounted for.
+	PodUID k8stypes.UID `json:"podUID"`
+
+	// VolumeName is the unique name of the volume.
+	VolumeName v1.UniqueVolumeName `json:"volumeName"`
+
+	// PluginName is the unescaped qualified name of the volume plugin that
+	// mounted the volume, e.g. "kubernetes.io/csi".
+	PluginName string `json:"pluginName"`
+
+	// OuterVolumeSpecName is the pod.Spec.Volumes[x].Name of the volume.
+	OuterVolumeSpecName string `json:"outerVolumeSpecName"`
+
+	// Path is the host path the volume is mounted at (filesystem volumes)
+	// or the device symlink path it is mapped to (block volumes).
+	Path string `json:"path"`
+
+	// Block is true for raw block volume mappings.
+	Block bool `json:"block,omitempty"`
+}
+
+// VolumeInventoryDevice describes one globally mounted device in the actual
+// state of the world.
+type VolumeInventoryDevice struct {
+	// VolumeName is the unique name of the volume backed by the device.
+	VolumeName v1.UniqueVolumeName `json:"volumeName"`
+
+	// PluginName is the unescaped qualified name of the volume plugin that
+	// mounted the device.
+	PluginName string `json:"pluginName"`
+
+	// DevicePath is the path the device is attached at on the node. Empty
+	// for non-attachable volumes.
+	DevicePath string `json:"devicePath,omitempty"`
+
+	// DeviceMountPath is the path the device is globally mounted at. For
+	// CSI volumes this is the staging path.
+	DeviceMountPath string `json:"deviceMountPath"`
+}
+
+// StorageMaintenanceStatus describes the storage maintenance state of the
+// node. It is served by the /storagemaintenance endpoint so that operators
+// can poll it until the node reports it is drained.
+type StorageMaintenanceStatus struct {
+	// Enabled is true while the node is in storage maintenance mode.
+	Enabled bool `json:"enabled"`
+
+	// MountedVolumes is the number of pod volume mounts the kubelet still
+	// owns, according to the actual state of the world.
+	MountedVolumes int `json:"mountedVolumes"`
+
+	// MountedDevices is the number of globally mounted devices the kubelet
+	// still owns, according to the actual state of the world.
+	MountedDevices int `json:"mountedDevices"`
+
+	// Drained is true when maintenance mode is enabled and the kubelet owns
+	// no volume or device mounts anymore, i.e. storage maintenance can
+	// proceed safely.
+	Drained bool `json:"drained"`
 }
 
 // podStateProvider can determine if a pod is going to be terminated
@@ -176,6 +429,9 @@ type PodManager interface {
 // kubeClient - kubeClient is the kube API client used by DesiredStateOfWorldPopulator
 // to communicate with the API server to fetch PV and PVC objects
 //
+// volumeObjectCache - optionally serves PVC and PV lookups from informer
+// caches; may be nil, in which case lookups fall back to direct GETs
+//
 // volumePluginMgr - the volume plugin manager used to access volume plugins.
 // Must be pre-initialized.
 func NewVolumeManager(
@@ -184,6 +440,7 @@ func NewVolumeManager(
 	podManager PodManager,
 	podStateProvider PodStateProvider,
 	kubeClient clientset.Interface,
+	volumeObjectCache *populator.ObjectCache,
 	volumePluginMgr *volume.VolumePluginMgr,
 	kubeContainerRuntime container.Runtime,
 	mounter mount.Interface,
@@ -195,16 +452,26 @@ func NewVolumeManager(
 	seLinuxTranslator := util.NewSELinuxLabelTranslator()
 	vm := &volumeManager{
 		kubeClient:          kubeClient,
+		volumeObjectCache:   volumeObjectCache,
+		nodeName:            nodeName,
 		volumePluginMgr:     volumePluginMgr,
 		desiredStateOfWorld: cache.NewDesiredStateOfWorld(volumePluginMgr, seLinuxTranslator),
 		actualStateOfWorld:  cache.NewActualStateOfWorld(nodeName, volumePluginMgr),
 		operationExecutor: operationexecutor.NewOperationExecutor(operationexecutor.NewOperationGenerator(
 			kubeClient,
 			volumePluginMgr,
-			recorder,
+			newMountFailureAggregatingRecorder(recorder),
 			blockVolumePathHandler)),
 	}
 
+	vm.quiescer = newPodVolumeQuiescer(vm.actualStateOfWorld)
+	vm.deviceMonitor = newDeviceMonitor(vm.actualStateOfWorld, podManager, kubeClient, recorder)
+	vm.remountRestarter = newRemountRestarter(vm.actualStateOfWorld, podManager, kubeContainerRuntime, recorder)
+	vm.mountAuditor = newMountAuditor(podManager, recorder)
+	vm.mountDeadlines = newMountDeadlineTracker(recorder)
+	vm.recoveryDegradedCount = volumeRecoveryDegradedCount
+	vm.recoveryDegradedAfter = volumeRecoveryDegradedAfter
+
 	intreeToCSITranslator := csitrans.New()
 	csiMigratedPluginManager := csimigration.NewPluginManager(intreeToCSITranslator, utilfeature.DefaultFeatureGate)
 
@@ -220,7 +487,8 @@ func NewVolumeManager(
 		kubeContainerRuntime,
 		csiMigratedPluginManager,
 		intreeToCSITranslator,
-		volumePluginMgr)
+		volumePluginMgr,
+		volumeObjectCache)
 	vm.reconciler = reconciler.NewReconciler(
 		kubeClient,
 		controllerAttachDetachEnabled,
@@ -245,6 +513,13 @@ type volumeManager struct {
 	// communicate with the API server to fetch PV and PVC objects
 	kubeClient clientset.Interface
 
+	// volumeObjectCache optionally serves PVC and PV lookups from informer
+	// caches; nil when the feature is disabled.
+	volumeObjectCache *populator.ObjectCache
+
+	// nodeName is the name of the node this volume manager runs on.
+	nodeName k8stypes.NodeName
+
 	// volumePluginMgr is the volume plugin manager used to access volume
 	// plugins. It must be pre-initialized.
 	volumePluginMgr *volume.VolumePluginMgr
@@ -281,11 +556,54 @@ type volumeManager struct {
 
 	// intreeToCSITranslator translates in-tree volume specs to CSI
 	intreeToCSITranslator csimigration.InTreeToCSITranslator
+
+	// quiescer freezes and thaws mounted filesystem volumes of pods for
+	// consistent snapshots.
+	quiescer *podVolumeQuiescer
+
+	// remountRestarter restarts containers of opted-in pods when one of
+	// their volumes is remounted.
+	remountRestarter *remountRestarter
+
+	// deviceMonitor listens for hot-unplug events of block devices backing
+	// mounted volumes and marks affected volumes unhealthy.
+	deviceMonitor *deviceMonitor
+
+	// mountAuditor periodically audits pods for volume mounts that make data
+	// visible across namespace boundaries and reports violations as events.
+	mountAuditor *mountAuditor
+
+	// mountDeadlines enforces the optional per-pod deadline for all of a
+	// pod's volumes to mount.
+	mountDeadlines *mountDeadlineTracker
+
+	// storageMaintenance is set while the node is in storage maintenance
+	// mode; it makes WaitForAttachAndMount fail fast so that no new pods
+	// start with volumes while the node drains its mounts.
+	storageMaintenance atomic.Bool
+
+	// recoveryDegradedCount and recoveryDegradedAfter are the thresholds of
+	// the VolumeRecoveryDegraded node condition. recoveryDegradedSince,
+	// guarded by recoveryDegradedLock, records when the number of
+	// unresolved reconstructed volumes first exceeded the count.
+	recoveryDegradedCount int
+	recoveryDegradedAfter time.Duration
+	recoveryDegradedLock  sync.Mutex
+	recoveryDegradedSince time.Time
+
+	// importedMountStates holds the pod mount states imported from other
+	// nodes, keyed by pod UID. Guarded by importedMountStatesLock.
+	importedMountStatesLock sync.Mutex
+	importedMountStates     map[k8stypes.UID]PodMountState
 }
 
 func (vm *volumeManager) Run(ctx context.Context, sourcesReady config.SourcesReady) {
 	defer runtime.HandleCrash()
 
+	// Probe and log the kernel filesystem capabilities once, so missing
+	// features surface at startup instead of at mount time.
+	hostutil.GetFilesystemCapabilities()
+
 	if vm.kubeClient != nil {
 		// start informer for CSIDriver
 		go vm.volumePluginMgr.Run(ctx.Done())
@@ -297,6 +615,14 @@ func (vm *volumeManager) Run(ctx context.Context, sourcesReady config.SourcesRea
 	klog.InfoS("Starting Kubelet Volume Manager")
 	go vm.reconciler.Run(ctx.Done())
 
+	go vm.runStartupVerificationBarrier(ctx.Done())
+
+	go vm.deviceMonitor.Run(ctx.Done())
+
+	go vm.remountRestarter.Run(ctx.Done())
+
+	go vm.mountAuditor.Run(ctx.Done())
+
 	metrics.Register(vm.actualStateOfWorld, vm.desiredStateOfWorld, vm.volumePluginMgr)
 
 	<-ctx.Done()
@@ -329,6 +655,108 @@ func (vm *volumeManager) GetPossiblyMountedVolumesForPod(podName types.UniquePod
 	return podVolumes
 }
 
+func (vm *volumeManager) GetMountDeadlineExceededMessage(pod *v1.Pod) (string, bool) {
+	if pod == nil {
+		return "", false
+	}
+	return vm.mountDeadlines.exceededMessage(util.GetUniquePodName(pod))
+}
+
+func (vm *volumeManager) GetVolumeMountStatusesForPod(pod *v1.Pod) []VolumeMountStatus {
+	if pod == nil {
+		return nil
+	}
+	podName := util.GetUniquePodName(pod)
+	mountedVolumes := make(map[string]operationexecutor.MountedVolume)
+	for _, mountedVolume := range vm.actualStateOfWorld.GetMountedVolumesForPod(podName) {
+		mountedVolumes[mountedVolume.OuterVolumeSpecName] = mountedVolume.MountedVolume
+	}
+
+	desiredVolumeSpecs := make(map[string]*volume.Spec)
+	for _, volumeToMount := range vm.desiredStateOfWorld.GetVolumesToMount() {
+		if volumeToMount.PodName == podName {
+			desiredVolumeSpecs[volumeToMount.OuterVolumeSpecName] = volumeToMount.VolumeSpec
+		}
+	}
+
+	expectedVolumes := sets.New(getExpectedVolumes(pod)...)
+	statuses := make([]VolumeMountStatus, 0, len(pod.Spec.Volumes))
+	for _, podVolume := range pod.Spec.Volumes {
+		if !expectedVolumes.Has(podVolume.Name) {
+			// The volume is not mounted or mapped by any container.
+			continue
+		}
+		status := VolumeMountStatus{Name: podVolume.Name}
+		if mountedVolume, ok := mountedVolumes[podVolume.Name]; ok {
+			status.Mounted = true
+			status.ReadOnly = mountedVolume.VolumeSpec.ReadOnly
+			status.SELinuxMountContext = mountedVolume.SELinuxMountContext
+		} else {
+			status.BlockedOnCircuitBreaker = vm.isBlockedOnCircuitBreaker(desiredVolumeSpecs[podVolume.Name])
+			status.RestoreInProgressMessage = vm.getRestoreInProgressMessage(pod, podVolume)
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// isBlockedOnCircuitBreaker reports whether mount operations for the volume
+// described by the given desired state spec are currently rejected by the
+// mount circuit breaker of its volume plugin.
+func (vm *volumeManager) isBlockedOnCircuitBreaker(volumeSpec *volume.Spec) bool {
+	if volumeSpec == nil {
+		return false
+	}
+	volumePlugin, err := vm.volumePluginMgr.FindPluginBySpec(volumeSpec)
+	if err != nil || volumePlugin == nil {
+		return false
+	}
+	fullPluginName := util.GetFullQualifiedPluginNameForVolume(volumePlugin.GetPluginName(), volumeSpec)
+	return vm.operationExecutor.IsMountCircuitBreakerOpen(fullPluginName)
+}
+
+// getRestoreInProgressMessage returns a human readable progress message when
+// the PVC behind the given unmounted pod volume is still being populated from
+// a data source (e.g. restored from a VolumeSnapshot). It returns an empty
+// string when no restore is in progress or the progress cannot be determined.
+// The message is computed from the PVC informer cache only; this runs on the
+// pod status generation hot path and must not issue API calls, so without
+// the cache the message is simply omitted.
+func (vm *volumeManager) getRestoreInProgressMessage(pod *v1.Pod, podVolume v1.Volume) string {
+	if vm.volumeObjectCache == nil {
+		return ""
+	}
+	var claimName string
+	switch {
+	case podVolume.PersistentVolumeClaim != nil:
+		claimName = podVolume.PersistentVolumeClaim.ClaimName
+	case podVolume.Ephemeral != nil:
+		claimName = pod.Name + "-" + podVolume.Name
+	default:
+		return ""
+	}
+	pvc, ok := vm.volumeObjectCache.GetPVC(pod.Namespace, claimName)
+	if !ok {
+		klog.V(4).InfoS("Could not get PVC from cache to determine restore progress", "pod", klog.KObj(pod), "volumeName", podVolume.Name)
+		return ""
+	}
+	if pvc.Status.Phase != v1.ClaimPending {
+		return ""
+	}
+	if pvc.Spec.DataSource == nil && pvc.Spec.DataSourceRef == nil {
+		return ""
+	}
+	message := fmt.Sprintf("PVC %s/%s is being populated from its data source", pvc.Namespace, pvc.Name)
+	// Data source populators report their progress through PVC conditions.
+	for _, condition := range pvc.Status.Conditions {
+		if condition.Message == "" {
+			continue
+		}
+		message += fmt.Sprintf("; %s: %s", condition.Type, condition.Message)
+	}
+	return message
+}
+
 func (vm *volumeManager) GetExtraSupplementalGroupsForPod(pod *v1.Pod) []int64 {
 	podName := util.GetUniquePodName(pod)
 	supplementalGroups := sets.New[string]()
@@ -390,6 +818,146 @@ func (vm *volumeManager) MarkVolumesAsReportedInUse(
 	vm.desiredStateOfWorld.MarkVolumesReportedInUse(volumesReportedAsInUse)
 }
 
+func (vm *volumeManager) MarkShutdownImminent() {
+	vm.reconciler.MarkShutdownImminent()
+}
+
+func (vm *volumeManager) QuiescePodVolumes(podName types.UniquePodName, timeout time.Duration) error {
+	return vm.quiescer.QuiescePodVolumes(podName, timeout)
+}
+
+func (vm *volumeManager) ThawPodVolumes(podName types.UniquePodName) error {
+	return vm.quiescer.ThawPodVolumes(podName)
+}
+
+func (vm *volumeManager) GetVolumeConsistencyGroupsForPod(podName types.UniquePodName) []VolumeConsistencyGroup {
+	quiescedVolumes := sets.New[v1.UniqueVolumeName]()
+	for _, mountedVolume := range vm.actualStateOfWorld.GetQuiescedVolumesForPod(podName) {
+		quiescedVolumes.Insert(mountedVolume.VolumeName)
+	}
+
+	groupsByDriver := map[string]*VolumeConsistencyGroup{}
+	for _, mountedVolume := range vm.actualStateOfWorld.GetMountedVolumesForPod(podName) {
+		driver := quiescerVolumeDriver(mountedVolume)
+		group, exists := groupsByDriver[driver]
+		if !exists {
+			group = &VolumeConsistencyGroup{Driver: driver, Quiesced: true}
+			groupsByDriver[driver] = group
+		}
+		group.VolumeNames = append(group.VolumeNames, mountedVolume.VolumeName)
+		if !quiescedVolumes.Has(mountedVolume.VolumeName) {
+			group.Quiesced = false
+		}
+	}
+
+	groups := make([]VolumeConsistencyGroup, 0, len(groupsByDriver))
+	for _, group := range groupsByDriver {
+		sort.Slice(group.VolumeNames, func(i, j int) bool {
+			return group.VolumeNames[i] < group.VolumeNames[j]
+		})
+		groups = append(groups, *group)
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Driver < groups[j].Driver })
+	return groups
+}
+
+func (vm *volumeManager) QuiesceConsistencyGroup(podName types.UniquePodName, driver string, timeout time.Duration) error {
+	return vm.quiescer.QuiesceConsistencyGroup(podName, driver, timeout)
+}
+
+func (vm *volumeManager) ThawConsistencyGroup(podName types.UniquePodName, driver string) error {
+	return vm.quiescer.ThawConsistencyGroup(podName, driver)
+}
+
+func (vm *volumeManager) GetPodsUsingPVC(pvcUID k8stypes.UID) []PVCUser {
+	podsForPVC := vm.desiredStateOfWorld.GetPodsForPVC(pvcUID)
+	users := make([]PVCUser, 0, len(podsForPVC))
+	for _, podForPVC := range podsForPVC {
+		user := PVCUser{
+			PodUID:     k8stypes.UID(podForPVC.PodName),
+			VolumeName: podForPVC.VolumeName,
+		}
+		if podForPVC.Pod != nil {
+			user.PodName = podForPVC.Pod.Name
+			user.PodNamespace = podForPVC.Pod.Namespace
+		}
+		users = append(users, user)
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].PodUID < users[j].PodUID })
+	return users
+}
+
+func (vm *volumeManager) GetVolumeInventory() VolumeInventory {
+	inventory := VolumeInventory{
+		SchemaVersion:   VolumeInventorySchemaVersion,
+		NodeName:        string(vm.nodeName),
+		Mounts:          []VolumeInventoryMount{},
+		Devices:         []VolumeInventoryDevice{},
+		CSIStagingPaths: []string{},
+	}
+
+	for _, mountedVolume := range vm.actualStateOfWorld.GetAllMountedVolumes() {
+		mount := VolumeInventoryMount{
+			PodUID:              mountedVolume.PodUID,
+			VolumeName:          mountedVolume.VolumeName,
+			PluginName:          mountedVolume.PluginName,
+			OuterVolumeSpecName: mountedVolume.OuterVolumeSpecName,
+		}
+		if mountedVolume.Mounter != nil {
+			mount.Path = mountedVolume.Mounter.GetPath()
+		} else if mountedVolume.BlockVolumeMapper != nil {
+			mapPath, linkName := mountedVolume.BlockVolumeMapper.GetPodDeviceMapPath()
+			mount.Path = filepath.Join(mapPath, linkName)
+			mount.Block = true
+		}
+		inventory.Mounts = append(inventory.Mounts, mount)
+	}
+	sort.Slice(inventory.Mounts, func(i, j int) bool {
+		if inventory.Mounts[i].PodUID != inventory.Mounts[j].PodUID {
+			return inventory.Mounts[i].PodUID < inventory.Mounts[j].PodUID
+		}
+		return inventory.Mounts[i].VolumeName < inventory.Mounts[j].VolumeName
+	})
+
+	for _, attachedVolume := range vm.actualStateOfWorld.GetGloballyMountedVolumes() {
+		inventory.Devices = append(inventory.Devices, VolumeInventoryDevice{
+			VolumeName:      attachedVolume.VolumeName,
+			PluginName:      attachedVolume.PluginName,
+			DevicePath:      attachedVolume.DevicePath,
+			DeviceMountPath: attachedVolume.DeviceMountPath,
+		})
+		if attachedVolume.PluginName == csi.CSIPluginName && attachedVolume.DeviceMountPath != "" {
+			inventory.CSIStagingPaths = append(inventory.CSIStagingPaths, attachedVolume.DeviceMountPath)
+		}
+	}
+	sort.Slice(inventory.Devices, func(i, j int) bool {
+		return inventory.Devices[i].VolumeName < inventory.Devices[j].VolumeName
+	})
+	sort.Strings(inventory.CSIStagingPaths)
+
+	return inventory
+}
+
+func (vm *volumeManager) SetStorageMaintenanceMode(enabled bool) {
+	vm.storageMaintenance.Store(enabled)
+	vm.reconciler.SetStorageMaintenanceMode(enabled)
+	if enabled {
+		klog.InfoS("Entered storage maintenance mode, no new volume mounts are started")
+	} else {
+		klog.InfoS("Left storage maintenance mode")
+	}
+}
+
+func (vm *volumeManager) GetStorageMaintenanceStatus() StorageMaintenanceStatus {
+	status := StorageMaintenanceStatus{
+		Enabled:        vm.storageMaintenance.Load(),
+		MountedVolumes: len(vm.actualStateOfWorld.GetAllMountedVolumes()),
+		MountedDevices: len(vm.actualStateOfWorld.GetGloballyMountedVolumes()),
+	}
+	status.Drained = status.Enabled && status.MountedVolumes == 0 && status.MountedDevices == 0
+	return status
+}
+
 func (vm *volumeManager) WaitForAttachAndMount(ctx context.Context, pod *v1.Pod) error {
 	if pod == nil {
 		return nil
@@ -401,6 +969,10 @@ func (vm *volumeManager) WaitForAttachAndMount(ctx context.Context, pod *v1.Pod)
 		return nil
 	}
 
+	if vm.storageMaintenance.Load() {
+		return fmt.Errorf("node is in storage maintenance mode, not mounting volumes for pod %s/%s", pod.Namespace, pod.Name)
+	}
+
 	klog.V(3).InfoS("Waiting for volumes to attach and mount for pod", "pod", klog.KObj(pod))
 	uniquePodName := util.GetUniquePodName(pod)
 
@@ -409,10 +981,28 @@ func (vm *volumeManager) WaitForAttachAndMount(ctx context.Context, pod *v1.Pod)
 	// like Downward API, depend on this to update the contents of the volume).
 	vm.desiredStateOfWorldPopulator.ReprocessPod(uniquePodName)
 
+	// A pod-level mount deadline caps the total time across retries; the
+	// per-attempt timeout shrinks to whatever is left of it.
+	timeout := podAttachAndMountTimeout
+	deadline, hasDeadline, deadlineErr := podMountDeadline(pod)
+	if deadlineErr != nil {
+		klog.ErrorS(deadlineErr, "Ignoring invalid volume mount deadline", "pod", klog.KObj(pod))
+	}
+	if hasDeadline {
+		remaining := vm.mountDeadlines.remaining(uniquePodName, deadline)
+		if remaining <= 0 {
+			return vm.mountDeadlines.handleExpired(pod, uniquePodName, deadline,
+				vm.getUnmountedVolumes(uniquePodName, expectedVolumes))
+		}
+		if remaining < timeout {
+			timeout = remaining
+		}
+	}
+
 	err := wait.PollUntilContextTimeout(
 		ctx,
 		podAttachAndMountRetryInterval,
-		podAttachAndMountTimeout,
+		timeout,
 		true,
 		vm.verifyVolumesMountedFunc(uniquePodName, expectedVolumes))
 
@@ -426,9 +1016,33 @@ func (vm *volumeManager) WaitForAttachAndMount(ctx context.Context, pod *v1.Pod)
 			vm.getVolumesNotInDSW(uniquePodName, expectedVolumes)
 
 		if len(unmountedVolumes) == 0 {
+			vm.mountDeadlines.forget(uniquePodName)
 			return nil
 		}
 
+		// Surface data source population progress of unmounted volumes so
+		// that pods restored from snapshots do not sit in ContainerCreating
+		// without progress information.
+		unmountedSet := sets.New(unmountedVolumes...)
+		restoreMessages := []string{}
+		for _, podVolume := range pod.Spec.Volumes {
+			if !unmountedSet.Has(podVolume.Name) {
+				continue
+			}
+			if message := vm.getRestoreInProgressMessage(pod, podVolume); message != "" {
+				restoreMessages = append(restoreMessages, message)
+			}
+		}
+		if len(restoreMessages) > 0 {
+			return fmt.Errorf(
+				"unmounted volumes=%v, unattached volumes=%v, failed to process volumes=%v: %s: %w",
+				unmountedVolumes,
+				unattachedVolumes,
+				volumesNotInDSW,
+				strings.Join(restoreMessages, "; "),
+				err)
+		}
+
 		return fmt.Errorf(
 			"unmounted volumes=%v, unattached volumes=%v, failed to process volumes=%v: %w",
 			unmountedVolumes,
@@ -437,7 +1051,10 @@ func (vm *volumeManager) WaitForAttachAndMount(ctx context.Context, pod *v1.Pod)
 			err)
 	}
 
+	vm.mountDeadlines.forget(uniquePodName)
 	klog.V(3).InfoS("All volumes are attached and mounted for pod", "pod", klog.KObj(pod))
+	metrics.PodVolumeStartupLatency.RecordVolumesMounted(uniquePodName, pod.Spec.PriorityClassName)
+	vm.updatePodDataLocality(ctx, pod)
 	return nil
 }
 
@@ -449,6 +1066,11 @@ func (vm *volumeManager) WaitForUnmount(ctx context.Context, pod *v1.Pod) error
 	klog.V(3).InfoS("Waiting for volumes to unmount for pod", "pod", klog.KObj(pod))
 	uniquePodName := util.GetUniquePodName(pod)
 
+	// The pod is tearing down; its mount deadline no longer applies and any
+	// imported mount state will never be consumed.
+	vm.mountDeadlines.forget(uniquePodName)
+	vm.forgetImportedPodMountState(pod.UID)
+
 	vm.desiredStateOfWorldPopulator.ReprocessPod(uniquePodName)
 
 	err := wait.PollUntilContextTimeout(
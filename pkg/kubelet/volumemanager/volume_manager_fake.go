@@ -21,6 +21,7 @@ import (
 	"time"
 
 	v1 "k8s.io/api/core/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/kubernetes/pkg/kubelet/config"
 	"k8s.io/kubernetes/pkg/kubelet/container"
 	"k8s.io/kubernetes/pkg/volume/util/types"
@@ -83,6 +84,16 @@ func (f *FakeVolumeManager) GetPossiblyMountedVolumesForPod(podName types.Unique
 	return nil
 }
 
+// GetMountDeadlineExceededMessage is not implemented
+func (f *FakeVolumeManager) GetMountDeadlineExceededMessage(pod *v1.Pod) (string, bool) {
+	return "", false
+}
+
+// GetVolumeMountStatusesForPod is not implemented
+func (f *FakeVolumeManager) GetVolumeMountStatusesForPod(pod *v1.Pod) []VolumeMountStatus {
+	return nil
+}
+
 // GetExtraSupplementalGroupsForPod is not implemented
 func (f *FakeVolumeManager) GetExtraSupplementalGroupsForPod(pod *v1.Pod) []int64 {
 	return nil
@@ -102,6 +113,11 @@ func (f *FakeVolumeManager) ReconcilerStatesHasBeenSynced() bool {
 	return true
 }
 
+// VolumeRecoveryDegraded is not implemented
+func (f *FakeVolumeManager) VolumeRecoveryDegraded() (bool, int) {
+	return false, 0
+}
+
 // VolumeIsAttached is not implemented
 func (f *FakeVolumeManager) VolumeIsAttached(volumeName v1.UniqueVolumeName) bool {
 	return false
@@ -116,6 +132,69 @@ func (f *FakeVolumeManager) MarkVolumesAsReportedInUse(volumesReportedAsInUse []
 	}
 }
 
+// MarkShutdownImminent is not implemented
+func (f *FakeVolumeManager) MarkShutdownImminent() {
+}
+
+// QuiescePodVolumes is not implemented
+func (f *FakeVolumeManager) QuiescePodVolumes(podName types.UniquePodName, timeout time.Duration) error {
+	return nil
+}
+
+// ThawPodVolumes is not implemented
+func (f *FakeVolumeManager) ThawPodVolumes(podName types.UniquePodName) error {
+	return nil
+}
+
+// GetVolumeConsistencyGroupsForPod is not implemented
+func (f *FakeVolumeManager) GetVolumeConsistencyGroupsForPod(podName types.UniquePodName) []VolumeConsistencyGroup {
+	return nil
+}
+
+// QuiesceConsistencyGroup is not implemented
+func (f *FakeVolumeManager) QuiesceConsistencyGroup(podName types.UniquePodName, driver string, timeout time.Duration) error {
+	return nil
+}
+
+// ThawConsistencyGroup is not implemented
+func (f *FakeVolumeManager) ThawConsistencyGroup(podName types.UniquePodName, driver string) error {
+	return nil
+}
+
+// GetPodsUsingPVC is not implemented
+func (f *FakeVolumeManager) GetPodsUsingPVC(pvcUID k8stypes.UID) []PVCUser {
+	return nil
+}
+
+// GetVolumeInventory returns an empty inventory
+func (f *FakeVolumeManager) GetVolumeInventory() VolumeInventory {
+	return VolumeInventory{SchemaVersion: VolumeInventorySchemaVersion}
+}
+
+// ExportPodMountState is not implemented
+func (f *FakeVolumeManager) ExportPodMountState(podUID k8stypes.UID) (PodMountState, error) {
+	return PodMountState{SchemaVersion: PodMountStateSchemaVersion}, nil
+}
+
+// ImportPodMountState is not implemented
+func (f *FakeVolumeManager) ImportPodMountState(state PodMountState) error {
+	return nil
+}
+
+// GetImportedPodMountState is not implemented
+func (f *FakeVolumeManager) GetImportedPodMountState(podUID k8stypes.UID) (PodMountState, bool) {
+	return PodMountState{}, false
+}
+
+// SetStorageMaintenanceMode is not implemented
+func (f *FakeVolumeManager) SetStorageMaintenanceMode(enabled bool) {
+}
+
+// GetStorageMaintenanceStatus returns an empty status
+func (f *FakeVolumeManager) GetStorageMaintenanceStatus() StorageMaintenanceStatus {
+	return StorageMaintenanceStatus{}
+}
+
 // GetVolumesReportedInUse is a test function only that returns a list of volumes
 // from the reportedInUse map
 func (f *FakeVolumeManager) GetVolumesReportedInUse() []v1.UniqueVolumeName {
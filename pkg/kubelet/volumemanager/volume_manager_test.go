@@ -144,6 +144,111 @@ func TestGetMountedVolumesForPodAndGetVolumesInUse(t *testing.T) {
 	}
 }
 
+func TestGetVolumeInventory(t *testing.T) {
+	tmpDir, err := utiltesting.MkTmpdir("volumeManagerTest")
+	if err != nil {
+		t.Fatalf("can't make a temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+	podManager := kubepod.NewBasicPodManager()
+
+	node, pod, pv, claim := createObjects(v1.PersistentVolumeFilesystem, v1.PersistentVolumeFilesystem)
+	kubeClient := fake.NewSimpleClientset(node, pod, pv, claim)
+
+	manager := newTestVolumeManager(t, tmpDir, podManager, kubeClient, node)
+
+	inventory := manager.GetVolumeInventory()
+	if inventory.SchemaVersion != VolumeInventorySchemaVersion {
+		t.Errorf("Expected schema version %q, got %q", VolumeInventorySchemaVersion, inventory.SchemaVersion)
+	}
+	if inventory.NodeName != testHostname {
+		t.Errorf("Expected node name %q, got %q", testHostname, inventory.NodeName)
+	}
+	if len(inventory.Mounts) != 0 || len(inventory.Devices) != 0 || len(inventory.CSIStagingPaths) != 0 {
+		t.Errorf("Expected empty inventory before any volume was mounted, got %+v", inventory)
+	}
+
+	tCtx := ktesting.Init(t)
+	defer tCtx.Cancel("test has completed")
+	sourcesReady := config.NewSourcesReady(func(_ sets.Set[string]) bool { return true })
+	go manager.Run(tCtx, sourcesReady)
+
+	podManager.SetPods([]*v1.Pod{pod})
+	go simulateVolumeInUseUpdate(
+		v1.UniqueVolumeName(node.Status.VolumesAttached[0].Name),
+		tCtx.Done(),
+		manager)
+
+	if err := manager.WaitForAttachAndMount(context.Background(), pod); err != nil {
+		t.Fatalf("Expected success: %v", err)
+	}
+
+	inventory = manager.GetVolumeInventory()
+	if len(inventory.Mounts) != 1 {
+		t.Fatalf("Expected one mount in the inventory, got %+v", inventory.Mounts)
+	}
+	mount := inventory.Mounts[0]
+	if mount.PodUID != pod.UID {
+		t.Errorf("Expected mount for pod %q, got %+v", pod.UID, mount)
+	}
+	if mount.OuterVolumeSpecName != pod.Spec.Volumes[0].Name {
+		t.Errorf("Expected outer volume spec name %q, got %+v", pod.Spec.Volumes[0].Name, mount)
+	}
+	if mount.PluginName != "fake" || mount.Path == "" || mount.Block {
+		t.Errorf("Expected a filesystem mount by the fake plugin with a path, got %+v", mount)
+	}
+	if len(inventory.Devices) != 1 {
+		t.Fatalf("Expected one device in the inventory, got %+v", inventory.Devices)
+	}
+	if inventory.Devices[0].VolumeName != mount.VolumeName {
+		t.Errorf("Expected device for volume %q, got %+v", mount.VolumeName, inventory.Devices[0])
+	}
+	if len(inventory.CSIStagingPaths) != 0 {
+		t.Errorf("Expected no CSI staging paths for the fake plugin, got %v", inventory.CSIStagingPaths)
+	}
+}
+
+func TestStorageMaintenanceMode(t *testing.T) {
+	tmpDir, err := utiltesting.MkTmpdir("volumeManagerTest")
+	if err != nil {
+		t.Fatalf("can't make a temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+	podManager := kubepod.NewBasicPodManager()
+
+	node, pod, pv, claim := createObjects(v1.PersistentVolumeFilesystem, v1.PersistentVolumeFilesystem)
+	kubeClient := fake.NewSimpleClientset(node, pod, pv, claim)
+
+	manager := newTestVolumeManager(t, tmpDir, podManager, kubeClient, node)
+
+	status := manager.GetStorageMaintenanceStatus()
+	if status.Enabled || status.Drained {
+		t.Errorf("Expected maintenance mode to be off initially, got %+v", status)
+	}
+
+	manager.SetStorageMaintenanceMode(true)
+	status = manager.GetStorageMaintenanceStatus()
+	if !status.Enabled {
+		t.Errorf("Expected maintenance mode to be on, got %+v", status)
+	}
+	if !status.Drained {
+		t.Errorf("Expected the node to report drained with no mounts, got %+v", status)
+	}
+
+	// New mounts must be refused while maintenance mode is on.
+	podManager.SetPods([]*v1.Pod{pod})
+	err = manager.WaitForAttachAndMount(context.Background(), pod)
+	if err == nil || !strings.Contains(err.Error(), "storage maintenance mode") {
+		t.Errorf("Expected a storage maintenance mode error, got: %v", err)
+	}
+
+	manager.SetStorageMaintenanceMode(false)
+	status = manager.GetStorageMaintenanceStatus()
+	if status.Enabled || status.Drained {
+		t.Errorf("Expected maintenance mode to be off again, got %+v", status)
+	}
+}
+
 func TestWaitForAttachAndMountError(t *testing.T) {
 	tmpDir, err := utiltesting.MkTmpdir("volumeManagerTest")
 	if err != nil {
@@ -422,6 +527,7 @@ func newTestVolumeManager(t *testing.T, tmpDir string, podManager kubepod.Manage
 		podManager,
 		stateProvider,
 		kubeClient,
+		nil, /* volumeObjectCache */
 		plugMgr,
 		&containertest.FakeRuntime{},
 		mount.NewFakeMounter(nil),
@@ -612,3 +718,82 @@ func TestWaitForAllPodsUnmount(t *testing.T) {
 		})
 	}
 }
+
+func TestPodMountStateExportImport(t *testing.T) {
+	tmpDir, err := utiltesting.MkTmpdir("volumeManagerTest")
+	if err != nil {
+		t.Fatalf("can't make a temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+	podManager := kubepod.NewBasicPodManager()
+
+	node, pod, pv, claim := createObjects(v1.PersistentVolumeFilesystem, v1.PersistentVolumeFilesystem)
+	kubeClient := fake.NewSimpleClientset(node, pod, pv, claim)
+
+	manager := newTestVolumeManager(t, tmpDir, podManager, kubeClient, node)
+
+	if _, err := manager.ExportPodMountState(pod.UID); err == nil {
+		t.Errorf("Expected an error exporting state of a pod without mounted volumes")
+	}
+
+	tCtx := ktesting.Init(t)
+	defer tCtx.Cancel("test has completed")
+	sourcesReady := config.NewSourcesReady(func(_ sets.Set[string]) bool { return true })
+	go manager.Run(tCtx, sourcesReady)
+
+	podManager.SetPods([]*v1.Pod{pod})
+	go simulateVolumeInUseUpdate(
+		v1.UniqueVolumeName(node.Status.VolumesAttached[0].Name),
+		tCtx.Done(),
+		manager)
+
+	if err := manager.WaitForAttachAndMount(context.Background(), pod); err != nil {
+		t.Fatalf("Expected success: %v", err)
+	}
+
+	state, err := manager.ExportPodMountState(pod.UID)
+	if err != nil {
+		t.Fatalf("Expected success: %v", err)
+	}
+	if state.SchemaVersion != PodMountStateSchemaVersion {
+		t.Errorf("Expected schema version %q, got %q", PodMountStateSchemaVersion, state.SchemaVersion)
+	}
+	if state.NodeName != testHostname || state.PodUID != pod.UID {
+		t.Errorf("Expected state for pod %q on node %q, got %+v", pod.UID, testHostname, state)
+	}
+	if len(state.Volumes) != 1 {
+		t.Fatalf("Expected one volume in the state, got %+v", state.Volumes)
+	}
+	stateVolume := state.Volumes[0]
+	if stateVolume.OuterVolumeSpecName != pod.Spec.Volumes[0].Name {
+		t.Errorf("Expected outer volume spec name %q, got %+v", pod.Spec.Volumes[0].Name, stateVolume)
+	}
+	if stateVolume.PluginName != "fake" || stateVolume.MountPath == "" || stateVolume.Block {
+		t.Errorf("Expected a filesystem mount by the fake plugin with a path, got %+v", stateVolume)
+	}
+
+	// The state was exported from this node, so it must be rejected.
+	if err := manager.ImportPodMountState(state); err == nil {
+		t.Errorf("Expected an error importing state exported from the same node")
+	}
+	state.NodeName = "other-node"
+	// The volume is already mounted on this node, so it must be rejected.
+	if err := manager.ImportPodMountState(state); err == nil {
+		t.Errorf("Expected an error importing state of a volume this node already mounted")
+	}
+	state.Volumes[0].VolumeName = "fake-plugin/other-volume"
+	if err := manager.ImportPodMountState(state); err != nil {
+		t.Errorf("Expected success: %v", err)
+	}
+	if err := manager.ImportPodMountState(PodMountState{SchemaVersion: "v0", NodeName: "other-node", PodUID: "uid"}); err == nil {
+		t.Errorf("Expected an error importing state with an unsupported schema version")
+	}
+
+	imported, exists := manager.GetImportedPodMountState(state.PodUID)
+	if !exists || imported.NodeName != "other-node" {
+		t.Errorf("Expected to get back the imported state, got %+v (exists=%v)", imported, exists)
+	}
+	if _, exists := manager.GetImportedPodMountState("unknown-uid"); exists {
+		t.Errorf("Expected no imported state for an unknown pod")
+	}
+}
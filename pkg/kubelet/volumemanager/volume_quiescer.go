@@ -0,0 +1,218 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumemanager
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/kubelet/volumemanager/cache"
+	"k8s.io/kubernetes/pkg/volume/util"
+	"k8s.io/kubernetes/pkg/volume/util/types"
+	utilexec "k8s.io/utils/exec"
+)
+
+const (
+	// defaultQuiesceTimeout is the safety timeout used when the caller does
+	// not specify one. A quiesced volume is thawed automatically after the
+	// timeout so that a crashed snapshot controller cannot leave filesystems
+	// frozen indefinitely.
+	defaultQuiesceTimeout = 30 * time.Second
+
+	// maxQuiesceTimeout caps the safety timeout a caller may request.
+	maxQuiesceTimeout = 5 * time.Minute
+
+	fsfreezeCmd = "fsfreeze"
+)
+
+// podVolumeQuiescer freezes and thaws the mounted filesystem volumes of a pod
+// so that an external snapshot controller can take consistent snapshots. The
+// quiesced state is tracked per volume in the actual state of the world and a
+// safety timer thaws the volumes when no explicit thaw arrives in time.
+// Volumes can be quiesced either all at once or per consistency group, i.e.
+// all volumes of the pod served by the same driver.
+type podVolumeQuiescer struct {
+	exec               utilexec.Interface
+	actualStateOfWorld cache.ActualStateOfWorld
+
+	sync.Mutex
+	// thawTimers holds the safety timers of quiesce operations, keyed by pod
+	// and the driver of the quiesced consistency group. An empty driver is
+	// used for whole-pod quiesce operations.
+	thawTimers map[quiesceKey]*time.Timer
+}
+
+// quiesceKey identifies one quiesce operation: all volumes of a pod, or the
+// pod's consistency group of one driver.
+type quiesceKey struct {
+	podName types.UniquePodName
+	driver  string
+}
+
+func newPodVolumeQuiescer(actualStateOfWorld cache.ActualStateOfWorld) *podVolumeQuiescer {
+	return &podVolumeQuiescer{
+		exec:               utilexec.New(),
+		actualStateOfWorld: actualStateOfWorld,
+		thawTimers:         make(map[quiesceKey]*time.Timer),
+	}
+}
+
+// quiescerVolumeDriver returns the driver a mounted volume belongs to for
+// consistency-group purposes: the full qualified plugin name, which includes
+// the CSI driver name for CSI volumes.
+func quiescerVolumeDriver(mountedVolume cache.MountedVolume) string {
+	return util.GetFullQualifiedPluginNameForVolume(mountedVolume.PluginName, mountedVolume.VolumeSpec)
+}
+
+// QuiescePodVolumes freezes all mounted filesystem volumes of the pod. On
+// failure, volumes frozen so far are thawed again and an error is returned.
+func (q *podVolumeQuiescer) QuiescePodVolumes(podName types.UniquePodName, timeout time.Duration) error {
+	return q.quiesce(podName, "" /* driver */, timeout)
+}
+
+// QuiesceConsistencyGroup freezes the mounted filesystem volumes of the pod
+// that are served by the given driver, so that an external group-snapshot
+// controller can snapshot a multi-volume application consistently. An error
+// is returned when the pod has no mounted volumes of the driver, or when a
+// volume of the group cannot be frozen; in the latter case volumes frozen so
+// far are thawed again.
+func (q *podVolumeQuiescer) QuiesceConsistencyGroup(podName types.UniquePodName, driver string, timeout time.Duration) error {
+	if driver == "" {
+		return fmt.Errorf("consistency group driver must not be empty")
+	}
+	return q.quiesce(podName, driver, timeout)
+}
+
+func (q *podVolumeQuiescer) quiesce(podName types.UniquePodName, driver string, timeout time.Duration) error {
+	if timeout <= 0 {
+		timeout = defaultQuiesceTimeout
+	}
+	if timeout > maxQuiesceTimeout {
+		timeout = maxQuiesceTimeout
+	}
+
+	q.Lock()
+	defer q.Unlock()
+
+	mountedVolumes := q.actualStateOfWorld.GetMountedVolumesForPod(podName)
+	quiesced := []cache.MountedVolume{}
+	for _, mountedVolume := range mountedVolumes {
+		if mountedVolume.Mounter == nil {
+			// Block volumes have no filesystem to freeze.
+			continue
+		}
+		if driver != "" && quiescerVolumeDriver(mountedVolume) != driver {
+			continue
+		}
+		path := mountedVolume.Mounter.GetPath()
+		if output, err := q.exec.Command(fsfreezeCmd, "--freeze", path).CombinedOutput(); err != nil {
+			q.thawVolumesLocked(podName, quiesced)
+			return fmt.Errorf("failed to freeze volume %q at %q: %v, output: %q", mountedVolume.VolumeName, path, err, string(output))
+		}
+		if err := q.actualStateOfWorld.MarkVolumeAsQuiesced(podName, mountedVolume.VolumeName, timeout); err != nil {
+			q.thawVolumesLocked(podName, quiesced)
+			// The volume itself is still frozen, thaw it as well.
+			q.thawVolumesLocked(podName, []cache.MountedVolume{mountedVolume})
+			return fmt.Errorf("failed to mark volume %q as quiesced: %v", mountedVolume.VolumeName, err)
+		}
+		quiesced = append(quiesced, mountedVolume)
+	}
+
+	if len(quiesced) == 0 {
+		if driver != "" {
+			return fmt.Errorf("pod %q has no mounted filesystem volumes of driver %q", podName, driver)
+		}
+		return nil
+	}
+
+	// Replace any previous safety timer for this quiesce operation.
+	key := quiesceKey{podName: podName, driver: driver}
+	if timer, exists := q.thawTimers[key]; exists {
+		timer.Stop()
+	}
+	q.thawTimers[key] = time.AfterFunc(timeout, func() {
+		klog.InfoS("Quiesce safety timeout elapsed, thawing pod volumes", "podName", podName, "driver", driver, "timeout", timeout)
+		if err := q.thaw(podName, driver); err != nil {
+			klog.ErrorS(err, "Failed to thaw pod volumes after safety timeout", "podName", podName, "driver", driver)
+		}
+	})
+	klog.V(2).InfoS("Quiesced pod volumes", "podName", podName, "driver", driver, "volumeCount", len(quiesced), "timeout", timeout)
+	return nil
+}
+
+// ThawPodVolumes unfreezes all quiesced volumes of the pod. Volumes whose
+// safety timeout already elapsed are thawed opportunistically as well because
+// fsfreeze --unfreeze of an unfrozen filesystem only returns an error that is
+// safe to ignore.
+func (q *podVolumeQuiescer) ThawPodVolumes(podName types.UniquePodName) error {
+	return q.thaw(podName, "" /* driver */)
+}
+
+// ThawConsistencyGroup unfreezes the quiesced volumes of the pod that are
+// served by the given driver.
+func (q *podVolumeQuiescer) ThawConsistencyGroup(podName types.UniquePodName, driver string) error {
+	if driver == "" {
+		return fmt.Errorf("consistency group driver must not be empty")
+	}
+	return q.thaw(podName, driver)
+}
+
+func (q *podVolumeQuiescer) thaw(podName types.UniquePodName, driver string) error {
+	q.Lock()
+	defer q.Unlock()
+
+	for key, timer := range q.thawTimers {
+		if key.podName != podName {
+			continue
+		}
+		// A whole-pod thaw cancels group timers as well.
+		if driver == "" || key.driver == driver {
+			timer.Stop()
+			delete(q.thawTimers, key)
+		}
+	}
+
+	quiescedVolumes := []cache.MountedVolume{}
+	for _, mountedVolume := range q.actualStateOfWorld.GetQuiescedVolumesForPod(podName) {
+		if driver != "" && quiescerVolumeDriver(mountedVolume) != driver {
+			continue
+		}
+		quiescedVolumes = append(quiescedVolumes, mountedVolume)
+	}
+	return q.thawVolumesLocked(podName, quiescedVolumes)
+}
+
+func (q *podVolumeQuiescer) thawVolumesLocked(podName types.UniquePodName, volumes []cache.MountedVolume) error {
+	var errs []error
+	for _, mountedVolume := range volumes {
+		if mountedVolume.Mounter == nil {
+			continue
+		}
+		path := mountedVolume.Mounter.GetPath()
+		if output, err := q.exec.Command(fsfreezeCmd, "--unfreeze", path).CombinedOutput(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to thaw volume %q at %q: %v, output: %q", mountedVolume.VolumeName, path, err, string(output)))
+			continue
+		}
+		q.actualStateOfWorld.MarkVolumeAsThawed(podName, mountedVolume.VolumeName)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to thaw %d volume(s) of pod %q: %v", len(errs), podName, errs)
+	}
+	return nil
+}
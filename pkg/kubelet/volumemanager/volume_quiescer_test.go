@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumemanager
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2/ktesting"
+	"k8s.io/kubernetes/pkg/kubelet/volumemanager/cache"
+	"k8s.io/kubernetes/pkg/volume"
+	volumetesting "k8s.io/kubernetes/pkg/volume/testing"
+	"k8s.io/kubernetes/pkg/volume/util"
+	"k8s.io/kubernetes/pkg/volume/util/operationexecutor"
+	utilexec "k8s.io/utils/exec"
+	testingexec "k8s.io/utils/exec/testing"
+)
+
+// newQuiescerWithMountedVolume returns a quiescer backed by an actual state
+// of the world with one mounted volume for one pod, along with the pod's
+// unique name. The quiescer uses the given fake exec.
+func newQuiescerWithMountedVolume(t *testing.T, fakeExec *testingexec.FakeExec) (*podVolumeQuiescer, cache.ActualStateOfWorld, *v1.Pod) {
+	volumePluginMgr, plugin := volumetesting.GetTestKubeletVolumePluginMgr(t)
+	asw := cache.NewActualStateOfWorld("mynode", volumePluginMgr)
+
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "pod1",
+			UID:  "pod1uid",
+		},
+		Spec: v1.PodSpec{
+			Volumes: []v1.Volume{
+				{
+					Name: "volume-name",
+					VolumeSource: v1.VolumeSource{
+						GCEPersistentDisk: &v1.GCEPersistentDiskVolumeSource{
+							PDName: "fake-device1",
+						},
+					},
+				},
+			},
+		},
+	}
+	volumeSpec := &volume.Spec{Volume: &pod.Spec.Volumes[0]}
+	generatedVolumeName, err := util.GetUniqueVolumeNameFromSpec(plugin, volumeSpec)
+	if err != nil {
+		t.Fatalf("GetUniqueVolumeNameFromSpec failed: %v", err)
+	}
+	logger, _ := ktesting.NewTestContext(t)
+	if err := asw.MarkVolumeAsAttached(logger, generatedVolumeName, volumeSpec, "", "fake/device/path"); err != nil {
+		t.Fatalf("MarkVolumeAsAttached failed: %v", err)
+	}
+	mounter, err := plugin.NewMounter(volumeSpec, pod)
+	if err != nil {
+		t.Fatalf("NewMounter failed: %v", err)
+	}
+	err = asw.AddPodToVolume(operationexecutor.MarkVolumeOpts{
+		PodName:             util.GetUniquePodName(pod),
+		PodUID:              pod.UID,
+		VolumeName:          generatedVolumeName,
+		Mounter:             mounter,
+		OuterVolumeSpecName: volumeSpec.Name(),
+		VolumeSpec:          volumeSpec,
+		VolumeMountState:    operationexecutor.VolumeMounted,
+	})
+	if err != nil {
+		t.Fatalf("AddPodToVolume failed: %v", err)
+	}
+
+	quiescer := newPodVolumeQuiescer(asw)
+	quiescer.exec = fakeExec
+	return quiescer, asw, pod
+}
+
+func addFakeCommand(fakeExec *testingexec.FakeExec, err error) {
+	fakeCmd := &testingexec.FakeCmd{}
+	fakeCmd.CombinedOutputScript = append(fakeCmd.CombinedOutputScript,
+		func() ([]byte, []byte, error) { return []byte{}, nil, err })
+	fakeExec.CommandScript = append(fakeExec.CommandScript,
+		func(cmd string, args ...string) utilexec.Cmd {
+			return testingexec.InitFakeCmd(fakeCmd, cmd, args...)
+		})
+}
+
+func TestQuiesceAndThawPodVolumes(t *testing.T) {
+	fakeExec := &testingexec.FakeExec{ExactOrder: true}
+	addFakeCommand(fakeExec, nil) // fsfreeze --freeze
+	addFakeCommand(fakeExec, nil) // fsfreeze --unfreeze
+	quiescer, asw, pod := newQuiescerWithMountedVolume(t, fakeExec)
+	podName := util.GetUniquePodName(pod)
+
+	if err := quiescer.QuiescePodVolumes(podName, time.Minute); err != nil {
+		t.Fatalf("QuiescePodVolumes failed: %v", err)
+	}
+	if quiesced := asw.GetQuiescedVolumesForPod(podName); len(quiesced) != 1 {
+		t.Fatalf("expected 1 quiesced volume, got %d", len(quiesced))
+	}
+
+	if err := quiescer.ThawPodVolumes(podName); err != nil {
+		t.Fatalf("ThawPodVolumes failed: %v", err)
+	}
+	if quiesced := asw.GetQuiescedVolumesForPod(podName); len(quiesced) != 0 {
+		t.Fatalf("expected no quiesced volumes after thaw, got %d", len(quiesced))
+	}
+	if fakeExec.CommandCalls != 2 {
+		t.Errorf("expected 2 fsfreeze invocations, got %d", fakeExec.CommandCalls)
+	}
+}
+
+func TestQuiescePodVolumesFreezeFailure(t *testing.T) {
+	fakeExec := &testingexec.FakeExec{ExactOrder: true}
+	addFakeCommand(fakeExec, fmt.Errorf("freeze failed"))
+	quiescer, asw, pod := newQuiescerWithMountedVolume(t, fakeExec)
+	podName := util.GetUniquePodName(pod)
+
+	if err := quiescer.QuiescePodVolumes(podName, time.Minute); err == nil {
+		t.Fatal("expected error from failing fsfreeze")
+	}
+	if quiesced := asw.GetQuiescedVolumesForPod(podName); len(quiesced) != 0 {
+		t.Fatalf("expected no quiesced volumes after failure, got %d", len(quiesced))
+	}
+}
+
+func TestQuiescePodVolumesSafetyTimeout(t *testing.T) {
+	fakeExec := &testingexec.FakeExec{ExactOrder: true}
+	addFakeCommand(fakeExec, nil) // fsfreeze --freeze
+	addFakeCommand(fakeExec, nil) // fsfreeze --unfreeze by the safety timer
+	quiescer, asw, pod := newQuiescerWithMountedVolume(t, fakeExec)
+	podName := util.GetUniquePodName(pod)
+
+	if err := quiescer.QuiescePodVolumes(podName, 10*time.Millisecond); err != nil {
+		t.Fatalf("QuiescePodVolumes failed: %v", err)
+	}
+	err := wait.PollImmediate(10*time.Millisecond, 10*time.Second, func() (bool, error) {
+		return len(asw.GetQuiescedVolumesForPod(podName)) == 0, nil
+	})
+	if err != nil {
+		t.Fatalf("expected volumes to be thawed by the safety timer: %v", err)
+	}
+}
+
+func TestQuiesceAndThawConsistencyGroup(t *testing.T) {
+	fakeExec := &testingexec.FakeExec{ExactOrder: true}
+	addFakeCommand(fakeExec, nil) // fsfreeze --freeze
+	addFakeCommand(fakeExec, nil) // fsfreeze --unfreeze
+	quiescer, asw, pod := newQuiescerWithMountedVolume(t, fakeExec)
+	podName := util.GetUniquePodName(pod)
+	driver := quiescerVolumeDriver(asw.GetMountedVolumesForPod(podName)[0])
+
+	if err := quiescer.QuiesceConsistencyGroup(podName, "other-driver", time.Minute); err == nil {
+		t.Fatal("expected error for consistency group of unknown driver")
+	}
+	if err := quiescer.QuiesceConsistencyGroup(podName, driver, time.Minute); err != nil {
+		t.Fatalf("QuiesceConsistencyGroup failed: %v", err)
+	}
+	if quiesced := asw.GetQuiescedVolumesForPod(podName); len(quiesced) != 1 {
+		t.Fatalf("expected 1 quiesced volume, got %d", len(quiesced))
+	}
+
+	// Thawing a different driver's group leaves the volume quiesced.
+	if err := quiescer.ThawConsistencyGroup(podName, "other-driver"); err != nil {
+		t.Fatalf("ThawConsistencyGroup failed: %v", err)
+	}
+	if quiesced := asw.GetQuiescedVolumesForPod(podName); len(quiesced) != 1 {
+		t.Fatalf("expected volume to stay quiesced, got %d quiesced volumes", len(quiesced))
+	}
+
+	if err := quiescer.ThawConsistencyGroup(podName, driver); err != nil {
+		t.Fatalf("ThawConsistencyGroup failed: %v", err)
+	}
+	if quiesced := asw.GetQuiescedVolumesForPod(podName); len(quiesced) != 0 {
+		t.Fatalf("expected no quiesced volumes after thaw, got %d", len(quiesced))
+	}
+}
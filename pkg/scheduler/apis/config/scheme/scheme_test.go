@@ -722,6 +722,7 @@ profiles:
     name: NodeResourcesFit
   - args:
       apiVersion: kubescheduler.config.k8s.io/v1
+      attachedVolumesWeight: 0
       bindTimeoutSeconds: 300
       kind: VolumeBindingArgs
     name: VolumeBinding
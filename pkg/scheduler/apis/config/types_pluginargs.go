@@ -166,6 +166,14 @@ type VolumeBindingArgs struct {
 	// +featureGate=VolumeCapacityPriority
 	// +optional
 	Shape []UtilizationShapePoint
+
+	// AttachedVolumesWeight is the weight of scoring nodes by the fraction of
+	// the pod's bound persistent volumes that are already attached to them,
+	// according to VolumeAttachment objects. Preferring such nodes reduces
+	// attach churn and startup time for rescheduled stateful pods.
+	// Value must be non-negative. The value zero (the default) disables the
+	// scoring.
+	AttachedVolumesWeight int64
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
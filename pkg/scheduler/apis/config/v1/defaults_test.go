@@ -110,7 +110,8 @@ var pluginConfigs = []configv1.PluginConfig{
 				Kind:       "VolumeBindingArgs",
 				APIVersion: "kubescheduler.config.k8s.io/v1",
 			},
-			BindTimeoutSeconds: ptr.To[int64](600),
+			BindTimeoutSeconds:    ptr.To[int64](600),
+			AttachedVolumesWeight: ptr.To[int64](0),
 		}},
 	},
 }
@@ -321,7 +322,8 @@ func TestSchedulerDefaults(t *testing.T) {
 										Kind:       "VolumeBindingArgs",
 										APIVersion: "kubescheduler.config.k8s.io/v1",
 									},
-									BindTimeoutSeconds: ptr.To[int64](600),
+									BindTimeoutSeconds:    ptr.To[int64](600),
+									AttachedVolumesWeight: ptr.To[int64](0),
 								}},
 							},
 						},
@@ -816,7 +818,8 @@ func TestPluginArgsDefaults(t *testing.T) {
 			},
 			in: &configv1.VolumeBindingArgs{},
 			want: &configv1.VolumeBindingArgs{
-				BindTimeoutSeconds: ptr.To[int64](600),
+				BindTimeoutSeconds:    ptr.To[int64](600),
+				AttachedVolumesWeight: ptr.To[int64](0),
 			},
 		},
 		{
@@ -826,7 +829,8 @@ func TestPluginArgsDefaults(t *testing.T) {
 			},
 			in: &configv1.VolumeBindingArgs{},
 			want: &configv1.VolumeBindingArgs{
-				BindTimeoutSeconds: ptr.To[int64](600),
+				BindTimeoutSeconds:    ptr.To[int64](600),
+				AttachedVolumesWeight: ptr.To[int64](0),
 				Shape: []configv1.UtilizationShapePoint{
 					{Utilization: 0, Score: 0},
 					{Utilization: 100, Score: 10},
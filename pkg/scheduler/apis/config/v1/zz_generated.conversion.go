@@ -924,6 +924,9 @@ func autoConvert_v1_VolumeBindingArgs_To_config_VolumeBindingArgs(in *configv1.V
 		return err
 	}
 	out.Shape = *(*[]config.UtilizationShapePoint)(unsafe.Pointer(&in.Shape))
+	if err := metav1.Convert_Pointer_int64_To_int64(&in.AttachedVolumesWeight, &out.AttachedVolumesWeight, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -937,6 +940,9 @@ func autoConvert_config_VolumeBindingArgs_To_v1_VolumeBindingArgs(in *config.Vol
 		return err
 	}
 	out.Shape = *(*[]configv1.UtilizationShapePoint)(unsafe.Pointer(&in.Shape))
+	if err := metav1.Convert_int64_To_Pointer_int64(&in.AttachedVolumesWeight, &out.AttachedVolumesWeight, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -279,6 +279,10 @@ func ValidateVolumeBindingArgsWithOptions(path *field.Path, args *config.VolumeB
 		allErrs = append(allErrs, field.Invalid(path.Child("bindTimeoutSeconds"), args.BindTimeoutSeconds, "invalid BindTimeoutSeconds, should not be a negative value"))
 	}
 
+	if args.AttachedVolumesWeight < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("attachedVolumesWeight"), args.AttachedVolumesWeight, "invalid AttachedVolumesWeight, should not be a negative value"))
+	}
+
 	if opts.AllowVolumeCapacityPriority {
 		allErrs = append(allErrs, validateFunctionShape(args.Shape, path.Child("shape"))...)
 	} else if args.Shape != nil {
@@ -189,6 +189,11 @@ type SchedulerVolumeBinder interface {
 	//
 	// This function can be called in parallel.
 	BindPodVolumes(ctx context.Context, assumedPod *v1.Pod, podVolumes *PodVolumes) error
+
+	// BindingTimedOutOnNode returns true when volume binding for the pod
+	// recently timed out on the given node, so the node should be avoided on
+	// this scheduling attempt.
+	BindingTimedOutOnNode(pod *v1.Pod, nodeName string) bool
 }
 
 type PodVolumeClaims struct {
@@ -220,6 +225,16 @@ type volumeBinder struct {
 
 	csiDriverLister          storagelisters.CSIDriverLister
 	csiStorageCapacityLister storagelisters.CSIStorageCapacityLister
+
+	// ephemeralCapacity tracks generic ephemeral volumes that were assumed
+	// for provisioning but are not bound yet, so that their sizes are
+	// subtracted from published storage capacity during scheduling.
+	ephemeralCapacity *ephemeralCapacityTracker
+
+	// bindingTimeouts tracks on which nodes volume binding recently timed
+	// out for a pod, so the Filter phase can steer the pod towards other
+	// topologies until the exclusion expires.
+	bindingTimeouts *bindingTimeoutTracker
 }
 
 var _ SchedulerVolumeBinder = &volumeBinder{}
@@ -256,6 +271,9 @@ func NewVolumeBinder(
 		pvCache:       NewPVAssumeCache(logger, pvInformer.Informer()),
 		bindTimeout:   bindTimeout,
 		translator:    csitrans.New(),
+
+		ephemeralCapacity: newEphemeralCapacityTracker(),
+		bindingTimeouts:   newBindingTimeoutTracker(),
 	}
 
 	b.csiDriverLister = capacityCheck.CSIDriverInformer.Lister()
@@ -432,6 +450,13 @@ func (b *volumeBinder) AssumePodVolumes(logger klog.Logger, assumedPod *v1.Pod,
 			return
 		}
 
+		// Generic ephemeral volumes are provisioned from capacity local to
+		// the selected node; remember their sizes until the claims are
+		// bound so other pods do not overcommit the same capacity.
+		if metav1.IsControlledBy(claimClone, assumedPod) {
+			b.ephemeralCapacity.assume(claimClone, volume.GetPersistentVolumeClaimClass(claimClone), nodeName)
+		}
+
 		newProvisionedPVCs = append(newProvisionedPVCs, claimClone)
 	}
 
@@ -473,11 +498,23 @@ func (b *volumeBinder) BindPodVolumes(ctx context.Context, assumedPod *v1.Pod, p
 		return b, err
 	})
 	if err != nil {
+		if wait.Interrupted(err) && ctx.Err() == nil {
+			// The bind timeout expired, most likely because the selected
+			// topology ran out of capacity. Clear the provisional binding and
+			// mark the node on the pod, so the next scheduling attempt tries
+			// other topologies.
+			b.recoverBindingTimeout(ctx, assumedPod, claimsToProvision)
+		}
 		return fmt.Errorf("binding volumes: %w", err)
 	}
 	return nil
 }
 
+// BindingTimedOutOnNode implements SchedulerVolumeBinder.BindingTimedOutOnNode.
+func (b *volumeBinder) BindingTimedOutOnNode(pod *v1.Pod, nodeName string) bool {
+	return b.bindingTimeouts.timedOutOnNode(pod.UID, nodeName)
+}
+
 func getPodName(pod *v1.Pod) string {
 	return pod.Namespace + "/" + pod.Name
 }
@@ -937,6 +974,7 @@ func (b *volumeBinder) revertAssumedPVs(bindings []*BindingInfo) {
 func (b *volumeBinder) revertAssumedPVCs(claims []*v1.PersistentVolumeClaim) {
 	for _, claim := range claims {
 		b.pvcCache.Restore(getPVCName(claim))
+		b.ephemeralCapacity.forget(getPVCName(claim))
 	}
 }
 
@@ -971,10 +1009,14 @@ func (b *volumeBinder) hasEnoughCapacity(logger klog.Logger, provisioner string,
 		return false, err
 	}
 
+	// Capacity that was already promised to in-flight generic ephemeral
+	// volumes on this node is not free, even though the driver still
+	// reports it as available.
 	sizeInBytes := quantity.Value()
+	pendingBytes := b.pendingEphemeralBytes(storageClass.Name, node.Name, getPVCName(claim))
 	for _, capacity := range capacities {
 		if capacity.StorageClassName == storageClass.Name &&
-			capacitySufficient(capacity, sizeInBytes) &&
+			capacitySufficient(capacity, sizeInBytes+pendingBytes) &&
 			b.nodeHasAccess(logger, node, capacity) {
 			// Enough capacity found.
 			return true, nil
@@ -984,7 +1026,7 @@ func (b *volumeBinder) hasEnoughCapacity(logger klog.Logger, provisioner string,
 	// TODO (?): this doesn't give any information about which pools where considered and why
 	// they had to be rejected. Log that above? But that might be a lot of log output...
 	logger.V(4).Info("Node has no accessible CSIStorageCapacity with enough capacity for PVC",
-		"node", klog.KObj(node), "PVC", klog.KObj(claim), "size", sizeInBytes, "storageClass", klog.KObj(storageClass))
+		"node", klog.KObj(node), "PVC", klog.KObj(claim), "size", sizeInBytes, "pendingEphemeralSize", pendingBytes, "storageClass", klog.KObj(storageClass))
 	return false, nil
 }
 
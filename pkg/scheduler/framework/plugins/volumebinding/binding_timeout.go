@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumebinding
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/component-helpers/storage/volume"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+)
+
+// bindingTimeoutExclusionTTL is how long a node is avoided for a pod after
+// volume binding for the pod timed out on it. The timeout typically means
+// the selected topology is out of capacity; capacity can come back, so the
+// exclusion expires instead of ruling the node out forever.
+const bindingTimeoutExclusionTTL = 10 * time.Minute
+
+// bindingTimeoutTracker remembers, in memory, on which nodes volume binding
+// recently timed out for a pod. The Filter phase consults it so the next
+// scheduling attempt prefers other topologies. Entries expire after
+// bindingTimeoutExclusionTTL and expired entries are pruned whenever a new
+// timeout is recorded, so the tracker does not grow without bound.
+type bindingTimeoutTracker struct {
+	mutex    sync.Mutex
+	clock    clock.PassiveClock
+	timeouts map[types.UID]map[string]time.Time
+}
+
+func newBindingTimeoutTracker() *bindingTimeoutTracker {
+	return &bindingTimeoutTracker{
+		clock:    clock.RealClock{},
+		timeouts: map[types.UID]map[string]time.Time{},
+	}
+}
+
+// record notes that volume binding for the pod timed out on the node.
+func (t *bindingTimeoutTracker) record(podUID types.UID, nodeName string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.pruneExpired()
+	nodes := t.timeouts[podUID]
+	if nodes == nil {
+		nodes = map[string]time.Time{}
+		t.timeouts[podUID] = nodes
+	}
+	nodes[nodeName] = t.clock.Now()
+}
+
+// timedOutOnNode returns true when volume binding for the pod timed out on
+// the node within the last bindingTimeoutExclusionTTL.
+func (t *bindingTimeoutTracker) timedOutOnNode(podUID types.UID, nodeName string) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	recorded, found := t.timeouts[podUID][nodeName]
+	if !found {
+		return false
+	}
+	if t.clock.Since(recorded) >= bindingTimeoutExclusionTTL {
+		delete(t.timeouts[podUID], nodeName)
+		if len(t.timeouts[podUID]) == 0 {
+			delete(t.timeouts, podUID)
+		}
+		return false
+	}
+	return true
+}
+
+// pruneExpired drops all expired entries. The caller must hold the mutex.
+func (t *bindingTimeoutTracker) pruneExpired() {
+	for podUID, nodes := range t.timeouts {
+		for nodeName, recorded := range nodes {
+			if t.clock.Since(recorded) >= bindingTimeoutExclusionTTL {
+				delete(nodes, nodeName)
+			}
+		}
+		if len(nodes) == 0 {
+			delete(t.timeouts, podUID)
+		}
+	}
+}
+
+// recoverBindingTimeout undoes the provisional binding after BindPodVolumes
+// ran into the bind timeout. It clears the selected node of the claims that
+// were still waiting to be provisioned, so the provisioner stops trying the
+// exhausted topology, and records the node in the binding timeout tracker so
+// the scheduler prefers other topologies when the pod is retried.
+func (b *volumeBinder) recoverBindingTimeout(ctx context.Context, pod *v1.Pod, claimsToProvision []*v1.PersistentVolumeClaim) {
+	logger := klog.FromContext(ctx)
+	for _, claim := range claimsToProvision {
+		pvc, err := b.pvcCache.GetAPIPVC(getPVCName(claim))
+		if err != nil {
+			logger.Error(err, "Failed to get claim to clear its selected node", "pod", klog.KObj(pod), "PVC", klog.KObj(claim))
+			continue
+		}
+		if b.isPVCFullyBound(pvc) || pvc.Annotations[volume.AnnSelectedNode] != pod.Spec.NodeName {
+			continue
+		}
+		pvcClone := pvc.DeepCopy()
+		delete(pvcClone.Annotations, volume.AnnSelectedNode)
+		if _, err := b.kubeClient.CoreV1().PersistentVolumeClaims(pvcClone.Namespace).Update(ctx, pvcClone, metav1.UpdateOptions{}); err != nil {
+			logger.Error(err, "Failed to clear selected node of claim after binding timeout", "pod", klog.KObj(pod), "PVC", klog.KObj(pvc))
+			continue
+		}
+		logger.V(2).Info("Cleared selected node of claim after binding timeout", "pod", klog.KObj(pod), "PVC", klog.KObj(pvc), "node", pod.Spec.NodeName)
+	}
+
+	b.bindingTimeouts.record(pod.UID, pod.Spec.NodeName)
+	logger.V(2).Info("Recorded binding timeout, other topologies will be tried on the next scheduling attempt", "pod", klog.KObj(pod), "node", pod.Spec.NodeName)
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumebinding
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/component-helpers/storage/volume"
+	"k8s.io/klog/v2/ktesting"
+	testingclock "k8s.io/utils/clock/testing"
+)
+
+func TestBindingTimeoutTracker(t *testing.T) {
+	fakeClock := testingclock.NewFakeClock(time.Now())
+	tracker := newBindingTimeoutTracker()
+	tracker.clock = fakeClock
+
+	if tracker.timedOutOnNode("pod-uid", "node1") {
+		t.Errorf("expected no timeout before anything was recorded")
+	}
+
+	tracker.record("pod-uid", "node1")
+	if !tracker.timedOutOnNode("pod-uid", "node1") {
+		t.Errorf("expected a timeout on the recorded node")
+	}
+	if tracker.timedOutOnNode("pod-uid", "node2") {
+		t.Errorf("expected no timeout on another node")
+	}
+	if tracker.timedOutOnNode("other-pod-uid", "node1") {
+		t.Errorf("expected no timeout for another pod")
+	}
+
+	fakeClock.Step(bindingTimeoutExclusionTTL)
+	if tracker.timedOutOnNode("pod-uid", "node1") {
+		t.Errorf("expected the timeout to expire after the TTL")
+	}
+	if len(tracker.timeouts) != 0 {
+		t.Errorf("expected expired entries to be removed, got %v", tracker.timeouts)
+	}
+}
+
+func TestBindingTimeoutTrackerPrunesOnRecord(t *testing.T) {
+	fakeClock := testingclock.NewFakeClock(time.Now())
+	tracker := newBindingTimeoutTracker()
+	tracker.clock = fakeClock
+
+	tracker.record("old-pod-uid", "node1")
+	fakeClock.Step(bindingTimeoutExclusionTTL)
+	tracker.record("pod-uid", "node2")
+
+	if _, found := tracker.timeouts["old-pod-uid"]; found {
+		t.Errorf("expected the expired entry of the old pod to be pruned")
+	}
+	if !tracker.timedOutOnNode("pod-uid", "node2") {
+		t.Errorf("expected a timeout on the freshly recorded node")
+	}
+}
+
+func TestRecoverBindingTimeout(t *testing.T) {
+	_, ctx := ktesting.NewTestContext(t)
+
+	// Setup
+	testEnv := newTestBinder(t, ctx)
+	testEnv.initNodes([]*v1.Node{node1})
+	testEnv.initClaims([]*v1.PersistentVolumeClaim{selectedNodePVC}, nil)
+	pod := makePod("test-pod").
+		withNamespace("testns").
+		withNodeName("node1").
+		withPVCVolume("provisioned-pvc", "").Pod
+
+	// Execute
+	testEnv.internalBinder.recoverBindingTimeout(ctx, pod, []*v1.PersistentVolumeClaim{selectedNodePVC})
+
+	// Validate
+	var updatedPVC *v1.PersistentVolumeClaim
+	for _, action := range testEnv.client.(*fake.Clientset).Actions() {
+		if action.Matches("update", "persistentvolumeclaims") {
+			updatedPVC = action.(k8stesting.UpdateAction).GetObject().(*v1.PersistentVolumeClaim)
+		}
+	}
+	if updatedPVC == nil {
+		t.Fatalf("expected the claim to be updated")
+	}
+	if _, found := updatedPVC.Annotations[volume.AnnSelectedNode]; found {
+		t.Errorf("expected the selected node of the claim to be cleared, got %v", updatedPVC.Annotations)
+	}
+	if !testEnv.internalBinder.BindingTimedOutOnNode(pod, "node1") {
+		t.Errorf("expected the binding timeout to be recorded for the node")
+	}
+}
+
+func TestRecoverBindingTimeoutUnselectedClaim(t *testing.T) {
+	_, ctx := ktesting.NewTestContext(t)
+
+	// Setup
+	testEnv := newTestBinder(t, ctx)
+	testEnv.initNodes([]*v1.Node{node1})
+	testEnv.initClaims([]*v1.PersistentVolumeClaim{provisionedPVC}, nil)
+	pod := makePod("test-pod").
+		withNamespace("testns").
+		withNodeName("node1").
+		withPVCVolume("provisioned-pvc", "").Pod
+
+	// Execute
+	testEnv.internalBinder.recoverBindingTimeout(ctx, pod, []*v1.PersistentVolumeClaim{provisionedPVC})
+
+	// Validate: the claim has no selected node, so it must not be updated,
+	// but the timeout is still recorded.
+	for _, action := range testEnv.client.(*fake.Clientset).Actions() {
+		if action.Matches("update", "persistentvolumeclaims") {
+			t.Errorf("expected no claim update, got %v", action)
+		}
+	}
+	if !testEnv.internalBinder.BindingTimedOutOnNode(pod, "node1") {
+		t.Errorf("expected the binding timeout to be recorded for the node")
+	}
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumebinding
+
+import (
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// inFlightProvision records one generic ephemeral volume that was assumed
+// for provisioning on a node but whose claim is not bound yet. Until the
+// CSI driver updates its CSIStorageCapacity objects, the requested capacity
+// is still reported as available, so the scheduler must subtract these
+// in-flight sizes itself to avoid overcommitting a node-local pool.
+type inFlightProvision struct {
+	storageClassName string
+	nodeName         string
+	sizeBytes        int64
+}
+
+// ephemeralCapacityTracker keeps the in-flight provisions of generic
+// ephemeral volumes, keyed by claim key (namespace/name). Entries are added
+// when pod volumes are assumed, removed when the assume is reverted, and
+// pruned lazily once the claim is observed bound or deleted.
+type ephemeralCapacityTracker struct {
+	mutex      sync.Mutex
+	provisions map[string]inFlightProvision
+}
+
+func newEphemeralCapacityTracker() *ephemeralCapacityTracker {
+	return &ephemeralCapacityTracker{
+		provisions: map[string]inFlightProvision{},
+	}
+}
+
+// assume records the claim as an in-flight provision on the node. Claims
+// without a storage request are ignored.
+func (t *ephemeralCapacityTracker) assume(claim *v1.PersistentVolumeClaim, storageClassName, nodeName string) {
+	quantity, ok := claim.Spec.Resources.Requests[v1.ResourceStorage]
+	if !ok {
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.provisions[getPVCName(claim)] = inFlightProvision{
+		storageClassName: storageClassName,
+		nodeName:         nodeName,
+		sizeBytes:        quantity.Value(),
+	}
+}
+
+// forget drops the in-flight provision of the claim, if any.
+func (t *ephemeralCapacityTracker) forget(claimKey string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	delete(t.provisions, claimKey)
+}
+
+// pendingBytes returns the total size of in-flight provisions for the given
+// storage class on the given node, not counting excludedClaimKey. Entries
+// for which resolved returns true are pruned along the way.
+func (t *ephemeralCapacityTracker) pendingBytes(storageClassName, nodeName, excludedClaimKey string, resolved func(claimKey string) bool) int64 {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	var pending int64
+	for claimKey, provision := range t.provisions {
+		if resolved(claimKey) {
+			delete(t.provisions, claimKey)
+			continue
+		}
+		if claimKey == excludedClaimKey {
+			continue
+		}
+		if provision.storageClassName == storageClassName && provision.nodeName == nodeName {
+			pending += provision.sizeBytes
+		}
+	}
+	return pending
+}
+
+// pendingEphemeralBytes returns the capacity already promised to in-flight
+// generic ephemeral volumes of the given storage class on the node. Claims
+// that are bound or deleted in the meantime no longer count: at that point
+// the CSI driver has seen the volume and refreshes its CSIStorageCapacity
+// objects itself.
+func (b *volumeBinder) pendingEphemeralBytes(storageClassName, nodeName, excludedClaimKey string) int64 {
+	return b.ephemeralCapacity.pendingBytes(storageClassName, nodeName, excludedClaimKey, func(claimKey string) bool {
+		pvc, err := b.pvcCache.GetPVC(claimKey)
+		if err != nil || pvc == nil {
+			return true
+		}
+		return b.isPVCFullyBound(pvc)
+	})
+}
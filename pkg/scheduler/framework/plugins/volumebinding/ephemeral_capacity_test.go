@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumebinding
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func ephemeralCapacityClaim(name, size string) *v1.PersistentVolumeClaim {
+	claim := &v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns"},
+	}
+	if size != "" {
+		claim.Spec.Resources.Requests = v1.ResourceList{
+			v1.ResourceStorage: resource.MustParse(size),
+		}
+	}
+	return claim
+}
+
+func TestEphemeralCapacityTracker(t *testing.T) {
+	neverResolved := func(string) bool { return false }
+	tracker := newEphemeralCapacityTracker()
+
+	tracker.assume(ephemeralCapacityClaim("claim-a", "1Gi"), "local", "node-1")
+	tracker.assume(ephemeralCapacityClaim("claim-b", "2Gi"), "local", "node-1")
+	tracker.assume(ephemeralCapacityClaim("claim-c", "4Gi"), "local", "node-2")
+	tracker.assume(ephemeralCapacityClaim("claim-d", "8Gi"), "other-class", "node-1")
+	tracker.assume(ephemeralCapacityClaim("claim-e", ""), "local", "node-1")
+
+	gib := int64(1024 * 1024 * 1024)
+	if pending := tracker.pendingBytes("local", "node-1", "", neverResolved); pending != 3*gib {
+		t.Errorf("Expected 3GiB pending on node-1, got %d", pending)
+	}
+	if pending := tracker.pendingBytes("local", "node-2", "", neverResolved); pending != 4*gib {
+		t.Errorf("Expected 4GiB pending on node-2, got %d", pending)
+	}
+	if pending := tracker.pendingBytes("local", "node-3", "", neverResolved); pending != 0 {
+		t.Errorf("Expected nothing pending on node-3, got %d", pending)
+	}
+
+	// The claim being checked does not count against itself.
+	if pending := tracker.pendingBytes("local", "node-1", "ns/claim-b", neverResolved); pending != gib {
+		t.Errorf("Expected 1GiB pending without claim-b, got %d", pending)
+	}
+
+	tracker.forget("ns/claim-a")
+	if pending := tracker.pendingBytes("local", "node-1", "", neverResolved); pending != 2*gib {
+		t.Errorf("Expected 2GiB pending after forgetting claim-a, got %d", pending)
+	}
+
+	// Resolved claims are pruned and stay gone.
+	resolved := func(claimKey string) bool { return claimKey == "ns/claim-b" }
+	if pending := tracker.pendingBytes("local", "node-1", "", resolved); pending != 0 {
+		t.Errorf("Expected nothing pending after claim-b resolved, got %d", pending)
+	}
+	if pending := tracker.pendingBytes("local", "node-1", "", neverResolved); pending != 0 {
+		t.Errorf("Expected resolved claim to stay pruned, got %d", pending)
+	}
+}
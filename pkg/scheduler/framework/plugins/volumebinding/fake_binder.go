@@ -73,3 +73,8 @@ func (b *FakeVolumeBinder) BindPodVolumes(ctx context.Context, assumedPod *v1.Po
 	b.BindCalled = true
 	return b.config.BindErr
 }
+
+// BindingTimedOutOnNode implements SchedulerVolumeBinder.BindingTimedOutOnNode.
+func (b *FakeVolumeBinder) BindingTimedOutOnNode(_ *v1.Pod, _ string) bool {
+	return false
+}
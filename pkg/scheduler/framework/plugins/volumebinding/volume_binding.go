@@ -27,8 +27,11 @@ import (
 	storagev1 "k8s.io/api/storage/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	corelisters "k8s.io/client-go/listers/core/v1"
+	storagelisters "k8s.io/client-go/listers/storage/v1"
 	"k8s.io/component-helpers/storage/ephemeral"
 	"k8s.io/klog/v2"
 	"k8s.io/kubernetes/pkg/scheduler/apis/config"
@@ -70,10 +73,12 @@ func (d *stateData) Clone() framework.StateData {
 // In the Filter phase, pod binding cache is created for the pod and used in
 // Reserve and PreBind phases.
 type VolumeBinding struct {
-	Binder    SchedulerVolumeBinder
-	PVCLister corelisters.PersistentVolumeClaimLister
-	scorer    volumeCapacityScorer
-	fts       feature.Features
+	Binder                 SchedulerVolumeBinder
+	PVCLister              corelisters.PersistentVolumeClaimLister
+	scorer                 volumeCapacityScorer
+	volumeAttachmentLister storagelisters.VolumeAttachmentLister
+	attachedVolumesWeight  int64
+	fts                    feature.Features
 }
 
 var _ framework.PreFilterPlugin = &VolumeBinding{}
@@ -420,6 +425,13 @@ func (pl *VolumeBinding) Filter(ctx context.Context, cs *framework.CycleState, p
 		return framework.AsStatus(err)
 	}
 
+	if pl.Binder.BindingTimedOutOnNode(pod, node.Name) {
+		// Volume binding timed out on this node on a recent attempt,
+		// presumably because its topology is out of capacity. Steer the pod
+		// towards other topologies until the exclusion expires.
+		return framework.NewStatus(framework.UnschedulableAndUnresolvable, "volume binding timed out on the node on a previous attempt")
+	}
+
 	podVolumes, reasons, err := pl.Binder.FindPodVolumes(logger, pod, state.podVolumeClaims, node)
 
 	if err != nil {
@@ -444,14 +456,17 @@ func (pl *VolumeBinding) Filter(ctx context.Context, cs *framework.CycleState, p
 
 // PreScore invoked at the preScore extension point. It checks whether volumeBinding can skip Score
 func (pl *VolumeBinding) PreScore(ctx context.Context, cs *framework.CycleState, pod *v1.Pod, nodes []*framework.NodeInfo) *framework.Status {
-	if pl.scorer == nil {
+	if pl.scorer == nil && pl.attachedVolumesWeight == 0 {
 		return framework.NewStatus(framework.Skip)
 	}
 	state, err := getStateData(cs)
 	if err != nil {
 		return framework.AsStatus(err)
 	}
-	if state.hasStaticBindings {
+	if pl.scorer != nil && state.hasStaticBindings {
+		return nil
+	}
+	if pl.attachedVolumesWeight > 0 && state.podVolumeClaims != nil && len(state.podVolumeClaims.boundClaims) > 0 {
 		return nil
 	}
 	return framework.NewStatus(framework.Skip)
@@ -459,32 +474,72 @@ func (pl *VolumeBinding) PreScore(ctx context.Context, cs *framework.CycleState,
 
 // Score invoked at the score extension point.
 func (pl *VolumeBinding) Score(ctx context.Context, cs *framework.CycleState, pod *v1.Pod, nodeName string) (int64, *framework.Status) {
-	if pl.scorer == nil {
+	if pl.scorer == nil && pl.attachedVolumesWeight == 0 {
 		return 0, nil
 	}
 	state, err := getStateData(cs)
 	if err != nil {
 		return 0, framework.AsStatus(err)
 	}
-	podVolumes, ok := state.podVolumesByNode[nodeName]
-	if !ok {
+	var score int64
+	if podVolumes, ok := state.podVolumesByNode[nodeName]; ok && pl.scorer != nil {
+		// group by storage class
+		classResources := make(classResourceMap)
+		for _, staticBinding := range podVolumes.StaticBindings {
+			class := staticBinding.StorageClassName()
+			storageResource := staticBinding.StorageResource()
+			if _, ok := classResources[class]; !ok {
+				classResources[class] = &StorageResource{
+					Requested: 0,
+					Capacity:  0,
+				}
+			}
+			classResources[class].Requested += storageResource.Requested
+			classResources[class].Capacity += storageResource.Capacity
+		}
+		score = pl.scorer(classResources)
+	}
+	if pl.attachedVolumesWeight > 0 {
+		attachedScore, err := pl.scoreByAttachedVolumes(state, nodeName)
+		if err != nil {
+			return 0, framework.AsStatus(err)
+		}
+		score = min(framework.MaxNodeScore, score+attachedScore)
+	}
+	return score, nil
+}
+
+// scoreByAttachedVolumes returns the share of the pod's bound persistent
+// volumes that are already attached to the node, scaled to the node score
+// range and multiplied by the configured weight. Nodes with the volumes
+// already attached start the pod without waiting for new attach operations.
+func (pl *VolumeBinding) scoreByAttachedVolumes(state *stateData, nodeName string) (int64, error) {
+	if state.podVolumeClaims == nil {
 		return 0, nil
 	}
-	// group by storage class
-	classResources := make(classResourceMap)
-	for _, staticBinding := range podVolumes.StaticBindings {
-		class := staticBinding.StorageClassName()
-		storageResource := staticBinding.StorageResource()
-		if _, ok := classResources[class]; !ok {
-			classResources[class] = &StorageResource{
-				Requested: 0,
-				Capacity:  0,
-			}
+	boundPVs := sets.New[string]()
+	for _, claim := range state.podVolumeClaims.boundClaims {
+		if claim.Spec.VolumeName != "" {
+			boundPVs.Insert(claim.Spec.VolumeName)
+		}
+	}
+	if boundPVs.Len() == 0 {
+		return 0, nil
+	}
+	volumeAttachments, err := pl.volumeAttachmentLister.List(labels.Everything())
+	if err != nil {
+		return 0, err
+	}
+	attached := 0
+	for _, volumeAttachment := range volumeAttachments {
+		if volumeAttachment.Spec.NodeName != nodeName || !volumeAttachment.Status.Attached {
+			continue
+		}
+		if pvName := volumeAttachment.Spec.Source.PersistentVolumeName; pvName != nil && boundPVs.Has(*pvName) {
+			attached++
 		}
-		classResources[class].Requested += storageResource.Requested
-		classResources[class].Capacity += storageResource.Capacity
 	}
-	return pl.scorer(classResources), nil
+	return pl.attachedVolumesWeight * framework.MaxNodeScore * int64(attached) / int64(boundPVs.Len()), nil
 }
 
 // ScoreExtensions of the Score plugin.
@@ -593,10 +648,18 @@ func New(ctx context.Context, plArgs runtime.Object, fh framework.Handle, fts fe
 		}
 		scorer = buildScorerFunction(shape)
 	}
+
+	// Only start watching VolumeAttachments when the scoring is enabled.
+	var volumeAttachmentLister storagelisters.VolumeAttachmentLister
+	if args.AttachedVolumesWeight > 0 {
+		volumeAttachmentLister = fh.SharedInformerFactory().Storage().V1().VolumeAttachments().Lister()
+	}
 	return &VolumeBinding{
-		Binder:    binder,
-		PVCLister: pvcInformer.Lister(),
-		scorer:    scorer,
-		fts:       fts,
+		Binder:                 binder,
+		PVCLister:              pvcInformer.Lister(),
+		scorer:                 scorer,
+		volumeAttachmentLister: volumeAttachmentLister,
+		attachedVolumesWeight:  args.AttachedVolumesWeight,
+		fts:                    fts,
 	}, nil
 }
@@ -79,6 +79,7 @@ func TestVolumeBinding(t *testing.T) {
 		nodes                   []*v1.Node
 		pvcs                    []*v1.PersistentVolumeClaim
 		pvs                     []*v1.PersistentVolume
+		vas                     []*storagev1.VolumeAttachment
 		fts                     feature.Features
 		args                    *config.VolumeBindingArgs
 		wantPreFilterResult     *framework.PreFilterResult
@@ -730,6 +731,59 @@ func TestVolumeBinding(t *testing.T) {
 				0,
 			},
 		},
+		{
+			name: "attached volumes scoring",
+			pod:  makePod("pod-a").withPVCVolume("pvc-a", "").Pod,
+			nodes: []*v1.Node{
+				makeNode("node-a").Node,
+				makeNode("node-b").Node,
+			},
+			pvcs: []*v1.PersistentVolumeClaim{
+				makePVC("pvc-a", waitSC.Name).withBoundPV("pv-a").PersistentVolumeClaim,
+			},
+			pvs: []*v1.PersistentVolume{
+				makePV("pv-a", waitSC.Name).withPhase(v1.VolumeAvailable).PersistentVolume,
+			},
+			vas: []*storagev1.VolumeAttachment{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "va-a",
+					},
+					Spec: storagev1.VolumeAttachmentSpec{
+						Attacher: "test-attacher",
+						NodeName: "node-a",
+						Source: storagev1.VolumeAttachmentSource{
+							PersistentVolumeName: ptr.To("pv-a"),
+						},
+					},
+					Status: storagev1.VolumeAttachmentStatus{
+						Attached: true,
+					},
+				},
+			},
+			args: &config.VolumeBindingArgs{
+				BindTimeoutSeconds:    300,
+				AttachedVolumesWeight: 1,
+			},
+			wantStateAfterPreFilter: &stateData{
+				podVolumeClaims: &PodVolumeClaims{
+					boundClaims: []*v1.PersistentVolumeClaim{
+						makePVC("pvc-a", waitSC.Name).withBoundPV("pv-a").PersistentVolumeClaim,
+					},
+					unboundClaimsDelayBinding:  []*v1.PersistentVolumeClaim{},
+					unboundVolumesDelayBinding: map[string][]*v1.PersistentVolume{},
+				},
+				podVolumesByNode: map[string]*PodVolumes{},
+			},
+			wantFilterStatus: []*framework.Status{
+				nil,
+				nil,
+			},
+			wantScores: []int64{
+				100,
+				0,
+			},
+		},
 	}
 
 	for _, item := range table {
@@ -777,6 +831,9 @@ func TestVolumeBinding(t *testing.T) {
 			for _, pv := range item.pvs {
 				client.CoreV1().PersistentVolumes().Create(ctx, pv, metav1.CreateOptions{})
 			}
+			for _, va := range item.vas {
+				client.StorageV1().VolumeAttachments().Create(ctx, va, metav1.CreateOptions{})
+			}
 
 			t.Log("Start informer factory after initialization")
 			informerFactory.Start(ctx.Done())
@@ -18,6 +18,7 @@ package configmap
 
 import (
 	"fmt"
+	"time"
 
 	"k8s.io/klog/v2"
 	"k8s.io/mount-utils"
@@ -254,6 +255,12 @@ func (b *configMapVolumeMounter) SetUpAt(dir string, mounterArgs volume.MounterA
 		return err
 	}
 
+	volumeutil.RecordPayloadRefresh(volumeutil.PayloadRefreshKey{
+		PodUID:     b.pod.UID,
+		VolumeName: b.volName,
+		PluginName: configMapPluginName,
+	}, b.pod.Namespace, b.pod.Name, time.Now())
+
 	setupSuccess = true
 	return nil
 }
@@ -327,6 +334,11 @@ func (c *configMapVolumeUnmounter) TearDown() error {
 }
 
 func (c *configMapVolumeUnmounter) TearDownAt(dir string) error {
+	volumeutil.ForgetPayloadRefresh(volumeutil.PayloadRefreshKey{
+		PodUID:     c.podUID,
+		VolumeName: c.volName,
+		PluginName: configMapPluginName,
+	})
 	return volumeutil.UnmountViaEmptyDir(dir, c.plugin.host, c.volName, wrappedVolumeSpec(), c.podUID)
 }
 
@@ -296,12 +296,23 @@ func (c *csiAttacher) MountDevice(spec *volume.Spec, devicePath string, deviceMo
 		return err
 	}
 
-	// Get secrets and publish context required for mountDevice
+	// Get secrets and publish context required for mountDevice. The live
+	// VolumeAttachment is authoritative: the controller may have re-attached
+	// the volume and returned different attachment metadata since a previous
+	// MountDevice call persisted its copy. The persisted copy is only used
+	// as a fallback when the API server cannot be reached, so retries after
+	// a kubelet restart still work while the node is partitioned.
 	nodeName := string(c.plugin.host.GetNodeName())
 	publishContext, err := c.plugin.getPublishContext(c.k8s, csiSource.VolumeHandle, csiSource.Driver, nodeName)
-
 	if err != nil {
-		return volumetypes.NewTransientOperationFailure(err.Error())
+		persistedContext, foundPersisted := LoadPublishContext(filepath.Dir(deviceMountPath))
+		if apierrors.IsNotFound(err) || !foundPersisted {
+			// A missing VolumeAttachment means the volume is not attached;
+			// the persisted copy must not mask that.
+			return volumetypes.NewTransientOperationFailure(err.Error())
+		}
+		klog.V(4).Info(log("attacher.MountDevice could not read the VolumeAttachment, falling back to the persisted publish context: %v", err))
+		publishContext = persistedContext
 	}
 
 	nodeStageSecrets := map[string]string{}
@@ -350,6 +361,16 @@ func (c *csiAttacher) MountDevice(spec *volume.Spec, devicePath string, deviceMo
 		data[volDataKey.seLinuxMountContext] = deviceMounterArgs.SELinuxLabel
 	}
 
+	// Persist the publish context so NodeStage retries and reconstruction
+	// after a kubelet restart do not depend on the API server.
+	encodedContext, err := encodePublishContext(publishContext)
+	if err != nil {
+		return errors.New(log("attacher.MountDevice failed to marshal publish context: %v", err))
+	}
+	if encodedContext != "" {
+		data[volDataKey.publishContext] = encodedContext
+	}
+
 	err = saveVolumeData(dataDir, volDataFileName, data)
 	defer func() {
 		// Only if there was an error and volume operation was considered
@@ -408,6 +429,14 @@ func (c *csiAttacher) MountDevice(spec *volume.Spec, devicePath string, deviceMo
 		return err
 	}
 
+	if verifyCSIStagingEnabled() {
+		// Catch drivers that report idempotent success for a stage that only
+		// partially happened, e.g. after a driver crash mid-stage.
+		if err = c.verifyStagedMount(ctx, csiSource.VolumeHandle, deviceMountPath); err != nil {
+			return err
+		}
+	}
+
 	klog.V(4).Info(log("attacher.MountDevice successfully requested NodeStageVolume [%s]", deviceMountPath))
 	return err
 }
@@ -541,8 +570,17 @@ func (c *csiAttacher) UnmountDevice(deviceMountPath string) error {
 			return nil
 		}
 
-		klog.Error(log("attacher.UnmountDevice failed to get driver and volume name from device mount path: %v", err))
-		return err
+		// The file (and its backup) is corrupt. Try to rebuild the minimum
+		// fields from the staging path and the VolumeAttachment object so the
+		// volume does not stay stuck.
+		klog.Error(log("attacher.UnmountDevice failed to get driver and volume name from device mount path, attempting recovery: %v", err))
+		data, recoveryErr := c.recoverVolumeData(deviceMountPath)
+		if recoveryErr != nil {
+			klog.Error(log("attacher.UnmountDevice failed to recover volume data for [%s]: %v", dataDir, recoveryErr))
+			return err
+		}
+		driverName = data[volDataKey.driverName]
+		volID = data[volDataKey.volHandle]
 	}
 
 	if c.csiClient == nil {
@@ -591,12 +629,100 @@ func (c *csiAttacher) UnmountDevice(deviceMountPath string) error {
 	return nil
 }
 
+// recoverVolumeData rebuilds the minimum vol_data.json fields for a staged
+// volume whose data file was corrupted. The driver name is taken from the
+// staging path, mountinfo confirms the volume is actually staged there, and
+// the volume handle is found by matching the staging path's volume hash
+// against the VolumeAttachment objects of this node. The rebuilt data is
+// persisted again for subsequent operations.
+func (c *csiAttacher) recoverVolumeData(deviceMountPath string) (map[string]string, error) {
+	dataDir := filepath.Dir(deviceMountPath)
+	volSha := filepath.Base(dataDir)
+	driverName := filepath.Base(filepath.Dir(dataDir))
+	if driverName == "" || volSha == "" {
+		return nil, fmt.Errorf("cannot derive driver name and volume hash from path %s", deviceMountPath)
+	}
+
+	mounted, err := isDirMounted(c.plugin, deviceMountPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check mountinfo for %s: %v", deviceMountPath, err)
+	}
+	if !mounted {
+		return nil, fmt.Errorf("nothing is mounted at %s, refusing to rebuild volume data", deviceMountPath)
+	}
+
+	nodeName := string(c.plugin.host.GetNodeName())
+	attachments, err := c.k8s.StorageV1().VolumeAttachments().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list VolumeAttachments: %v", err)
+	}
+	for _, attachment := range attachments.Items {
+		if string(attachment.Spec.NodeName) != nodeName || attachment.Spec.Attacher != driverName {
+			continue
+		}
+		csiSource, specVolID, err := c.csiSourceFromAttachment(&attachment)
+		if err != nil {
+			klog.V(4).Info(log("attacher.recoverVolumeData skipping attachment [%s]: %v", attachment.Name, err))
+			continue
+		}
+		if fmt.Sprintf("%x", sha256.Sum256([]byte(csiSource.VolumeHandle))) != volSha {
+			continue
+		}
+		volData := map[string]string{
+			volDataKey.specVolID:    specVolID,
+			volDataKey.volHandle:    csiSource.VolumeHandle,
+			volDataKey.driverName:   csiSource.Driver,
+			volDataKey.nodeName:     nodeName,
+			volDataKey.attachmentID: attachment.Name,
+		}
+		if err := saveVolumeData(dataDir, volDataFileName, volData); err != nil {
+			klog.Error(log("attacher.recoverVolumeData failed to persist rebuilt volume data for [%s]: %v", dataDir, err))
+		} else {
+			klog.Info(log("attacher.recoverVolumeData rebuilt volume data for [%s] from VolumeAttachment [%s]", dataDir, attachment.Name))
+		}
+		return volData, nil
+	}
+	return nil, fmt.Errorf("no VolumeAttachment for node %s and driver %s matches staging path %s", nodeName, driverName, deviceMountPath)
+}
+
+// csiSourceFromAttachment returns the CSI persistent volume source and spec
+// volume ID the given attachment refers to, resolving the PV from the API
+// server when the attachment references one by name.
+func (c *csiAttacher) csiSourceFromAttachment(attachment *storage.VolumeAttachment) (*v1.CSIPersistentVolumeSource, string, error) {
+	if inlineSpec := attachment.Spec.Source.InlineVolumeSpec; inlineSpec != nil {
+		if inlineSpec.CSI == nil {
+			return nil, "", errors.New("inline volume spec has no CSI source")
+		}
+		return inlineSpec.CSI, attachment.Name, nil
+	}
+	pvName := attachment.Spec.Source.PersistentVolumeName
+	if pvName == nil || *pvName == "" {
+		return nil, "", errors.New("attachment has no volume source")
+	}
+	pv, err := c.k8s.CoreV1().PersistentVolumes().Get(context.TODO(), *pvName, metav1.GetOptions{})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get PersistentVolume %s: %v", *pvName, err)
+	}
+	if pv.Spec.CSI == nil {
+		return nil, "", fmt.Errorf("PersistentVolume %s has no CSI source", *pvName)
+	}
+	return pv.Spec.CSI, pv.Name, nil
+}
+
 // getAttachmentName returns csi-<sha256(volName,csiDriverName,NodeName)>
 func getAttachmentName(volName, csiDriverName, nodeName string) string {
 	result := sha256.Sum256([]byte(fmt.Sprintf("%s%s%s", volName, csiDriverName, nodeName)))
 	return fmt.Sprintf("csi-%x", result)
 }
 
+// GetVolumeAttachmentName returns the name of the VolumeAttachment object
+// that describes the attachment of the given CSI volume handle to the given
+// node. It lets callers fetch the attachment for a known volume directly
+// instead of listing all VolumeAttachments.
+func GetVolumeAttachmentName(volumeHandle, csiDriverName, nodeName string) string {
+	return getAttachmentName(volumeHandle, csiDriverName, nodeName)
+}
+
 func makeDeviceMountPath(plugin *csiPlugin, spec *volume.Spec) (string, error) {
 	if spec == nil {
 		return "", errors.New("makeDeviceMountPath failed, spec is nil")
@@ -150,6 +150,19 @@ func newV1NodeClient(addr csiAddr, metricsManager *MetricsManager) (nodeClient c
 	return nodeClient, conn, nil
 }
 
+// newV1NodeClientCreator returns a nodeV1ClientCreator whose connections
+// record connectivity state metrics for the given driver.
+func newV1NodeClientCreator(driverName csiDriverName) nodeV1ClientCreator {
+	return func(addr csiAddr, metricsManager *MetricsManager) (csipbv1.NodeClient, io.Closer, error) {
+		conn, err := newGrpcConn(addr, metricsManager)
+		if err != nil {
+			return nil, nil, err
+		}
+		watchConnectionState(string(driverName), conn)
+		return csipbv1.NewNodeClient(conn), conn, nil
+	}
+}
+
 func newCsiDriverClient(driverName csiDriverName) (*csiDriverClient, error) {
 	if driverName == "" {
 		return nil, fmt.Errorf("driver name is empty")
@@ -160,7 +173,7 @@ func newCsiDriverClient(driverName csiDriverName) (*csiDriverClient, error) {
 		return nil, fmt.Errorf("driver name %s not found in the list of registered CSI drivers", driverName)
 	}
 
-	nodeV1ClientCreator := newV1NodeClient
+	nodeV1ClientCreator := newV1NodeClientCreator(driverName)
 	return &csiDriverClient{
 		driverName:          driverName,
 		addr:                csiAddr(existingDriver.endpoint),
@@ -234,6 +247,9 @@ func (c *csiDriverClient) NodePublishVolume(
 		return errors.New("failed to call NodePublishVolume. nodeV1ClientCreator is nil")
 	}
 
+	done := startNodeOperation(string(c.driverName), "NodePublishVolume")
+	defer done()
+
 	accessModeMapper, err := c.getNodeV1AccessModeMapper(ctx)
 	if err != nil {
 		return err
@@ -302,6 +318,9 @@ func (c *csiDriverClient) NodeExpandVolume(ctx context.Context, opts csiResizeOp
 		return opts.newSize, errors.New("size can not be less than 0")
 	}
 
+	done := startNodeOperation(string(c.driverName), "NodeExpandVolume")
+	defer done()
+
 	accessModeMapper, err := c.getNodeV1AccessModeMapper(ctx)
 	if err != nil {
 		return opts.newSize, err
@@ -405,6 +424,9 @@ func (c *csiDriverClient) NodeStageVolume(ctx context.Context,
 		return errors.New("nodeV1ClientCreate is nil")
 	}
 
+	done := startNodeOperation(string(c.driverName), "NodeStageVolume")
+	defer done()
+
 	accessModeMapper, err := c.getNodeV1AccessModeMapper(ctx)
 	if err != nil {
 		return err
@@ -533,15 +555,17 @@ func newGrpcConn(addr csiAddr, metricsManager *MetricsManager) (*grpc.ClientConn
 	network := "unix"
 	klog.V(4).InfoS(log("creating new gRPC connection"), "protocol", network, "endpoint", addr)
 
-	return grpc.Dial(
-		string(addr),
+	opts := []grpc.DialOption{
 		grpc.WithAuthority("localhost"),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithContextDialer(func(ctx context.Context, target string) (net.Conn, error) {
 			return (&net.Dialer{}).DialContext(ctx, network, target)
 		}),
 		grpc.WithChainUnaryInterceptor(metricsManager.RecordMetricsInterceptor),
-	)
+	}
+	opts = append(opts, csiGrpcDialOptions()...)
+
+	return grpc.Dial(string(addr), opts...)
 }
 
 // CSI client getter with cache.
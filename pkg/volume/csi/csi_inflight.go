@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"sync"
+
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+	"k8s.io/kubernetes/pkg/features"
+	"k8s.io/kubernetes/pkg/volume"
+)
+
+// defaultDriverSaturationThreshold is the number of in-flight CSI node
+// operations per driver above which the driver is considered saturated and
+// non-critical operations (e.g. remount verifications) are shed. Only applied
+// with the CSIDriverLoadShedding feature gate enabled.
+const defaultDriverSaturationThreshold = 16
+
+var csiNodeOperationsInFlight = metrics.NewGaugeVec(
+	&metrics.GaugeOpts{
+		Subsystem:      "csi",
+		Name:           "node_operations_in_flight",
+		Help:           "Number of in-flight CSI node operations, partitioned by driver name and operation name",
+		StabilityLevel: metrics.ALPHA,
+	},
+	[]string{"driver_name", "operation_name"},
+)
+
+var registerInFlightMetricsOnce sync.Once
+
+// nodeOperationTracker mirrors the in-flight gauge in memory so that the
+// per-driver totals can be consulted for load shedding without scraping the
+// metrics endpoint.
+type nodeOperationTracker struct {
+	sync.Mutex
+	inFlightByDriver map[string]int
+}
+
+var inFlightTracker = &nodeOperationTracker{
+	inFlightByDriver: map[string]int{},
+}
+
+// startNodeOperation records the start of a CSI node operation for the given
+// driver and returns a function that records its completion.
+func startNodeOperation(driverName, operationName string) func() {
+	registerInFlightMetricsOnce.Do(func() {
+		legacyregistry.MustRegister(csiNodeOperationsInFlight)
+	})
+	inFlightTracker.Lock()
+	inFlightTracker.inFlightByDriver[driverName]++
+	inFlightTracker.Unlock()
+	csiNodeOperationsInFlight.WithLabelValues(driverName, operationName).Inc()
+	return func() {
+		inFlightTracker.Lock()
+		if inFlightTracker.inFlightByDriver[driverName]--; inFlightTracker.inFlightByDriver[driverName] <= 0 {
+			delete(inFlightTracker.inFlightByDriver, driverName)
+		}
+		inFlightTracker.Unlock()
+		csiNodeOperationsInFlight.WithLabelValues(driverName, operationName).Dec()
+	}
+}
+
+// driverInFlightOperations returns the number of node operations currently in
+// flight for the given driver.
+func driverInFlightOperations(driverName string) int {
+	inFlightTracker.Lock()
+	defer inFlightTracker.Unlock()
+	return inFlightTracker.inFlightByDriver[driverName]
+}
+
+// driverSaturationThreshold returns the per-driver saturation threshold, or 0
+// when load shedding is disabled.
+func driverSaturationThreshold() int {
+	if !utilfeature.DefaultFeatureGate.Enabled(features.CSIDriverLoadShedding) {
+		return 0
+	}
+	return defaultDriverSaturationThreshold
+}
+
+// ShedNonCriticalOperations returns true when the given volume belongs to a
+// CSI driver that currently has at least the configured threshold of node
+// operations in flight. Callers should then postpone operations that only
+// verify state (e.g. remounts) so that the driver can drain its queue of
+// critical mounts first.
+func ShedNonCriticalOperations(spec *volume.Spec) bool {
+	threshold := driverSaturationThreshold()
+	if threshold == 0 || spec == nil {
+		return false
+	}
+	driverName, err := GetCSIDriverName(spec)
+	if err != nil || driverName == "" {
+		return false
+	}
+	return driverInFlightOperations(driverName) >= threshold
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"testing"
+
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+	"k8s.io/kubernetes/pkg/features"
+	"k8s.io/kubernetes/pkg/volume"
+)
+
+func TestStartNodeOperation(t *testing.T) {
+	if count := driverInFlightOperations("inflight-driver"); count != 0 {
+		t.Fatalf("expected 0 in-flight operations, got %d", count)
+	}
+
+	doneStage := startNodeOperation("inflight-driver", "NodeStageVolume")
+	donePublish := startNodeOperation("inflight-driver", "NodePublishVolume")
+	if count := driverInFlightOperations("inflight-driver"); count != 2 {
+		t.Errorf("expected 2 in-flight operations, got %d", count)
+	}
+	if count := driverInFlightOperations("other-driver"); count != 0 {
+		t.Errorf("expected 0 in-flight operations for other driver, got %d", count)
+	}
+
+	doneStage()
+	donePublish()
+	if count := driverInFlightOperations("inflight-driver"); count != 0 {
+		t.Errorf("expected 0 in-flight operations after completion, got %d", count)
+	}
+}
+
+func TestShedNonCriticalOperations(t *testing.T) {
+	spec := volume.NewSpecFromPersistentVolume(makeTestPV("shed-pv", 10, "shed-driver", "shed-vol"), false)
+
+	// Disabled by default.
+	done := startNodeOperation("shed-driver", "NodeStageVolume")
+	defer done()
+	if ShedNonCriticalOperations(spec) {
+		t.Errorf("expected no shedding with the feature gate disabled")
+	}
+
+	featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, features.CSIDriverLoadShedding, true)
+	if ShedNonCriticalOperations(spec) {
+		t.Errorf("expected no shedding below the threshold")
+	}
+
+	for i := 1; i < defaultDriverSaturationThreshold; i++ {
+		doneOp := startNodeOperation("shed-driver", "NodePublishVolume")
+		defer doneOp()
+	}
+	if !ShedNonCriticalOperations(spec) {
+		t.Errorf("expected shedding at the threshold")
+	}
+
+	// Other drivers and non-CSI volumes are not shed.
+	otherSpec := volume.NewSpecFromPersistentVolume(makeTestPV("other-pv", 10, "other-driver", "other-vol"), false)
+	if ShedNonCriticalOperations(otherSpec) {
+		t.Errorf("expected no shedding for a driver without in-flight operations")
+	}
+	if ShedNonCriticalOperations(nil) {
+		t.Errorf("expected no shedding for a nil spec")
+	}
+}
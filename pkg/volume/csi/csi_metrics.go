@@ -30,6 +30,7 @@ import (
 )
 
 var _ volume.MetricsProvider = &metricsCsi{}
+var _ volume.MetricsProviderWithContext = &metricsCsi{}
 
 // metricsCsi represents a MetricsProvider that calculates the used,free and
 // capacity information for volume using volume path.
@@ -53,11 +54,17 @@ func NewMetricsCsi(volumeID string, targetPath string, driverName csiDriverName)
 }
 
 func (mc *metricsCsi) GetMetrics() (*volume.Metrics, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), csiTimeout)
+	defer cancel()
+	return mc.GetMetricsWithContext(ctx)
+}
+
+// GetMetricsWithContext is like GetMetrics, but the caller controls the
+// timeout and cancellation of the NodeGetVolumeStats call through ctx.
+func (mc *metricsCsi) GetMetricsWithContext(ctx context.Context) (*volume.Metrics, error) {
 	startTime := time.Now()
 	defer servermetrics.CollectVolumeStatCalDuration(string(mc.csiClientGetter.driverName), startTime)
 	currentTime := metav1.Now()
-	ctx, cancel := context.WithTimeout(context.Background(), csiTimeout)
-	defer cancel()
 	// Get CSI client
 	csiClient, err := mc.csiClientGetter.Get()
 	if err != nil {
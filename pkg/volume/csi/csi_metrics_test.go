@@ -17,6 +17,7 @@ limitations under the License.
 package csi
 
 import (
+	"context"
 	"io"
 	"reflect"
 	"testing"
@@ -187,3 +188,35 @@ func getRawVolumeInfo() *csipbv1.NodeGetVolumeStatsResponse {
 		},
 	}
 }
+
+// test GetMetricsWithContext with a caller-supplied context
+func TestGetMetricsWithContext(t *testing.T) {
+	metricsGetter := &metricsCsi{volumeID: "foobar", targetPath: "/mnt/foo"}
+	metricsGetter.csiClient = &csiDriverClient{
+		driverName: "com.google.gcepd",
+		nodeV1ClientCreator: func(addr csiAddr, m *MetricsManager) (csipbv1.NodeClient, io.Closer, error) {
+			nodeClient := fake.NewNodeClientWithVolumeStats(true /* VolumeStatsCapable */)
+			fakeCloser := fake.NewCloser(t)
+			nodeClient.SetNodeVolumeStatsResp(getRawVolumeInfo())
+			return nodeClient, fakeCloser, nil
+		},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), csiTimeout)
+	defer cancel()
+	metrics, err := metricsGetter.GetMetricsWithContext(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if metrics == nil {
+		t.Fatal("unexpected nil metrics")
+	}
+	expectedMetrics := getRawVolumeInfo()
+	for _, usage := range expectedMetrics.Usage {
+		if usage.Unit == csipbv1.VolumeUsage_BYTES {
+			availableBytes := resource.NewQuantity(usage.Available, resource.BinarySI)
+			if metrics.Available.Cmp(*availableBytes) != 0 {
+				t.Fatalf("error: expected :%v , got: %v", *availableBytes, *(metrics.Available))
+			}
+		}
+	}
+}
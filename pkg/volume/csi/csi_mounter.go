@@ -33,6 +33,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
 	"k8s.io/kubernetes/pkg/features"
+	kevents "k8s.io/kubernetes/pkg/kubelet/events"
 	"k8s.io/kubernetes/pkg/volume"
 	"k8s.io/kubernetes/pkg/volume/util"
 	volumetypes "k8s.io/kubernetes/pkg/volume/util/types"
@@ -49,7 +50,9 @@ var (
 		nodeName,
 		attachmentID,
 		volumeLifecycleMode,
-		seLinuxMountContext string
+		seLinuxMountContext,
+		volumeAttributes,
+		publishContext string
 	}{
 		"specVolID",
 		"volumeHandle",
@@ -58,6 +61,8 @@ var (
 		"attachmentID",
 		"volumeLifecycleMode",
 		"seLinuxMountContext",
+		"volumeAttributes",
+		"publishContext",
 	}
 )
 
@@ -193,6 +198,16 @@ func (c *csiMountMgr) SetUpAt(dir string, mounterArgs volume.MounterArgs) error
 			}
 		}
 
+		// Prefer the publish context persisted at NodeStage time, so
+		// NodePublish retries and remounts after a kubelet restart work
+		// without the API server.
+		if c.publishContext == nil && deviceMountPath != "" {
+			if persistedContext, ok := LoadPublishContext(filepath.Dir(deviceMountPath)); ok {
+				c.publishContext = persistedContext
+				publishContext = persistedContext
+			}
+		}
+
 		// search for attachment by VolumeAttachment.Spec.Source.PersistentVolumeName
 		if c.publishContext == nil {
 			nodeName := string(c.plugin.host.GetNodeName())
@@ -281,6 +296,29 @@ func (c *csiMountMgr) SetUpAt(dir string, mounterArgs volume.MounterArgs) error
 		volData[volDataKey.seLinuxMountContext] = mounterArgs.SELinuxLabel
 	}
 
+	if volSrc != nil && len(volSrc.VolumeAttributes) > 0 {
+		// Persist the spec-declared attributes of the ephemeral volume: they
+		// are not recoverable from the mount path, and reconstruction after
+		// a kubelet restart needs them to clean the volume up with the
+		// correct context. Injected pod info and service account token
+		// attributes are deliberately not persisted.
+		attribs, marshalErr := json.Marshal(volSrc.VolumeAttributes)
+		if marshalErr != nil {
+			return errors.New(log("mounter.SetUpAt failed to marshal volume attributes of %s: %v", c.spec.Name(), marshalErr))
+		}
+		volData[volDataKey.volumeAttributes] = string(attribs)
+	}
+
+	// Persist the publish context so NodePublish retries and reconstruction
+	// after a kubelet restart do not depend on the API server.
+	encodedContext, marshalErr := encodePublishContext(publishContext)
+	if marshalErr != nil {
+		return errors.New(log("mounter.SetUpAt failed to marshal publish context of %s: %v", c.spec.Name(), marshalErr))
+	}
+	if encodedContext != "" {
+		volData[volDataKey.publishContext] = encodedContext
+	}
+
 	err = saveVolumeData(parentDir, volDataFileName, volData)
 	defer func() {
 		// Only if there was an error and volume operation was considered
@@ -331,7 +369,9 @@ func (c *csiMountMgr) SetUpAt(dir string, mounterArgs volume.MounterArgs) error
 		}
 	}
 
-	if !driverSupportsCSIVolumeMountGroup && c.supportsFSGroup(fsType, mounterArgs.FsGroup, fsGroupPolicy) {
+	if driverSupportsCSIVolumeMountGroup {
+		c.recordFSGroupDecision(mounterArgs.FsGroup, true, fsGroupReasonDriverDelegated)
+	} else if applyFSGroup, reason := c.supportsFSGroup(fsType, mounterArgs.FsGroup, fsGroupPolicy); applyFSGroup {
 		// Driver doesn't support applying FSGroup. Kubelet must apply it instead.
 
 		// fullPluginName helps to distinguish different driver from csi plugin
@@ -343,7 +383,10 @@ func (c *csiMountMgr) SetUpAt(dir string, mounterArgs volume.MounterArgs) error
 			//      cleaned up.
 			return volumetypes.NewUncertainProgressError(fmt.Sprintf("applyFSGroup failed for vol %s: %v", c.volumeID, err))
 		}
+		c.recordFSGroupDecision(mounterArgs.FsGroup, true, reason)
 		klog.V(4).Info(log("mounter.SetupAt fsGroup [%d] applied successfully to %s", *mounterArgs.FsGroup, c.volumeID))
+	} else {
+		c.recordFSGroupDecision(mounterArgs.FsGroup, false, reason)
 	}
 
 	klog.V(4).Info(log("mounter.SetUp successfully requested NodePublish [%s]", dir))
@@ -409,6 +452,19 @@ func (c *csiMountMgr) GetAttributes() volume.Attributes {
 	}
 }
 
+var _ volume.OwnershipProgressReporter = &csiMountMgr{}
+
+// ReportOwnershipProgress surfaces progress of a long running recursive
+// ownership change as an event on the pod.
+func (c *csiMountMgr) ReportOwnershipProgress(completedEntries, totalEntries int) {
+	recorder := c.plugin.host.GetEventRecorder()
+	if recorder == nil || c.pod == nil {
+		return
+	}
+	recorder.Eventf(c.pod, api.EventTypeNormal, kevents.VolumeOwnershipChangeProgress,
+		"Changing ownership of volume %s: %d of %d entries completed", c.specVolumeID, completedEntries, totalEntries)
+}
+
 // volume.Unmounter methods
 var _ volume.Unmounter = &csiMountMgr{}
 
@@ -452,37 +508,45 @@ func (c *csiMountMgr) TearDownAt(dir string) error {
 	return nil
 }
 
-func (c *csiMountMgr) supportsFSGroup(fsType string, fsGroup *int64, driverPolicy storage.FSGroupPolicy) bool {
-	if fsGroup == nil || driverPolicy == storage.NoneFSGroupPolicy || c.readOnly {
-		return false
+// supportsFSGroup returns whether the kubelet must apply the pod's fsGroup to
+// the mounted volume, together with the reason for the decision.
+func (c *csiMountMgr) supportsFSGroup(fsType string, fsGroup *int64, driverPolicy storage.FSGroupPolicy) (bool, string) {
+	if fsGroup == nil {
+		return false, fsGroupReasonNoFSGroup
+	}
+	if driverPolicy == storage.NoneFSGroupPolicy {
+		return false, fsGroupReasonNonePolicy
+	}
+	if c.readOnly {
+		return false, fsGroupReasonReadOnly
 	}
 
 	if driverPolicy == storage.FileFSGroupPolicy {
-		return true
+		return true, fsGroupReasonFilePolicy
 	}
 
 	if fsType == "" {
 		klog.V(4).Info(log("mounter.SetupAt WARNING: skipping fsGroup, fsType not provided"))
-		return false
+		return false, fsGroupReasonNoFSType
 	}
 
 	if c.spec.PersistentVolume != nil {
 		if c.spec.PersistentVolume.Spec.AccessModes == nil {
 			klog.V(4).Info(log("mounter.SetupAt WARNING: skipping fsGroup, access modes not provided"))
-			return false
+			return false, fsGroupReasonNoAccessModes
 		}
 		if !hasReadWriteOnce(c.spec.PersistentVolume.Spec.AccessModes) {
 			klog.V(4).Info(log("mounter.SetupAt WARNING: skipping fsGroup, only support ReadWriteOnce access mode"))
-			return false
+			return false, fsGroupReasonNotReadWriteOnce
 		}
-		return true
+		return true, fsGroupReasonReadWriteOnce
 	} else if c.spec.Volume != nil && c.spec.Volume.CSI != nil {
 		// Inline CSI volumes are always mounted with RWO AccessMode by SetUpAt
-		return true
+		return true, fsGroupReasonInlineVolume
 	}
 
 	klog.V(4).Info(log("mounter.SetupAt WARNING: skipping fsGroup, unsupported volume type"))
-	return false
+	return false, fsGroupReasonUnsupportedType
 }
 
 // getFSGroupPolicy returns if the CSI driver supports a volume in the given mode.
@@ -594,6 +658,9 @@ func removeMountDir(plug *csiPlugin, mountPath string) error {
 		if err := os.Remove(dataFile); err != nil && !os.IsNotExist(err) {
 			return errors.New(log("failed to delete volume data file [%s]: %v", dataFile, err))
 		}
+		if err := os.Remove(dataFile + volDataBackupSuffix); err != nil && !os.IsNotExist(err) {
+			return errors.New(log("failed to delete volume data backup file [%s]: %v", dataFile+volDataBackupSuffix, err))
+		}
 		// remove volume path
 		klog.V(4).Info(log("deleting volume path [%s]", volPath))
 		if err := os.Remove(volPath); err != nil && !os.IsNotExist(err) {
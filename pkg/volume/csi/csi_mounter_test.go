@@ -675,6 +675,7 @@ func TestMounterSetUpWithInline(t *testing.T) {
 			spec: func(fsType string, options []string) *volume.Spec {
 				volSrc := makeTestVol("pv1", testDriver)
 				volSrc.CSI.FSType = &fsType
+				volSrc.CSI.VolumeAttributes = map[string]string{"foo": "bar"}
 				return volume.NewSpecFromVolume(volSrc)
 			},
 		},
@@ -792,6 +793,20 @@ func TestMounterSetUpWithInline(t *testing.T) {
 			if vol.Path != csiMounter.GetPath() {
 				t.Error("csi server may not have received NodePublishVolume call")
 			}
+
+			// Ephemeral volumes persist their spec attributes for
+			// reconstruction; persistent volumes do not.
+			data, err := loadVolumeData(filepath.Dir(csiMounter.GetPath()), volDataFileName)
+			if err != nil {
+				t.Fatalf("failed to load volume data: %v", err)
+			}
+			if tc.mode == storage.VolumeLifecycleEphemeral {
+				if data[volDataKey.volumeAttributes] != `{"foo":"bar"}` {
+					t.Errorf("unexpected persisted volume attributes: %q", data[volDataKey.volumeAttributes])
+				}
+			} else if attribs, ok := data[volDataKey.volumeAttributes]; ok {
+				t.Errorf("unexpected persisted volume attributes for persistent volume: %q", attribs)
+			}
 		})
 	}
 }
@@ -1442,7 +1457,7 @@ func Test_csiMountMgr_supportsFSGroup(t *testing.T) {
 				kubeVolHost:         tt.fields.kubeVolHost,
 				MetricsProvider:     tt.fields.MetricsProvider,
 			}
-			if got := c.supportsFSGroup(tt.args.fsType, tt.args.fsGroup, tt.args.driverPolicy); got != tt.want {
+			if got, _ := c.supportsFSGroup(tt.args.fsType, tt.args.fsGroup, tt.args.driverPolicy); got != tt.want {
 				t.Errorf("supportsFSGroup() = %v, want %v", got, tt.want)
 			}
 		})
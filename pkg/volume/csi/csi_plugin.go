@@ -18,6 +18,7 @@ package csi
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -481,7 +482,7 @@ func (p *csiPlugin) ConstructVolumeSpec(volumeName, mountPath string) (volume.Re
 	// to construct volume source spec. If mode is VolumeLifecyclePersistent,
 	// use constructPVSourceSpec to construct volume construct pv source spec.
 	if storage.VolumeLifecycleMode(volData[volDataKey.volumeLifecycleMode]) == storage.VolumeLifecycleEphemeral {
-		ret.Spec = p.constructVolSourceSpec(volData[volDataKey.specVolID], volData[volDataKey.driverName])
+		ret.Spec = p.constructVolSourceSpec(volData[volDataKey.specVolID], volData[volDataKey.driverName], volData[volDataKey.volumeAttributes])
 		return ret, nil
 	}
 
@@ -489,13 +490,24 @@ func (p *csiPlugin) ConstructVolumeSpec(volumeName, mountPath string) (volume.Re
 	return ret, nil
 }
 
-// constructVolSourceSpec constructs volume.Spec with CSIVolumeSource
-func (p *csiPlugin) constructVolSourceSpec(volSpecName, driverName string) *volume.Spec {
+// constructVolSourceSpec constructs volume.Spec with CSIVolumeSource. The
+// volume attributes persisted at publish time, if any, are restored so that
+// the reconstructed ephemeral volume is cleaned up with the same context it
+// was published with.
+func (p *csiPlugin) constructVolSourceSpec(volSpecName, driverName, volAttribsData string) *volume.Spec {
+	var volAttribs map[string]string
+	if volAttribsData != "" {
+		if err := json.Unmarshal([]byte(volAttribsData), &volAttribs); err != nil {
+			klog.Error(log("failed to parse persisted volume attributes of %s, reconstructing without them: %v", volSpecName, err))
+			volAttribs = nil
+		}
+	}
 	vol := &api.Volume{
 		Name: volSpecName,
 		VolumeSource: api.VolumeSource{
 			CSI: &api.CSIVolumeSource{
-				Driver: driverName,
+				Driver:           driverName,
+				VolumeAttributes: volAttribs,
 			},
 		},
 	}
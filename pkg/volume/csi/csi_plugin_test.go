@@ -575,6 +575,53 @@ func TestPluginConstructVolumeSpecWithInline(t *testing.T) {
 	}
 }
 
+func TestPluginConstructVolumeSpecWithInlineVolumeAttributes(t *testing.T) {
+	registerFakePlugin(testDriver, "endpoint", []string{"1.0.0"}, t)
+	driver := getTestCSIDriver(testDriver, nil, nil, []storage.VolumeLifecycleMode{storage.VolumeLifecycleEphemeral})
+	client := fakeclient.NewSimpleClientset(driver)
+	plug, tmpDir := newTestPlugin(t, client)
+	defer os.RemoveAll(tmpDir)
+
+	spec := volume.NewSpecFromVolume(makeTestVol("volspec", testDriver))
+	mounter, err := plug.NewMounter(
+		spec,
+		&api.Pod{ObjectMeta: meta.ObjectMeta{UID: types.UID(fmt.Sprintf("%08X", rand.Uint64())), Namespace: testns}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csiMounter := mounter.(*csiMountMgr)
+
+	mountPath := filepath.Dir(csiMounter.GetPath())
+	if err := os.MkdirAll(mountPath, 0755); err != nil {
+		t.Fatalf("failed to create dir for volume info file: %s", err)
+	}
+	nodeName := string(plug.host.GetNodeName())
+	volData := map[string]string{
+		volDataKey.specVolID:           spec.Name(),
+		volDataKey.volHandle:           csiMounter.volumeID,
+		volDataKey.driverName:          testDriver,
+		volDataKey.nodeName:            nodeName,
+		volDataKey.attachmentID:        getAttachmentName(csiMounter.volumeID, testDriver, nodeName),
+		volDataKey.volumeLifecycleMode: string(storage.VolumeLifecycleEphemeral),
+		volDataKey.volumeAttributes:    `{"foo":"bar"}`,
+	}
+	if err := saveVolumeData(mountPath, volDataFileName, volData); err != nil {
+		t.Fatalf("failed to save volume info file: %s", err)
+	}
+
+	rec, err := plug.ConstructVolumeSpec("test-pv", mountPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Spec == nil || rec.Spec.Volume == nil || rec.Spec.Volume.CSI == nil {
+		t.Fatal("expected a CSIVolumeSource in the reconstructed volume.Spec")
+	}
+	if rec.Spec.Volume.CSI.VolumeAttributes["foo"] != "bar" {
+		t.Errorf("expected persisted volume attributes to be restored, got %v", rec.Spec.Volume.CSI.VolumeAttributes)
+	}
+}
+
 func TestPluginNewMounter(t *testing.T) {
 	tests := []struct {
 		name                string
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"context"
+	"errors"
+
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/features"
+)
+
+// verifyCSIStagingEnabled returns whether the kubelet verifies that a staging
+// path the driver reported as staged actually holds a mount. A driver that
+// crashed mid-stage may report idempotent success on retry while the staging
+// path is still empty; without verification the volume is published from an
+// unstaged directory.
+func verifyCSIStagingEnabled() bool {
+	return utilfeature.DefaultFeatureGate.Enabled(features.CSIStagingVerification)
+}
+
+// verifyStagedMount checks that the staging path is a mount point after a
+// successful NodeStageVolume. When it is not, the stage was only partial: the
+// partial stage is cleaned up with NodeUnstageVolume and an error is returned
+// so that the operation is retried from scratch. An inconclusive mount point
+// check does not fail the operation.
+func (c *csiAttacher) verifyStagedMount(ctx context.Context, volumeHandle, deviceMountPath string) error {
+	mounter := c.plugin.host.GetMounter(CSIPluginName)
+	notMnt, err := mounter.IsLikelyNotMountPoint(deviceMountPath)
+	if err != nil {
+		klog.Warning(log("could not verify staging path %s after NodeStageVolume: %v", deviceMountPath, err))
+		return nil
+	}
+	if !notMnt {
+		return nil
+	}
+	klog.Error(log("staging path %s holds no mount after a successful NodeStageVolume, cleaning up partial stage", deviceMountPath))
+	if unstageErr := c.csiClient.NodeUnstageVolume(ctx, volumeHandle, deviceMountPath); unstageErr != nil {
+		return errors.New(log("staging path %s holds no mount after NodeStageVolume and cleaning up the partial stage failed: %v", deviceMountPath, unstageErr))
+	}
+	return errors.New(log("staging path %s holds no mount after NodeStageVolume, cleaned up partial stage for retry", deviceMountPath))
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+	"k8s.io/kubernetes/pkg/features"
+)
+
+func TestVerifyCSIStagingEnabled(t *testing.T) {
+	if verifyCSIStagingEnabled() {
+		t.Errorf("expected staging verification to be disabled by default")
+	}
+	featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, features.CSIStagingVerification, true)
+	if !verifyCSIStagingEnabled() {
+		t.Errorf("expected staging verification to be enabled")
+	}
+}
+
+func TestVerifyStagedMount(t *testing.T) {
+	plug, tmpDir := newTestPlugin(t, nil)
+	defer os.RemoveAll(tmpDir)
+
+	attacher, err := plug.NewAttacher()
+	if err != nil {
+		t.Fatalf("failed to create new attacher: %v", err)
+	}
+	csiAttacher := getCsiAttacherFromVolumeAttacher(attacher, testWatchTimeout)
+	csiAttacher.csiClient = setupClient(t, true /*stageUnstageSet*/)
+
+	// An existing staging path without a mount is a partial stage and must
+	// fail verification after cleanup.
+	stagingPath := filepath.Join(tmpDir, "staging")
+	if err := os.MkdirAll(stagingPath, 0750); err != nil {
+		t.Fatalf("failed to create staging path: %v", err)
+	}
+	if err := csiAttacher.verifyStagedMount(context.Background(), "test-vol1", stagingPath); err == nil {
+		t.Errorf("expected verification of an unmounted staging path to fail")
+	}
+
+	// An inconclusive mount point check (staging path missing) must not fail
+	// the operation.
+	if err := csiAttacher.verifyStagedMount(context.Background(), "test-vol1", filepath.Join(tmpDir, "missing")); err != nil {
+		t.Errorf("expected an inconclusive check not to fail, got %v", err)
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/volume"
+	"k8s.io/kubernetes/pkg/volume/util"
+	"k8s.io/mount-utils"
+)
+
+// volumeDevicesDirName is the name of the directory under the CSI plugin dir
+// that holds block volume metadata. It mirrors
+// config.DefaultKubeletVolumeDevicesDirName, which cannot be imported here.
+const volumeDevicesDirName = "volumeDevices"
+
+// ScavengeStaleStagingDirs scans the per-volume staging directories the CSI
+// plugin keeps under plugins/kubernetes.io/csi/<driver>/<volume-sha>/ and
+// removes those that reference no volume in use on this node, e.g. because
+// their vol_data.json was corrupted and volume reconstruction could not claim
+// them. Directories whose global mount point is still mounted are left alone.
+// The volumeInUse callback reports whether the given unique volume name is
+// still referenced by the caller's state caches; it is consulted only for
+// directories with a readable vol_data.json. When dryRun is true, directories
+// that would be removed are only logged.
+func ScavengeStaleStagingDirs(host volume.VolumeHost, mounter mount.Interface, volumeInUse func(v1.UniqueVolumeName) bool, dryRun bool) error {
+	csiDir := host.GetPluginDir(CSIPluginName)
+	driverDirs, err := os.ReadDir(csiDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read CSI plugin directory %s: %w", csiDir, err)
+	}
+	for _, driverDir := range driverDirs {
+		if !driverDir.IsDir() || driverDir.Name() == volumeDevicesDirName {
+			continue
+		}
+		driverPath := filepath.Join(csiDir, driverDir.Name())
+		volDirs, err := os.ReadDir(driverPath)
+		if err != nil {
+			klog.ErrorS(err, "Failed to read CSI driver staging directory", "path", driverPath)
+			continue
+		}
+		for _, volDir := range volDirs {
+			if !volDir.IsDir() {
+				continue
+			}
+			scavengeStagingDir(filepath.Join(driverPath, volDir.Name()), mounter, volumeInUse, dryRun)
+		}
+	}
+	return nil
+}
+
+// scavengeStagingDir removes the given staging directory if it references no
+// volume in use and nothing is mounted below it.
+func scavengeStagingDir(stagingDir string, mounter mount.Interface, volumeInUse func(v1.UniqueVolumeName) bool, dryRun bool) {
+	data, err := loadVolumeData(stagingDir, volDataFileName)
+	if err == nil {
+		volumeName := util.GetUniqueVolumeName(
+			CSIPluginName,
+			fmt.Sprintf("%s%s%s", data[volDataKey.driverName], volNameSep, data[volDataKey.volHandle]))
+		if volumeInUse(volumeName) {
+			return
+		}
+		klog.V(4).InfoS("CSI staging directory references a volume that is no longer in use", "path", stagingDir, "volumeName", volumeName)
+	} else {
+		// Without vol_data.json the directory cannot be reconstructed and
+		// would otherwise persist forever.
+		klog.V(4).InfoS("CSI staging directory has missing or corrupt vol_data.json", "path", stagingDir, "err", err)
+	}
+
+	// Never touch a directory that still has something mounted below it.
+	globalMountPath := filepath.Join(stagingDir, globalMountInGlobalPath)
+	if pathExists, err := mount.PathExists(globalMountPath); err != nil {
+		klog.ErrorS(err, "Failed to check CSI staging mount path, not removing staging directory", "path", globalMountPath)
+		return
+	} else if pathExists {
+		notMnt, err := mounter.IsLikelyNotMountPoint(globalMountPath)
+		if err != nil {
+			klog.ErrorS(err, "Failed to check CSI staging mount point, not removing staging directory", "path", globalMountPath)
+			return
+		}
+		if !notMnt {
+			klog.InfoS("CSI staging directory is unreferenced but still mounted, not removing it", "path", globalMountPath)
+			return
+		}
+	}
+
+	if dryRun {
+		klog.InfoS("Stale CSI staging directory would be removed (dry-run)", "path", stagingDir)
+		return
+	}
+	if err := os.RemoveAll(stagingDir); err != nil {
+		klog.ErrorS(err, "Failed to remove stale CSI staging directory", "path", stagingDir)
+		return
+	}
+	klog.InfoS("Removed stale CSI staging directory", "path", stagingDir)
+}
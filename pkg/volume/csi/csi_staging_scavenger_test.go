@@ -0,0 +1,112 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/kubernetes/pkg/volume/util"
+	"k8s.io/mount-utils"
+)
+
+func TestScavengeStaleStagingDirs(t *testing.T) {
+	testCases := []struct {
+		name         string
+		corruptData  bool
+		inUse        bool
+		mounted      bool
+		dryRun       bool
+		expectExists bool
+	}{
+		{
+			name:         "stale staging dir is removed",
+			expectExists: false,
+		},
+		{
+			name:         "staging dir of volume in use is kept",
+			inUse:        true,
+			expectExists: true,
+		},
+		{
+			name:         "staging dir with corrupt vol_data.json is removed",
+			corruptData:  true,
+			expectExists: false,
+		},
+		{
+			name:         "still mounted staging dir is kept",
+			mounted:      true,
+			expectExists: true,
+		},
+		{
+			name:         "dry-run keeps stale staging dir",
+			dryRun:       true,
+			expectExists: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			plug, tmpDir := newTestPlugin(t, nil)
+			defer os.RemoveAll(tmpDir)
+
+			driverName := "test-driver"
+			volumeHandle := "test-vol-handle"
+			stagingDir := filepath.Join(plug.host.GetPluginDir(CSIPluginName), driverName, "volsha")
+			globalMountPath := filepath.Join(stagingDir, globalMountInGlobalPath)
+			if err := os.MkdirAll(globalMountPath, 0750); err != nil {
+				t.Fatalf("failed to create staging dir: %v", err)
+			}
+			if tc.corruptData {
+				if err := os.WriteFile(filepath.Join(stagingDir, volDataFileName), []byte("{\"driverName\":"), 0640); err != nil {
+					t.Fatalf("failed to write corrupt vol_data.json: %v", err)
+				}
+			} else {
+				err := saveVolumeData(stagingDir, volDataFileName, map[string]string{
+					volDataKey.driverName: driverName,
+					volDataKey.volHandle:  volumeHandle,
+				})
+				if err != nil {
+					t.Fatalf("failed to write vol_data.json: %v", err)
+				}
+			}
+
+			mounter := mount.NewFakeMounter(nil)
+			if tc.mounted {
+				mounter.MountPoints = []mount.MountPoint{{Path: globalMountPath}}
+			}
+			inUseVolumeName := util.GetUniqueVolumeName(CSIPluginName, fmt.Sprintf("%s%s%s", driverName, volNameSep, volumeHandle))
+			volumeInUse := func(volumeName v1.UniqueVolumeName) bool {
+				return tc.inUse && volumeName == inUseVolumeName
+			}
+
+			err := ScavengeStaleStagingDirs(plug.host, mounter, volumeInUse, tc.dryRun)
+			if err != nil {
+				t.Fatalf("ScavengeStaleStagingDirs failed: %v", err)
+			}
+
+			_, err = os.Stat(stagingDir)
+			exists := err == nil
+			if exists != tc.expectExists {
+				t.Errorf("staging dir exists: %v, expected: %v", exists, tc.expectExists)
+			}
+		})
+	}
+}
@@ -55,24 +55,63 @@ func getCredentialsFromSecret(k8s kubernetes.Interface, secretRef *api.SecretRef
 	return credentials, nil
 }
 
-// saveVolumeData persists parameter data as json file at the provided location
+// volDataBackupSuffix is appended to the volume data file name for the backup
+// copy saveVolumeData keeps of the previously persisted data.
+const volDataBackupSuffix = ".backup"
+
+// saveVolumeData persists parameter data as json file at the provided location.
+// The file is written atomically via a temporary file and rename so that a
+// crash cannot leave a truncated file behind, and the previously persisted
+// file is kept as a backup copy for recovery.
 func saveVolumeData(dir string, fileName string, data map[string]string) error {
 	dataFilePath := filepath.Join(dir, fileName)
 	klog.V(4).Info(log("saving volume data file [%s]", dataFilePath))
-	file, err := os.Create(dataFilePath)
+	file, err := os.CreateTemp(dir, fileName)
 	if err != nil {
 		return errors.New(log("failed to save volume data file %s: %v", dataFilePath, err))
 	}
-	defer file.Close()
+	tmpFilePath := file.Name()
+	defer os.Remove(tmpFilePath) // no-op after a successful rename
 	if err := json.NewEncoder(file).Encode(data); err != nil {
+		file.Close()
+		return errors.New(log("failed to save volume data file %s: %v", dataFilePath, err))
+	}
+	if err := file.Close(); err != nil {
+		return errors.New(log("failed to save volume data file %s: %v", dataFilePath, err))
+	}
+	if _, err := os.Stat(dataFilePath); err == nil {
+		// Keep the previous file around so a corrupted write can be recovered.
+		if err := os.Rename(dataFilePath, dataFilePath+volDataBackupSuffix); err != nil {
+			klog.Error(log("failed to back up volume data file %s: %v", dataFilePath, err))
+		}
+	}
+	if err := os.Rename(tmpFilePath, dataFilePath); err != nil {
 		return errors.New(log("failed to save volume data file %s: %v", dataFilePath, err))
 	}
 	klog.V(4).Info(log("volume data file saved successfully [%s]", dataFilePath))
 	return nil
 }
 
-// loadVolumeData loads volume info from specified json file/location
+// loadVolumeData loads volume info from specified json file/location. When
+// the file is missing or corrupt, it falls back to the backup copy kept by
+// saveVolumeData.
 func loadVolumeData(dir string, fileName string) (map[string]string, error) {
+	data, err := loadVolumeDataFile(dir, fileName)
+	if err == nil {
+		return data, nil
+	}
+	backupData, backupErr := loadVolumeDataFile(dir, fileName+volDataBackupSuffix)
+	if backupErr != nil {
+		// Return the error of the primary file; the caller distinguishes a
+		// missing file from a corrupt one.
+		return nil, err
+	}
+	klog.Warning(log("volume data file [%s] is missing or corrupt, recovered from backup copy: %v", filepath.Join(dir, fileName), err))
+	return backupData, nil
+}
+
+// loadVolumeDataFile loads volume info from specified json file/location
+func loadVolumeDataFile(dir string, fileName string) (map[string]string, error) {
 	// remove /mount at the end
 	dataFileName := filepath.Join(dir, fileName)
 	klog.V(4).Info(log("loading volume data file [%s]", dataFileName))
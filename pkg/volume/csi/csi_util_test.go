@@ -19,6 +19,7 @@ package csi
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"os"
@@ -157,6 +158,55 @@ func TestSaveVolumeData(t *testing.T) {
 	}
 }
 
+func TestLoadVolumeDataRecovery(t *testing.T) {
+	plug, tmpDir := newTestPlugin(t, nil)
+	defer os.RemoveAll(tmpDir)
+
+	targetPath := getTargetPath(testPodUID, "spec-volid-recovery", plug.host)
+	mountDir := filepath.Join(targetPath, "mount")
+	if err := os.MkdirAll(mountDir, 0755); err != nil {
+		t.Fatalf("failed to create dir [%s]: %v", mountDir, err)
+	}
+
+	// Missing file and backup is reported as os.ErrNotExist.
+	if _, err := loadVolumeData(targetPath, volDataFileName); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected os.ErrNotExist for missing data file, got: %v", err)
+	}
+
+	// Saving twice keeps the previous data as a backup copy.
+	oldData := map[string]string{"key0": "val0"}
+	newData := map[string]string{"key0": "val1"}
+	if err := saveVolumeData(targetPath, volDataFileName, oldData); err != nil {
+		t.Fatalf("failed to save volume data: %v", err)
+	}
+	if err := saveVolumeData(targetPath, volDataFileName, newData); err != nil {
+		t.Fatalf("failed to save volume data: %v", err)
+	}
+	backupFile := filepath.Join(targetPath, volDataFileName+volDataBackupSuffix)
+	if _, err := os.Stat(backupFile); err != nil {
+		t.Fatalf("expected backup file to exist: %v", err)
+	}
+	data, err := loadVolumeData(targetPath, volDataFileName)
+	if err != nil {
+		t.Fatalf("failed to load volume data: %v", err)
+	}
+	if data["key0"] != "val1" {
+		t.Errorf("expected data from primary file, got %v", data)
+	}
+
+	// A truncated primary file falls back to the backup copy.
+	if err := os.WriteFile(filepath.Join(targetPath, volDataFileName), []byte("{\"key0\":"), 0644); err != nil {
+		t.Fatalf("failed to corrupt data file: %v", err)
+	}
+	data, err = loadVolumeData(targetPath, volDataFileName)
+	if err != nil {
+		t.Fatalf("failed to load volume data from backup: %v", err)
+	}
+	if data["key0"] != "val0" {
+		t.Errorf("expected data recovered from backup, got %v", data)
+	}
+}
+
 func TestCreateCSIOperationContext(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"k8s.io/klog/v2"
+
+	api "k8s.io/api/core/v1"
+	kevents "k8s.io/kubernetes/pkg/kubelet/events"
+	"k8s.io/kubernetes/pkg/volume/util"
+)
+
+// Reasons why the pod's fsGroup was or wasn't applied to a mounted volume.
+// They label the volume_fsgroup_decisions_total metric, so a silent skip,
+// a frequent source of permission-denied tickets, can be traced back to the
+// driver declaration or heuristic that caused it.
+const (
+	// fsGroupReasonDriverDelegated: the driver supports VOLUME_MOUNT_GROUP
+	// and applies the fsGroup itself during NodePublish.
+	fsGroupReasonDriverDelegated = "driver-delegated"
+	// fsGroupReasonFilePolicy: the driver declares the File fsGroupPolicy.
+	fsGroupReasonFilePolicy = "file-fsgroup-policy"
+	// fsGroupReasonReadWriteOnce: the default heuristic matched, the volume
+	// has a filesystem and the ReadWriteOnce access mode.
+	fsGroupReasonReadWriteOnce = "read-write-once"
+	// fsGroupReasonInlineVolume: inline CSI volumes are always mounted with
+	// the ReadWriteOnce access mode.
+	fsGroupReasonInlineVolume = "inline-volume"
+
+	// fsGroupReasonNoFSGroup: the pod does not request an fsGroup.
+	fsGroupReasonNoFSGroup = "no-fsgroup"
+	// fsGroupReasonNonePolicy: the driver declares the None fsGroupPolicy.
+	fsGroupReasonNonePolicy = "none-fsgroup-policy"
+	// fsGroupReasonReadOnly: the volume is mounted read-only.
+	fsGroupReasonReadOnly = "read-only"
+	// fsGroupReasonNoFSType: the volume does not declare a filesystem type.
+	fsGroupReasonNoFSType = "no-fstype"
+	// fsGroupReasonNoAccessModes: the PV does not declare access modes.
+	fsGroupReasonNoAccessModes = "no-access-modes"
+	// fsGroupReasonNotReadWriteOnce: the PV lacks the ReadWriteOnce access
+	// mode, so the volume may be shared and ownership is left alone.
+	fsGroupReasonNotReadWriteOnce = "not-read-write-once"
+	// fsGroupReasonUnsupportedType: neither a PV nor an inline CSI volume.
+	fsGroupReasonUnsupportedType = "unsupported-volume-type"
+)
+
+// recordFSGroupDecision surfaces why the pod's fsGroup was or wasn't applied
+// to the mounted volume, as a counter metric and an event on the pod. Pods
+// without an fsGroup made no decision and record nothing.
+func (c *csiMountMgr) recordFSGroupDecision(fsGroup *int64, applied bool, reason string) {
+	if fsGroup == nil {
+		return
+	}
+	fullPluginName := util.GetFullQualifiedPluginNameForVolume(CSIPluginName, c.spec)
+	util.RecordFSGroupDecisionMetric(fullPluginName, applied, reason)
+	klog.V(4).Info(log("mounter.SetupAt fsGroup decision for %s: applied=%t reason=%s", c.volumeID, applied, reason))
+
+	recorder := c.plugin.host.GetEventRecorder()
+	if recorder == nil || c.pod == nil {
+		return
+	}
+	if applied {
+		recorder.Eventf(c.pod, api.EventTypeNormal, kevents.VolumeFSGroupApplied,
+			"Applied fsGroup %d to volume %s (%s)", *fsGroup, c.specVolumeID, reason)
+	} else {
+		recorder.Eventf(c.pod, api.EventTypeNormal, kevents.VolumeFSGroupSkipped,
+			"Skipped applying fsGroup %d to volume %s (%s)", *fsGroup, c.specVolumeID, reason)
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	storage "k8s.io/api/storage/v1"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubernetes/pkg/volume"
+)
+
+func Test_csiMountMgr_fsGroupDecisionReasons(t *testing.T) {
+	fsGroup := new(int64)
+	rwoPV := &volume.Spec{
+		PersistentVolume: &corev1.PersistentVolume{
+			ObjectMeta: meta.ObjectMeta{Name: "rwo-pv"},
+			Spec: corev1.PersistentVolumeSpec{
+				AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			},
+		},
+	}
+	rwxPV := &volume.Spec{
+		PersistentVolume: &corev1.PersistentVolume{
+			ObjectMeta: meta.ObjectMeta{Name: "rwx-pv"},
+			Spec: corev1.PersistentVolumeSpec{
+				AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		spec           *volume.Spec
+		readOnly       bool
+		fsType         string
+		fsGroup        *int64
+		driverPolicy   storage.FSGroupPolicy
+		expectedApply  bool
+		expectedReason string
+	}{
+		{
+			name:           "no fsGroup",
+			spec:           rwoPV,
+			fsType:         "ext4",
+			driverPolicy:   storage.ReadWriteOnceWithFSTypeFSGroupPolicy,
+			expectedApply:  false,
+			expectedReason: fsGroupReasonNoFSGroup,
+		},
+		{
+			name:           "driver declares None policy",
+			spec:           rwoPV,
+			fsType:         "ext4",
+			fsGroup:        fsGroup,
+			driverPolicy:   storage.NoneFSGroupPolicy,
+			expectedApply:  false,
+			expectedReason: fsGroupReasonNonePolicy,
+		},
+		{
+			name:           "read-only volume",
+			spec:           rwoPV,
+			readOnly:       true,
+			fsType:         "ext4",
+			fsGroup:        fsGroup,
+			driverPolicy:   storage.ReadWriteOnceWithFSTypeFSGroupPolicy,
+			expectedApply:  false,
+			expectedReason: fsGroupReasonReadOnly,
+		},
+		{
+			name:           "driver declares File policy",
+			spec:           rwxPV,
+			fsGroup:        fsGroup,
+			driverPolicy:   storage.FileFSGroupPolicy,
+			expectedApply:  true,
+			expectedReason: fsGroupReasonFilePolicy,
+		},
+		{
+			name:           "no fsType",
+			spec:           rwoPV,
+			fsGroup:        fsGroup,
+			driverPolicy:   storage.ReadWriteOnceWithFSTypeFSGroupPolicy,
+			expectedApply:  false,
+			expectedReason: fsGroupReasonNoFSType,
+		},
+		{
+			name:           "RWX heuristic skips shared volume",
+			spec:           rwxPV,
+			fsType:         "ext4",
+			fsGroup:        fsGroup,
+			driverPolicy:   storage.ReadWriteOnceWithFSTypeFSGroupPolicy,
+			expectedApply:  false,
+			expectedReason: fsGroupReasonNotReadWriteOnce,
+		},
+		{
+			name:           "ReadWriteOnce volume with fsType",
+			spec:           rwoPV,
+			fsType:         "ext4",
+			fsGroup:        fsGroup,
+			driverPolicy:   storage.ReadWriteOnceWithFSTypeFSGroupPolicy,
+			expectedApply:  true,
+			expectedReason: fsGroupReasonReadWriteOnce,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			c := &csiMountMgr{
+				spec:     test.spec,
+				readOnly: test.readOnly,
+			}
+			apply, reason := c.supportsFSGroup(test.fsType, test.fsGroup, test.driverPolicy)
+			if apply != test.expectedApply || reason != test.expectedReason {
+				t.Errorf("supportsFSGroup() = (%v, %q), want (%v, %q)", apply, reason, test.expectedApply, test.expectedReason)
+			}
+		})
+	}
+}
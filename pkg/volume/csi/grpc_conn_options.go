@@ -0,0 +1,92 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/keepalive"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+	"k8s.io/kubernetes/pkg/features"
+)
+
+const (
+	// defaultCSIGrpcKeepaliveTime is how long a driver connection may sit
+	// idle before the CSI node client pings it. A driver socket that stops
+	// responding is then torn down and re-dialed instead of blocking
+	// operations until their timeout.
+	defaultCSIGrpcKeepaliveTime = 30 * time.Second
+
+	// defaultCSIGrpcKeepaliveTimeout is how long the client waits for a
+	// keepalive ping response before considering the connection dead.
+	defaultCSIGrpcKeepaliveTimeout = 20 * time.Second
+)
+
+var (
+	registerConnectionMetricsOnce sync.Once
+
+	csiGrpcConnectionTransitions = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Name:           "csi_grpc_connection_state_transitions_total",
+			Help:           "The number of gRPC connectivity state transitions of kubelet connections to CSI driver sockets, partitioned by driver name and the state entered.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"driver_name", "state"},
+	)
+)
+
+// csiGrpcDialOptions returns the extra dial options for driver connections:
+// client keepalive when the CSIGRPCKeepalive feature gate is enabled.
+func csiGrpcDialOptions() []grpc.DialOption {
+	var opts []grpc.DialOption
+	if utilfeature.DefaultFeatureGate.Enabled(features.CSIGRPCKeepalive) {
+		opts = append(opts, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                defaultCSIGrpcKeepaliveTime,
+			Timeout:             defaultCSIGrpcKeepaliveTimeout,
+			PermitWithoutStream: true,
+		}))
+	}
+	return opts
+}
+
+// watchConnectionState records the connectivity state transitions of the
+// given driver connection until it shuts down.
+func watchConnectionState(driverName string, conn *grpc.ClientConn) {
+	if driverName == "" {
+		return
+	}
+	registerConnectionMetricsOnce.Do(func() {
+		legacyregistry.MustRegister(csiGrpcConnectionTransitions)
+	})
+	go func() {
+		state := conn.GetState()
+		csiGrpcConnectionTransitions.WithLabelValues(driverName, state.String()).Inc()
+		for state != connectivity.Shutdown {
+			if !conn.WaitForStateChange(context.Background(), state) {
+				return
+			}
+			state = conn.GetState()
+			csiGrpcConnectionTransitions.WithLabelValues(driverName, state.String()).Inc()
+		}
+	}()
+}
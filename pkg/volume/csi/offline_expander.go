@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"errors"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/volume"
+	"k8s.io/kubernetes/pkg/volume/util"
+)
+
+// OfflineNodeExpander is implemented by volume plugins that can finish a
+// pending filesystem expansion for a volume no pod on the node has mounted.
+type OfflineNodeExpander interface {
+	OfflineNodeExpand(spec *volume.Spec, newSize resource.Quantity) (bool, error)
+}
+
+var _ OfflineNodeExpander = &csiPlugin{}
+
+// OfflineNodeExpand expands the filesystem of a CSI volume without a running
+// pod. NodeExpandVolume needs a path the filesystem is reachable at, so when
+// the volume is not already staged it is staged into its regular device mount
+// path for the duration of the expansion and unstaged again afterwards. The
+// volume must still be attached to this node; staging reads the publish
+// context from the VolumeAttachment.
+func (c *csiPlugin) OfflineNodeExpand(spec *volume.Spec, newSize resource.Quantity) (bool, error) {
+	if spec == nil || spec.PersistentVolume == nil {
+		return false, errors.New(log("Expander.OfflineNodeExpand requires a PersistentVolume backed spec"))
+	}
+	fsVolume, err := util.CheckVolumeModeFilesystem(spec)
+	if err != nil {
+		return false, errors.New(log("Expander.OfflineNodeExpand failed to check VolumeMode of source: %v", err))
+	}
+	if !fsVolume {
+		// Raw block volumes have no node-side filesystem to grow; the larger
+		// device is visible as soon as a pod maps it again.
+		return false, errors.New(log("Expander.OfflineNodeExpand only supports filesystem volumes"))
+	}
+
+	deviceMountPath, err := makeDeviceMountPath(c, spec)
+	if err != nil {
+		return false, errors.New(log("Expander.OfflineNodeExpand failed to make device mount path: %v", err))
+	}
+	staged, err := isDirMounted(c, deviceMountPath)
+	if err != nil {
+		return false, errors.New(log("Expander.OfflineNodeExpand failed to check staging path %s: %v", deviceMountPath, err))
+	}
+
+	if !staged {
+		deviceMounter, err := c.NewDeviceMounter()
+		if err != nil {
+			return false, err
+		}
+		if err := deviceMounter.MountDevice(spec, "" /* devicePath, unused by CSI */, deviceMountPath, volume.DeviceMounterArgs{}); err != nil {
+			return false, errors.New(log("Expander.OfflineNodeExpand failed to stage volume: %v", err))
+		}
+		klog.V(4).Info(log("Expander.OfflineNodeExpand staged volume temporarily at %s", deviceMountPath))
+	}
+
+	resized, expandErr := c.NodeExpand(volume.NodeResizeOptions{
+		VolumeSpec:      spec,
+		NewSize:         newSize,
+		DeviceMountPath: deviceMountPath,
+		DeviceStagePath: deviceMountPath,
+	})
+
+	if !staged {
+		deviceUnmounter, err := c.NewDeviceUnmounter()
+		if err == nil {
+			err = deviceUnmounter.UnmountDevice(deviceMountPath)
+		}
+		if err != nil {
+			if expandErr == nil {
+				return resized, errors.New(log("Expander.OfflineNodeExpand failed to unstage volume after expansion: %v", err))
+			}
+			klog.Error(log("Expander.OfflineNodeExpand failed to unstage volume after failed expansion: %v", err))
+		}
+	}
+	return resized, expandErr
+}
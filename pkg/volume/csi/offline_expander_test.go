@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	fakeclient "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/kubernetes/pkg/volume"
+)
+
+func TestOfflineNodeExpandRejectsInvalidSpecs(t *testing.T) {
+	plug, tmpDir := newTestPlugin(t, fakeclient.NewSimpleClientset())
+	defer os.RemoveAll(tmpDir)
+
+	newSize := resource.MustParse("10Gi")
+
+	if _, err := plug.OfflineNodeExpand(nil, newSize); err == nil {
+		t.Errorf("expected error for nil spec")
+	}
+
+	inlineSpec := volume.NewSpecFromVolume(&api.Volume{
+		Name: "inline",
+		VolumeSource: api.VolumeSource{
+			CSI: &api.CSIVolumeSource{Driver: testDriver},
+		},
+	})
+	if _, err := plug.OfflineNodeExpand(inlineSpec, newSize); err == nil {
+		t.Errorf("expected error for spec without a PersistentVolume")
+	}
+
+	blockMode := api.PersistentVolumeBlock
+	pv := makeTestPV("block-pv", 10, testDriver, "block-vol")
+	pv.Spec.VolumeMode = &blockMode
+	blockSpec := volume.NewSpecFromPersistentVolume(pv, false)
+	_, err := plug.OfflineNodeExpand(blockSpec, newSize)
+	if err == nil || !strings.Contains(err.Error(), "filesystem") {
+		t.Errorf("expected filesystem-only error for block volume, got: %v", err)
+	}
+}
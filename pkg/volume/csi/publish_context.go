@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"encoding/json"
+
+	"k8s.io/klog/v2"
+)
+
+// The publish context returned by ControllerPublishVolume is persisted in the
+// volume data file next to the staged volume and in each pod's volume
+// directory. NodeStage/NodePublish retries and reconstruction after a kubelet
+// restart read it from there when the live VolumeAttachment cannot be
+// fetched, so remounts keep working while the API server is unreachable. The
+// live VolumeAttachment stays authoritative whenever it can be read.
+
+// encodePublishContext returns the JSON encoding of the given publish
+// context, or the empty string when there is nothing to persist.
+func encodePublishContext(publishContext map[string]string) (string, error) {
+	if len(publishContext) == 0 {
+		return "", nil
+	}
+	encoded, err := json.Marshal(publishContext)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
+
+// LoadPublishContext returns the publish context persisted in the volume data
+// file in dataDir. It returns false when no publish context was persisted
+// there or the file cannot be read, in which case the caller falls back to
+// the VolumeAttachment object.
+func LoadPublishContext(dataDir string) (map[string]string, bool) {
+	data, err := loadVolumeData(dataDir, volDataFileName)
+	if err != nil {
+		return nil, false
+	}
+	encoded, ok := data[volDataKey.publishContext]
+	if !ok || encoded == "" {
+		return nil, false
+	}
+	publishContext := map[string]string{}
+	if err := json.Unmarshal([]byte(encoded), &publishContext); err != nil {
+		klog.Error(log("failed to parse persisted publish context in %s: %v", dataDir, err))
+		return nil, false
+	}
+	return publishContext, true
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package csi
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPublishContextRoundTrip(t *testing.T) {
+	publishContext := map[string]string{
+		"devicePath": "/dev/xvdba",
+		"lun":        "3",
+	}
+
+	encoded, err := encodePublishContext(publishContext)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	dataDir := t.TempDir()
+	volData := map[string]string{
+		volDataKey.volHandle:      "foobar",
+		volDataKey.driverName:     "com.simple.SimpleDriver",
+		volDataKey.publishContext: encoded,
+	}
+	if err := saveVolumeData(dataDir, volDataFileName, volData); err != nil {
+		t.Fatalf("failed to save volume data: %v", err)
+	}
+
+	loaded, ok := LoadPublishContext(dataDir)
+	if !ok {
+		t.Fatal("expected persisted publish context to be found")
+	}
+	if !reflect.DeepEqual(publishContext, loaded) {
+		t.Errorf("expected publish context %v, got %v", publishContext, loaded)
+	}
+}
+
+func TestLoadPublishContextAbsent(t *testing.T) {
+	dataDir := t.TempDir()
+
+	// No volume data file at all.
+	if _, ok := LoadPublishContext(dataDir); ok {
+		t.Error("expected no publish context without a volume data file")
+	}
+
+	// Volume data file without a persisted publish context.
+	volData := map[string]string{
+		volDataKey.volHandle:  "foobar",
+		volDataKey.driverName: "com.simple.SimpleDriver",
+	}
+	if err := saveVolumeData(dataDir, volDataFileName, volData); err != nil {
+		t.Fatalf("failed to save volume data: %v", err)
+	}
+	if _, ok := LoadPublishContext(dataDir); ok {
+		t.Error("expected no publish context when none was persisted")
+	}
+}
+
+func TestEncodePublishContextEmpty(t *testing.T) {
+	encoded, err := encodePublishContext(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if encoded != "" {
+		t.Errorf("expected empty encoding for nil publish context, got %q", encoded)
+	}
+}
@@ -168,6 +168,27 @@ func (plugin *emptyDirPlugin) newMounterInternal(spec *volume.Spec, pod *v1.Pod,
 	}, nil
 }
 
+// ResizeMemoryBackedVolume remounts the tmpfs backing a memory-medium
+// emptyDir with the size limit derived from the pod's current memory
+// allocation. It is called when the pod's memory limits change in place so
+// the volume cannot outgrow the resized pod.
+func (plugin *emptyDirPlugin) ResizeMemoryBackedVolume(spec *volume.Spec, pod *v1.Pod) error {
+	return plugin.resizeMemoryBackedVolumeInternal(spec, pod, plugin.host.GetMounter(plugin.GetPluginName()), &realMountDetector{plugin.host.GetMounter(plugin.GetPluginName())})
+}
+
+func (plugin *emptyDirPlugin) resizeMemoryBackedVolumeInternal(spec *volume.Spec, pod *v1.Pod, mounter mount.Interface, mountDetector mountDetector) error {
+	if spec.Volume == nil || spec.Volume.EmptyDir == nil || spec.Volume.EmptyDir.Medium != v1.StorageMediumMemory {
+		return nil
+	}
+	// The mounter recomputes the size limit from the pod's current resources.
+	volMounter, err := plugin.newMounterInternal(spec, pod, mounter, mountDetector)
+	if err != nil {
+		return err
+	}
+	ed := volMounter.(*emptyDir)
+	return ed.resizeTmpfs(ed.GetPath())
+}
+
 func (plugin *emptyDirPlugin) NewUnmounter(volName string, podUID types.UID) (volume.Unmounter, error) {
 	// Inject real implementations here, test through the internal function.
 	return plugin.newUnmounterInternal(volName, podUID, plugin.host.GetMounter(plugin.GetPluginName()), &realMountDetector{plugin.host.GetMounter(plugin.GetPluginName())})
@@ -337,11 +358,35 @@ func (ed *emptyDir) setupTmpfs(dir string) error {
 	}
 
 	options := ed.generateTmpfsMountOptions(swap.IsTmpfsNoswapOptionSupported(ed.mounter, ed.plugin.host.GetPluginDir(emptyDirPluginName)))
+	options = volumeutil.AppendHardenedMountOptions(nil, options)
 
 	klog.V(3).Infof("pod %v: mounting tmpfs for volume %v", ed.pod.UID, ed.volName)
 	return ed.mounter.MountSensitiveWithoutSystemd("tmpfs", dir, "tmpfs", options, nil)
 }
 
+// resizeTmpfs remounts the tmpfs at the specified directory with the current
+// size limit. It is a no-op when the volume was not set up yet, in which case
+// SetUp will mount with the new size, or when no size limit applies.
+func (ed *emptyDir) resizeTmpfs(dir string) error {
+	if ed.mounter == nil {
+		return fmt.Errorf("memory storage requested, but mounter is nil")
+	}
+	medium, isMnt, _, err := ed.mountDetector.GetMountMedium(dir, ed.medium)
+	if err != nil {
+		return err
+	}
+	if !isMnt || medium != v1.StorageMediumMemory {
+		return nil
+	}
+	if ed.sizeLimit == nil || ed.sizeLimit.Value() <= 0 {
+		return nil
+	}
+
+	options := []string{"remount", fmt.Sprintf("size=%d", ed.sizeLimit.Value())}
+	klog.V(3).Infof("pod %v: remounting tmpfs for volume %v with size %v", ed.pod.UID, ed.volName, ed.sizeLimit.String())
+	return ed.mounter.MountSensitiveWithoutSystemd("tmpfs", dir, "tmpfs", options, nil)
+}
+
 // setupHugepages creates a hugepage mount at the specified directory.
 func (ed *emptyDir) setupHugepages(dir string) error {
 	if ed.mounter == nil {
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -955,6 +956,105 @@ func TestGetPageSize(t *testing.T) {
 	}
 }
 
+type recordingMounter struct {
+	*mount.FakeMounter
+	sensitiveWithoutSystemdOptions [][]string
+}
+
+func (rm *recordingMounter) MountSensitiveWithoutSystemd(source string, target string, fstype string, options []string, sensitiveOptions []string) error {
+	rm.sensitiveWithoutSystemdOptions = append(rm.sensitiveWithoutSystemdOptions, options)
+	return rm.FakeMounter.MountSensitiveWithoutSystemd(source, target, fstype, options, sensitiveOptions)
+}
+
+func TestResizeTmpfs(t *testing.T) {
+	sizeLimit := resource.MustParse("64Mi")
+	zero := resource.MustParse("0")
+
+	testCases := map[string]struct {
+		sizeLimit       *resource.Quantity
+		medium          v1.StorageMedium
+		isMount         bool
+		expectedRemount bool
+	}{
+		"mounted volume is remounted with the new size": {
+			sizeLimit:       &sizeLimit,
+			medium:          v1.StorageMediumMemory,
+			isMount:         true,
+			expectedRemount: true,
+		},
+		"volume that was not set up yet is left alone": {
+			sizeLimit: &sizeLimit,
+			medium:    v1.StorageMediumMemory,
+		},
+		"volume without a size limit is left alone": {
+			sizeLimit: &zero,
+			medium:    v1.StorageMediumMemory,
+			isMount:   true,
+		},
+	}
+
+	for testCaseName, testCase := range testCases {
+		t.Run(testCaseName, func(t *testing.T) {
+			physicalMounter := &recordingMounter{FakeMounter: mount.NewFakeMounter(nil)}
+			emptyDirObj := emptyDir{
+				pod:           &v1.Pod{ObjectMeta: metav1.ObjectMeta{UID: types.UID("poduid")}},
+				volName:       "test-volume",
+				medium:        v1.StorageMediumMemory,
+				sizeLimit:     testCase.sizeLimit,
+				mounter:       physicalMounter,
+				mountDetector: &fakeMountDetector{medium: testCase.medium, isMount: testCase.isMount},
+			}
+
+			if err := emptyDirObj.resizeTmpfs("/fake/dir"); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !testCase.expectedRemount {
+				if len(physicalMounter.GetLog()) != 0 {
+					t.Errorf("Expected no mounter calls, got %v", physicalMounter.GetLog())
+				}
+				return
+			}
+			log := physicalMounter.GetLog()
+			if len(log) != 1 || log[0].Action != mount.FakeActionMount || log[0].FSType != "tmpfs" {
+				t.Fatalf("Expected a single tmpfs mount, got %v", log)
+			}
+			expectedOptions := []string{"remount", fmt.Sprintf("size=%d", testCase.sizeLimit.Value())}
+			if len(physicalMounter.sensitiveWithoutSystemdOptions) != 1 ||
+				!reflect.DeepEqual(expectedOptions, physicalMounter.sensitiveWithoutSystemdOptions[0]) {
+				t.Errorf("Expected mount options %v, got %v", expectedOptions, physicalMounter.sensitiveWithoutSystemdOptions)
+			}
+		})
+	}
+}
+
+func TestResizeMemoryBackedVolumeIgnoresOtherMediums(t *testing.T) {
+	tmpDir, err := utiltesting.MkTmpdir("emptydirTest")
+	if err != nil {
+		t.Fatalf("can't make a temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+	plug := makePluginUnderTest(t, "kubernetes.io/empty-dir", tmpDir)
+
+	spec := &v1.Volume{
+		Name:         "test-volume",
+		VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{Medium: v1.StorageMediumDefault}},
+	}
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{UID: types.UID("poduid")}}
+	physicalMounter := mount.NewFakeMounter(nil)
+
+	err = plug.(*emptyDirPlugin).resizeMemoryBackedVolumeInternal(volume.NewSpecFromVolume(spec),
+		pod,
+		physicalMounter,
+		&fakeMountDetector{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(physicalMounter.GetLog()) != 0 {
+		t.Errorf("Expected no mounter calls for a disk-backed volume, got %v", physicalMounter.GetLog())
+	}
+}
+
 func TestCalculateEmptyDirMemorySize(t *testing.T) {
 	testCases := map[string]struct {
 		pod                   *v1.Pod
@@ -19,7 +19,9 @@ package hostpath
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"regexp"
+	"strings"
 
 	"k8s.io/klog/v2"
 
@@ -75,6 +77,10 @@ var _ volume.ProvisionableVolumePlugin = &hostPathPlugin{}
 
 const (
 	hostPathPluginName = "kubernetes.io/host-path"
+
+	// defaultProvisioningRoot is the directory under which dynamically
+	// provisioned hostPath volumes are created when no root is configured.
+	defaultProvisioningRoot = "/tmp/hostpath_pv"
 )
 
 func (plugin *hostPathPlugin) Init(host volume.VolumeHost) error {
@@ -171,7 +177,7 @@ func (plugin *hostPathPlugin) Recycle(pvName string, spec *volume.Spec, eventRec
 }
 
 func (plugin *hostPathPlugin) NewDeleter(logger klog.Logger, spec *volume.Spec) (volume.Deleter, error) {
-	return newDeleter(spec, plugin.host)
+	return newDeleter(spec, plugin.host, plugin.config.ProvisioningRootDir)
 }
 
 func (plugin *hostPathPlugin) NewProvisioner(logger klog.Logger, options volume.VolumeOptions) (volume.Provisioner, error) {
@@ -195,16 +201,20 @@ func (plugin *hostPathPlugin) ConstructVolumeSpec(volumeName, mountPath string)
 	}, nil
 }
 
-func newDeleter(spec *volume.Spec, host volume.VolumeHost) (volume.Deleter, error) {
+func newDeleter(spec *volume.Spec, host volume.VolumeHost, provisioningRoot string) (volume.Deleter, error) {
 	if spec.PersistentVolume != nil && spec.PersistentVolume.Spec.HostPath == nil {
 		return nil, fmt.Errorf("spec.PersistentVolumeSource.HostPath is nil")
 	}
 	path := spec.PersistentVolume.Spec.HostPath.Path
-	return &hostPathDeleter{name: spec.Name(), path: path, host: host}, nil
+	return &hostPathDeleter{name: spec.Name(), path: path, host: host, provisioningRoot: provisioningRoot}, nil
 }
 
 func newProvisioner(options volume.VolumeOptions, host volume.VolumeHost, plugin *hostPathPlugin) (volume.Provisioner, error) {
-	return &hostPathProvisioner{options: options, host: host, plugin: plugin, basePath: "hostpath_pv"}, nil
+	basePath := plugin.config.ProvisioningRootDir
+	if basePath == "" {
+		basePath = defaultProvisioningRoot
+	}
+	return &hostPathProvisioner{options: options, host: host, plugin: plugin, basePath: basePath}, nil
 }
 
 // HostPath volumes represent a bare host file or directory mount.
@@ -280,7 +290,8 @@ func (c *hostPathUnmounter) TearDownAt(dir string) error {
 }
 
 // hostPathProvisioner implements a Provisioner for the HostPath plugin
-// This implementation is meant for testing only and only works in a single node cluster.
+// This implementation is meant for single-node and CI clusters only and only
+// works on the node the controller manager runs on.
 type hostPathProvisioner struct {
 	host     volume.VolumeHost
 	options  volume.VolumeOptions
@@ -288,14 +299,15 @@ type hostPathProvisioner struct {
 	basePath string
 }
 
-// Create for hostPath simply creates a local /tmp/%/%s directory as a new PersistentVolume, default /tmp/hostpath_pv/%s.
+// Provision creates a per-PV directory under the configured provisioning root
+// (default /tmp/hostpath_pv) and returns it as a new PersistentVolume.
 // This Provisioner is meant for development and testing only and WILL NOT WORK in a multi-node cluster.
 func (r *hostPathProvisioner) Provision(selectedNode *v1.Node, allowedTopologies []v1.TopologySelectorTerm) (*v1.PersistentVolume, error) {
 	if util.CheckPersistentVolumeClaimModeBlock(r.options.PVC) {
 		return nil, fmt.Errorf("%s does not support block volume provisioning", r.plugin.GetPluginName())
 	}
 
-	fullpath := fmt.Sprintf("/tmp/%s/%s", r.basePath, uuid.NewUUID())
+	fullpath := filepath.Join(r.basePath, string(uuid.NewUUID()))
 
 	capacity := r.options.PVC.Spec.Resources.Requests[v1.ResourceName(v1.ResourceStorage)]
 	pv := &v1.PersistentVolume{
@@ -338,9 +350,10 @@ func (r *hostPathProvisioner) Provision(selectedNode *v1.Node, allowedTopologies
 // hostPathDeleter deletes a hostPath PV from the cluster.
 // This deleter only works on a single host cluster and is for testing purposes only.
 type hostPathDeleter struct {
-	name string
-	path string
-	host volume.VolumeHost
+	name             string
+	path             string
+	host             volume.VolumeHost
+	provisioningRoot string
 	volume.MetricsNil
 }
 
@@ -348,17 +361,33 @@ func (r *hostPathDeleter) GetPath() string {
 	return r.path
 }
 
-// Delete for hostPath removes the local directory so long as it is beneath /tmp/*.
+// Delete for hostPath removes the local directory so long as it is beneath
+// the configured provisioning root or /tmp/*.
 // THIS IS FOR TESTING AND LOCAL DEVELOPMENT ONLY!  This message should scare you away from using
 // this deleter for anything other than development and testing.
 func (r *hostPathDeleter) Delete() error {
-	regexp := regexp.MustCompile("/tmp/.+")
-	if !regexp.MatchString(r.GetPath()) {
-		return fmt.Errorf("host_path deleter only supports /tmp/.+ but received provided %s", r.GetPath())
+	if !r.pathInProvisioningRoot() {
+		regexp := regexp.MustCompile("/tmp/.+")
+		if !regexp.MatchString(r.GetPath()) {
+			return fmt.Errorf("host_path deleter only supports the configured provisioning root or /tmp/.+ but received provided %s", r.GetPath())
+		}
 	}
 	return os.RemoveAll(r.GetPath())
 }
 
+// pathInProvisioningRoot returns true if the deleter's path is a strict
+// subdirectory of the configured provisioning root.
+func (r *hostPathDeleter) pathInProvisioningRoot() bool {
+	if r.provisioningRoot == "" {
+		return false
+	}
+	rel, err := filepath.Rel(r.provisioningRoot, r.GetPath())
+	if err != nil {
+		return false
+	}
+	return rel != "." && !strings.HasPrefix(rel, "..")
+}
+
 func getVolumeSource(spec *volume.Spec) (*v1.HostPathVolumeSource, bool, error) {
 	if spec.Volume != nil && spec.Volume.HostPath != nil {
 		return spec.Volume.HostPath, spec.ReadOnly, nil
@@ -19,6 +19,8 @@ package hostpath
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	v1 "k8s.io/api/core/v1"
@@ -154,9 +156,41 @@ func TestDeleterTempDir(t *testing.T) {
 	}
 }
 
+func TestDeleterProvisioningRoot(t *testing.T) {
+	// Paths under a configured provisioning root are deletable even when the
+	// root lies outside /tmp; everything else keeps the /tmp/.+ restriction.
+	// None of these paths exist, so deletion succeeds without touching disk.
+	root := "/var/lib/hostpath-pvs"
+	tests := map[string]struct {
+		expectedFailure bool
+		path            string
+	}{
+		"root-itself":    {true, root},
+		"under-root":     {false, filepath.Join(root, "pv-1")},
+		"outside-root":   {true, "/var/lib/elsewhere"},
+		"tmp-unaffected": {false, "/tmp/scratch"},
+	}
+	logger, _ := ktesting.NewTestContext(t)
+	for name, test := range tests {
+		plugMgr := volume.VolumePluginMgr{}
+		plugMgr.InitPlugins(ProbeVolumePlugins(volume.VolumeConfig{ProvisioningRootDir: root}), nil /* prober */, volumetest.NewFakeKubeletVolumeHost(t, "/tmp/fake", nil, nil))
+		spec := &volume.Spec{PersistentVolume: &v1.PersistentVolume{Spec: v1.PersistentVolumeSpec{PersistentVolumeSource: v1.PersistentVolumeSource{HostPath: &v1.HostPathVolumeSource{Path: test.path}}}}}
+		plug, _ := plugMgr.FindDeletablePluginBySpec(spec)
+		deleter, _ := plug.NewDeleter(logger, spec)
+		err := deleter.Delete()
+		if err == nil && test.expectedFailure {
+			t.Errorf("Expected failure for test '%s' but got nil err", name)
+		}
+		if err != nil && !test.expectedFailure {
+			t.Errorf("Unexpected failure for test '%s': %v", name, err)
+		}
+	}
+}
+
 func TestProvisioner(t *testing.T) {
+	root := t.TempDir()
 	plugMgr := volume.VolumePluginMgr{}
-	plugMgr.InitPlugins(ProbeVolumePlugins(volume.VolumeConfig{ProvisioningEnabled: true}),
+	plugMgr.InitPlugins(ProbeVolumePlugins(volume.VolumeConfig{ProvisioningEnabled: true, ProvisioningRootDir: root}),
 		nil,
 		volumetest.NewFakeKubeletVolumeHost(t, "/tmp/fake", nil, nil))
 	plug, err := plugMgr.FindProvisionablePluginByName(hostPathPluginName)
@@ -177,7 +211,6 @@ func TestProvisioner(t *testing.T) {
 	if !ok {
 		t.Fatal("Not a hostPathProvisioner")
 	}
-	hostPathCreator.basePath = fmt.Sprintf("%s.%s", "hostPath_pv", uuid.NewUUID())
 
 	pv, err := hostPathCreator.Provision(nil, nil)
 	if err != nil {
@@ -186,6 +219,9 @@ func TestProvisioner(t *testing.T) {
 	if pv.Spec.HostPath.Path == "" {
 		t.Errorf("Expected pv.Spec.HostPath.Path to not be empty: %#v", pv)
 	}
+	if !strings.HasPrefix(pv.Spec.HostPath.Path, root+string(filepath.Separator)) {
+		t.Errorf("Expected pv.Spec.HostPath.Path to be under %s but got %s", root, pv.Spec.HostPath.Path)
+	}
 	expectedCapacity := resource.NewQuantity(1*1024*1024*1024, resource.BinarySI)
 	actualCapacity := pv.Spec.Capacity[v1.ResourceStorage]
 	expectedAmt := expectedCapacity.Value()
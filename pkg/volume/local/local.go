@@ -346,7 +346,11 @@ func (dm *deviceMounter) mountLocalBlockDevice(spec *volume.Spec, devicePath str
 		options = append(options, "ro")
 	}
 	mountOptions := util.MountOptionFromSpec(spec, options...)
-	err = dm.mounter.FormatAndMount(devicePath, deviceMountPath, fstype, mountOptions)
+	mismatchPolicy, err := util.GetFsTypeMismatchPolicy(spec.PersistentVolume)
+	if err != nil {
+		return err
+	}
+	err = util.FormatAndMountWithMismatchPolicy(dm.mounter, devicePath, deviceMountPath, fstype, mountOptions, mismatchPolicy)
 	if err != nil {
 		if rmErr := os.Remove(deviceMountPath); rmErr != nil {
 			klog.Warningf("local: failed to remove %s: %v", deviceMountPath, rmErr)
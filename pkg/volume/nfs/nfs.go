@@ -212,9 +212,10 @@ var _ volume.Mounter = &nfsMounter{}
 
 func (nfsMounter *nfsMounter) GetAttributes() volume.Attributes {
 	return volume.Attributes{
-		ReadOnly:       nfsMounter.readOnly,
-		Managed:        false,
-		SELinuxRelabel: false,
+		ReadOnly:         nfsMounter.readOnly,
+		Managed:          false,
+		SELinuxRelabel:   false,
+		SharedFilesystem: true,
 	}
 }
 
@@ -267,6 +268,14 @@ func (nfsMounter *nfsMounter) SetUpAt(dir string, mounterArgs volume.MounterArgs
 		os.Remove(dir)
 		return err
 	}
+	if !nfsMounter.readOnly && mounterArgs.FsGroup != nil {
+		// fsGroup is applied best-effort: when the server denies ownership
+		// changes, e.g. with root squash, the change is skipped with a
+		// single summary instead of failing the mount.
+		if err := volume.SetVolumeOwnership(nfsMounter, dir, mounterArgs.FsGroup, mounterArgs.FSGroupChangePolicy, util.FSGroupCompleteHook(nfsMounter.plugin, nil)); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,33 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volume
+
+import "strings"
+
+// ownershipCheckpointSuffix names the progress marker persisted next to a
+// volume while a recursive ownership change is running, so that a change
+// interrupted by a kubelet restart resumes instead of starting over.
+const ownershipCheckpointSuffix = ".fsgroup-progress"
+
+// IsOwnershipCheckpointFile returns true when the given directory entry name
+// is the progress marker of a recursive ownership change (see
+// SetVolumeOwnership). The marker is a plain file written next to the volume
+// directory, so code that enumerates volume directories on disk must skip it
+// rather than treat it as a volume.
+func IsOwnershipCheckpointFile(name string) bool {
+	return strings.HasPrefix(name, ".") && strings.HasSuffix(name, ownershipCheckpointSuffix)
+}
@@ -255,6 +255,29 @@ type ExpandableVolumePlugin interface {
 	RequiresFSResize() bool
 }
 
+// ShutdownUnmountSkippingVolumePlugin is an extended interface of VolumePlugin
+// and is used for volumes whose device mounts may be safely left in place
+// while the node shuts down, provided filesystem buffers have been flushed to
+// disk first.
+type ShutdownUnmountSkippingVolumePlugin interface {
+	DeviceMountableVolumePlugin
+	// SafeToSkipUnmountOnShutdown returns true if the device mount for the
+	// given spec does not need to be unmounted before the node powers off.
+	SafeToSkipUnmountOnShutdown(spec *Spec) bool
+}
+
+// MemoryBackedVolumeResizerPlugin is an extended interface of VolumePlugin
+// and is used for volumes backed by node memory whose size limit is derived
+// from the pod's memory allocation. When that allocation changes through
+// in-place pod resize, the kubelet calls ResizeMemoryBackedVolume so mounted
+// volumes pick up the new limit.
+type MemoryBackedVolumeResizerPlugin interface {
+	VolumePlugin
+	// ResizeMemoryBackedVolume updates the mounted volume for the given spec
+	// to the size limit implied by the pod's current memory allocation.
+	ResizeMemoryBackedVolume(spec *Spec, pod *v1.Pod) error
+}
+
 // NodeExpandableVolumePlugin is an expanded interface of VolumePlugin and is used for volumes that
 // require expansion on the node via NodeExpand call.
 type NodeExpandableVolumePlugin interface {
@@ -538,6 +561,10 @@ type VolumeConfig struct {
 	// ProvisioningEnabled configures whether provisioning of this plugin is
 	// enabled or not. Currently used only in host_path plugin.
 	ProvisioningEnabled bool
+
+	// ProvisioningRootDir is the directory under which dynamically provisioned
+	// volumes are created. Currently used only in host_path plugin.
+	ProvisioningRootDir string
 }
 
 // ReconstructedVolume contains information about a volume reconstructed by
@@ -954,6 +981,18 @@ func (pm *VolumePluginMgr) FindNodeExpandablePluginByName(name string) (NodeExpa
 	return nil, nil
 }
 
+// FindMemoryBackedVolumeResizerPluginBySpec fetches a memory-backed volume resizer plugin by spec
+func (pm *VolumePluginMgr) FindMemoryBackedVolumeResizerPluginBySpec(spec *Spec) (MemoryBackedVolumeResizerPlugin, error) {
+	volumePlugin, err := pm.FindPluginBySpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	if resizerPlugin, ok := volumePlugin.(MemoryBackedVolumeResizerPlugin); ok {
+		return resizerPlugin, nil
+	}
+	return nil, nil
+}
+
 // Run starts the volume plugin manager, initializing and running the necessary
 // tasks for managing volume plugins. This method is typically called to begin
 // the plugin management lifecycle.
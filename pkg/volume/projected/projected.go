@@ -18,6 +18,7 @@ package projected
 
 import (
 	"fmt"
+	"time"
 
 	authenticationv1 "k8s.io/api/authentication/v1"
 	v1 "k8s.io/api/core/v1"
@@ -237,6 +238,12 @@ func (s *projectedVolumeMounter) SetUpAt(dir string, mounterArgs volume.MounterA
 		return err
 	}
 
+	volumeutil.RecordPayloadRefresh(volumeutil.PayloadRefreshKey{
+		PodUID:     s.pod.UID,
+		VolumeName: s.volName,
+		PluginName: projectedPluginName,
+	}, s.pod.Namespace, s.pod.Name, time.Now())
+
 	setupSuccess = true
 	return nil
 }
@@ -411,6 +418,11 @@ func (c *projectedVolumeUnmounter) TearDownAt(dir string) error {
 		return err
 	}
 
+	volumeutil.ForgetPayloadRefresh(volumeutil.PayloadRefreshKey{
+		PodUID:     c.podUID,
+		VolumeName: c.volName,
+		PluginName: projectedPluginName,
+	})
 	c.plugin.deleteServiceAccountToken(c.podUID)
 	return nil
 }
@@ -0,0 +1,48 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package removed
+
+import (
+	"sync"
+
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+)
+
+var registerMetrics sync.Once
+
+var removedPluginMountAttemptsMetric = metrics.NewCounterVec(
+	&metrics.CounterOpts{
+		Name:           "removed_in_tree_plugin_mount_attempts_total",
+		Help:           "Total number of mount attempts against removed in-tree volume plugins, partitioned by plugin name",
+		StabilityLevel: metrics.ALPHA,
+	},
+	[]string{"plugin_name"})
+
+// registerRemovedPluginMetrics registers the mount attempt counter with the
+// legacy registry. It is called when the shim plugins are probed.
+func registerRemovedPluginMetrics() {
+	registerMetrics.Do(func() {
+		legacyregistry.MustRegister(removedPluginMountAttemptsMetric)
+	})
+}
+
+// RecordRemovedPluginMountAttempt counts a mount attempt against the removed
+// in-tree plugin with the given name.
+func RecordRemovedPluginMountAttempt(pluginName string) {
+	removedPluginMountAttemptsMetric.WithLabelValues(pluginName).Inc()
+}
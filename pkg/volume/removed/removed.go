@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package removed provides shim plugins for in-tree volume plugins that were
+// removed from Kubernetes. The shims claim the legacy volume sources so that
+// pods still referencing them fail with explicit migration guidance and a
+// per-plugin attempt metric, instead of an unhelpful "no volume plugin
+// matched" error.
+package removed
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/kubernetes/pkg/volume"
+)
+
+// removedPlugin is a shim for one removed in-tree plugin. It supports the
+// legacy volume source of the plugin but fails every mount with migration
+// guidance.
+type removedPlugin struct {
+	pluginName string
+	// guidance completes the error message of failed mounts, telling the
+	// admin how to move off the removed plugin.
+	guidance string
+	// matches reports whether the given spec uses the legacy volume source
+	// of the removed plugin.
+	matches func(spec *volume.Spec) bool
+}
+
+var _ volume.VolumePlugin = &removedPlugin{}
+
+// ProbeVolumePlugins returns shims for all removed in-tree plugins whose
+// volume sources are still part of the API.
+func ProbeVolumePlugins() []volume.VolumePlugin {
+	registerRemovedPluginMetrics()
+	return []volume.VolumePlugin{
+		&removedPlugin{
+			pluginName: "kubernetes.io/glusterfs",
+			guidance:   "the in-tree glusterfs plugin was removed in Kubernetes 1.26; migrate the data to a volume backed by a CSI driver",
+			matches: func(spec *volume.Spec) bool {
+				return (spec.Volume != nil && spec.Volume.Glusterfs != nil) ||
+					(spec.PersistentVolume != nil && spec.PersistentVolume.Spec.Glusterfs != nil)
+			},
+		},
+		&removedPlugin{
+			pluginName: "kubernetes.io/rbd",
+			guidance:   "the in-tree rbd plugin was removed in Kubernetes 1.31; use the rbd.csi.ceph.com CSI driver instead",
+			matches: func(spec *volume.Spec) bool {
+				return (spec.Volume != nil && spec.Volume.RBD != nil) ||
+					(spec.PersistentVolume != nil && spec.PersistentVolume.Spec.RBD != nil)
+			},
+		},
+		&removedPlugin{
+			pluginName: "kubernetes.io/flocker",
+			guidance:   "the in-tree flocker plugin was removed in Kubernetes 1.25; migrate the data to a volume backed by a CSI driver",
+			matches: func(spec *volume.Spec) bool {
+				return (spec.Volume != nil && spec.Volume.Flocker != nil) ||
+					(spec.PersistentVolume != nil && spec.PersistentVolume.Spec.Flocker != nil)
+			},
+		},
+		&removedPlugin{
+			pluginName: "kubernetes.io/quobyte",
+			guidance:   "the in-tree quobyte plugin was removed in Kubernetes 1.25; use the quobyte CSI driver instead",
+			matches: func(spec *volume.Spec) bool {
+				return (spec.Volume != nil && spec.Volume.Quobyte != nil) ||
+					(spec.PersistentVolume != nil && spec.PersistentVolume.Spec.Quobyte != nil)
+			},
+		},
+		&removedPlugin{
+			pluginName: "kubernetes.io/scaleio",
+			guidance:   "the in-tree scaleio plugin was removed in Kubernetes 1.22; migrate the data to a volume backed by a CSI driver",
+			matches: func(spec *volume.Spec) bool {
+				return (spec.Volume != nil && spec.Volume.ScaleIO != nil) ||
+					(spec.PersistentVolume != nil && spec.PersistentVolume.Spec.ScaleIO != nil)
+			},
+		},
+		&removedPlugin{
+			pluginName: "kubernetes.io/storageos",
+			guidance:   "the in-tree storageos plugin was removed in Kubernetes 1.25; migrate the data to a volume backed by a CSI driver",
+			matches: func(spec *volume.Spec) bool {
+				return (spec.Volume != nil && spec.Volume.StorageOS != nil) ||
+					(spec.PersistentVolume != nil && spec.PersistentVolume.Spec.StorageOS != nil)
+			},
+		},
+	}
+}
+
+func (p *removedPlugin) Init(host volume.VolumeHost) error {
+	return nil
+}
+
+func (p *removedPlugin) GetPluginName() string {
+	return p.pluginName
+}
+
+func (p *removedPlugin) GetVolumeName(spec *volume.Spec) (string, error) {
+	return spec.Name(), nil
+}
+
+func (p *removedPlugin) CanSupport(spec *volume.Spec) bool {
+	return spec != nil && p.matches(spec)
+}
+
+func (p *removedPlugin) RequiresRemount(spec *volume.Spec) bool {
+	return false
+}
+
+// NewMounter always fails: the plugin backing the volume source no longer
+// exists. The error surfaces in the FailedMount event of the pod together
+// with the migration guidance, and the attempt is counted per plugin.
+func (p *removedPlugin) NewMounter(spec *volume.Spec, pod *v1.Pod) (volume.Mounter, error) {
+	RecordRemovedPluginMountAttempt(p.pluginName)
+	return nil, p.removedError()
+}
+
+func (p *removedPlugin) NewUnmounter(name string, podUID types.UID) (volume.Unmounter, error) {
+	return nil, p.removedError()
+}
+
+func (p *removedPlugin) ConstructVolumeSpec(volumeName, mountPath string) (volume.ReconstructedVolume, error) {
+	return volume.ReconstructedVolume{}, p.removedError()
+}
+
+func (p *removedPlugin) SupportsMountOption() bool {
+	return false
+}
+
+func (p *removedPlugin) SupportsSELinuxContextMount(spec *volume.Spec) (bool, error) {
+	return false, nil
+}
+
+func (p *removedPlugin) removedError() error {
+	return fmt.Errorf("volume plugin %s is no longer available: %s", p.pluginName, p.guidance)
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package removed
+
+import (
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/kubernetes/pkg/volume"
+)
+
+func findPlugin(t *testing.T, pluginName string) volume.VolumePlugin {
+	t.Helper()
+	for _, plugin := range ProbeVolumePlugins() {
+		if plugin.GetPluginName() == pluginName {
+			return plugin
+		}
+	}
+	t.Fatalf("no shim found for plugin %q", pluginName)
+	return nil
+}
+
+func TestRemovedPluginCanSupport(t *testing.T) {
+	glusterfsVolume := volume.NewSpecFromVolume(&v1.Volume{
+		Name: "legacy",
+		VolumeSource: v1.VolumeSource{
+			Glusterfs: &v1.GlusterfsVolumeSource{EndpointsName: "ep", Path: "path"},
+		},
+	})
+	rbdPV := volume.NewSpecFromPersistentVolume(&v1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "pv"},
+		Spec: v1.PersistentVolumeSpec{
+			PersistentVolumeSource: v1.PersistentVolumeSource{
+				RBD: &v1.RBDPersistentVolumeSource{CephMonitors: []string{"mon"}, RBDImage: "image"},
+			},
+		},
+	}, false)
+	nfsVolume := volume.NewSpecFromVolume(&v1.Volume{
+		Name: "supported",
+		VolumeSource: v1.VolumeSource{
+			NFS: &v1.NFSVolumeSource{Server: "server", Path: "/share"},
+		},
+	})
+
+	glusterfs := findPlugin(t, "kubernetes.io/glusterfs")
+	if !glusterfs.CanSupport(glusterfsVolume) {
+		t.Errorf("expected the glusterfs shim to claim glusterfs volumes")
+	}
+	if glusterfs.CanSupport(rbdPV) || glusterfs.CanSupport(nfsVolume) {
+		t.Errorf("expected the glusterfs shim to claim only glusterfs volumes")
+	}
+	rbd := findPlugin(t, "kubernetes.io/rbd")
+	if !rbd.CanSupport(rbdPV) {
+		t.Errorf("expected the rbd shim to claim rbd persistent volumes")
+	}
+	for _, plugin := range ProbeVolumePlugins() {
+		if plugin.CanSupport(nfsVolume) {
+			t.Errorf("shim %q must not claim supported volume sources", plugin.GetPluginName())
+		}
+	}
+}
+
+func TestRemovedPluginMountFailsWithGuidance(t *testing.T) {
+	spec := volume.NewSpecFromVolume(&v1.Volume{
+		Name: "legacy",
+		VolumeSource: v1.VolumeSource{
+			RBD: &v1.RBDVolumeSource{CephMonitors: []string{"mon"}, RBDImage: "image"},
+		},
+	})
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "ns", UID: "poduid"}}
+
+	plugin := findPlugin(t, "kubernetes.io/rbd")
+	mounter, err := plugin.NewMounter(spec, pod)
+	if mounter != nil {
+		t.Errorf("expected no mounter from a removed plugin shim, got %v", mounter)
+	}
+	if err == nil {
+		t.Fatalf("expected an error from NewMounter of a removed plugin shim")
+	}
+	if !strings.Contains(err.Error(), "rbd.csi.ceph.com") {
+		t.Errorf("expected migration guidance in the error, got %q", err.Error())
+	}
+}
@@ -19,6 +19,7 @@ package secret
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"k8s.io/klog/v2"
 	"k8s.io/mount-utils"
@@ -250,6 +251,12 @@ func (b *secretVolumeMounter) SetUpAt(dir string, mounterArgs volume.MounterArgs
 		return err
 	}
 
+	volumeutil.RecordPayloadRefresh(volumeutil.PayloadRefreshKey{
+		PodUID:     b.pod.UID,
+		VolumeName: b.volName,
+		PluginName: secretPluginName,
+	}, b.pod.Namespace, b.pod.Name, time.Now())
+
 	setupSuccess = true
 	return nil
 }
@@ -314,6 +321,11 @@ func (c *secretVolumeUnmounter) TearDown() error {
 }
 
 func (c *secretVolumeUnmounter) TearDownAt(dir string) error {
+	volumeutil.ForgetPayloadRefresh(volumeutil.PayloadRefreshKey{
+		PodUID:     c.podUID,
+		VolumeName: c.volName,
+		PluginName: secretPluginName,
+	})
 	return volumeutil.UnmountViaEmptyDir(dir, c.plugin.host, c.volName, wrappedVolumeSpec(), c.podUID)
 }
 
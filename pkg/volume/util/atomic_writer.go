@@ -29,6 +29,8 @@ import (
 	"k8s.io/klog/v2"
 
 	"k8s.io/apimachinery/pkg/util/sets"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/kubernetes/pkg/features"
 )
 
 const (
@@ -196,7 +198,15 @@ func (w *AtomicWriter) Write(payload map[string]FileProjection, setPerms func(su
 		tsDirName := filepath.Base(tsDir)
 
 		// (6)
-		if err = w.writePayloadToDir(cleanPayload, tsDir); err != nil {
+		// When the AtomicWriterHardlinks feature is enabled, unchanged files
+		// are hardlinked from the previous version instead of being written
+		// again, which avoids I/O spikes on updates of projections with many
+		// unchanged keys.
+		linkDir := ""
+		if len(oldTsDir) != 0 && utilfeature.DefaultFeatureGate.Enabled(features.AtomicWriterHardlinks) {
+			linkDir = oldTsPath
+		}
+		if err = w.writePayloadToDir(cleanPayload, tsDir, linkDir); err != nil {
 			klog.Errorf("%s: error writing payload to ts data directory %s: %v", w.logContext, tsDir, err)
 			return err
 		}
@@ -415,8 +425,10 @@ func (w *AtomicWriter) newTimestampDir() (string, error) {
 }
 
 // writePayloadToDir writes the given payload to the given directory.  The
-// directory must exist.
-func (w *AtomicWriter) writePayloadToDir(payload map[string]FileProjection, dir string) error {
+// directory must exist.  If linkDir is non-empty, files whose content, mode
+// and ownership are unchanged are hardlinked from the same path under linkDir
+// instead of being written again.
+func (w *AtomicWriter) writePayloadToDir(payload map[string]FileProjection, dir, linkDir string) error {
 	for userVisiblePath, fileProjection := range payload {
 		content := fileProjection.Data
 		mode := os.FileMode(fileProjection.Mode)
@@ -428,6 +440,10 @@ func (w *AtomicWriter) writePayloadToDir(payload map[string]FileProjection, dir
 			return err
 		}
 
+		if linkDir != "" && w.linkUnchangedFile(linkDir, dir, userVisiblePath, fileProjection) {
+			continue
+		}
+
 		if err := os.WriteFile(fullPath, content, mode); err != nil {
 			klog.Errorf("%s: unable to write file %s with mode %v: %v", w.logContext, fullPath, mode, err)
 			return err
@@ -454,6 +470,32 @@ func (w *AtomicWriter) writePayloadToDir(payload map[string]FileProjection, dir
 	return nil
 }
 
+// linkUnchangedFile hardlinks the file at the user-visible path from the
+// previous payload directory into the new one if its content, mode and
+// ownership would not change, sparing the write.  It returns false when the
+// file has to be written the regular way.
+func (w *AtomicWriter) linkUnchangedFile(oldDir, newDir, userVisiblePath string, fileProjection FileProjection) bool {
+	if fileProjection.FsUser != nil {
+		// A chown on a hardlinked file would also change the old, still
+		// published version of the file.
+		return false
+	}
+	oldPath := filepath.Join(oldDir, userVisiblePath)
+	info, err := os.Lstat(oldPath)
+	if err != nil || !info.Mode().IsRegular() || info.Mode().Perm() != os.FileMode(fileProjection.Mode).Perm() {
+		return false
+	}
+	contentOnFs, err := os.ReadFile(oldPath)
+	if err != nil || !bytes.Equal(contentOnFs, fileProjection.Data) {
+		return false
+	}
+	if err := os.Link(oldPath, filepath.Join(newDir, userVisiblePath)); err != nil {
+		klog.V(4).Infof("%s: unable to hardlink unchanged file %s, writing a new copy: %v", w.logContext, userVisiblePath, err)
+		return false
+	}
+	return true
+}
+
 // createUserVisibleFiles creates the relative symlinks for all the
 // files configured in the payload. If the directory in a file path does not
 // exist, it is created.
@@ -26,10 +26,14 @@ import (
 	"path/filepath"
 	"reflect"
 	"strings"
+	"syscall"
 	"testing"
 
 	"k8s.io/apimachinery/pkg/util/sets"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
 	utiltesting "k8s.io/client-go/util/testing"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+	"k8s.io/kubernetes/pkg/features"
 )
 
 func TestNewAtomicWriter(t *testing.T) {
@@ -1093,3 +1097,48 @@ func TestWriteAgainAfterUnexpectedExit(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteHardlinksUnchangedFiles(t *testing.T) {
+	featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, features.AtomicWriterHardlinks, true)
+
+	targetDir, err := utiltesting.MkTmpdir("atomic-write")
+	if err != nil {
+		t.Fatalf("unexpected error creating tmp dir: %v", err)
+	}
+	defer os.RemoveAll(targetDir)
+
+	writer := &AtomicWriter{targetDir: targetDir, logContext: "-test-"}
+	firstPayload := map[string]FileProjection{
+		"unchanged": {Mode: 0644, Data: []byte("stable")},
+		"changed":   {Mode: 0644, Data: []byte("old")},
+	}
+	if err := writer.Write(firstPayload, nil); err != nil {
+		t.Fatalf("unexpected error writing payload: %v", err)
+	}
+
+	inode := func(name string) uint64 {
+		info, err := os.Stat(filepath.Join(targetDir, name))
+		if err != nil {
+			t.Fatalf("unexpected error checking file %v: %v", name, err)
+		}
+		return info.Sys().(*syscall.Stat_t).Ino
+	}
+	unchangedInode := inode("unchanged")
+	changedInode := inode("changed")
+
+	secondPayload := map[string]FileProjection{
+		"unchanged": {Mode: 0644, Data: []byte("stable")},
+		"changed":   {Mode: 0644, Data: []byte("new")},
+	}
+	if err := writer.Write(secondPayload, nil); err != nil {
+		t.Fatalf("unexpected error writing payload: %v", err)
+	}
+
+	checkVolumeContents(targetDir, "-test-", secondPayload, t)
+	if got := inode("unchanged"); got != unchangedInode {
+		t.Errorf("expected the unchanged file to be hardlinked to the previous version, got inode %v instead of %v", got, unchangedInode)
+	}
+	if got := inode("changed"); got == changedInode {
+		t.Errorf("expected the changed file to be written anew, got the previous inode %v", got)
+	}
+}
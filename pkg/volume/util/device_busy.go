@@ -0,0 +1,53 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"errors"
+	"strings"
+	"syscall"
+)
+
+// VolumePathHolder describes a process that keeps a volume path busy and
+// thereby prevents it from being unmounted.
+type VolumePathHolder struct {
+	// Pid is the process id of the holder.
+	Pid int
+	// Command is the process command line (or comm when the command line is
+	// not readable).
+	Command string
+	// MountNamespace identifies the mount namespace of the holder, e.g.
+	// "mnt:[4026531840]".
+	MountNamespace string
+	// PodOwned is true when the process cgroup indicates it belongs to the
+	// pod whose UID was passed to FindVolumePathHolders.
+	PodOwned bool
+}
+
+// IsDeviceBusyError returns true when err indicates that an unmount failed
+// because the target is still in use.
+func IsDeviceBusyError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, syscall.EBUSY) {
+		return true
+	}
+	// Mount helpers frequently wrap the umount(2) error into the output of
+	// the umount binary, losing the errno along the way.
+	return strings.Contains(err.Error(), "device or resource busy")
+}
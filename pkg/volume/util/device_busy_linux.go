@@ -0,0 +1,124 @@
+//go:build linux
+// +build linux
+
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// FindVolumePathHolders scans /proc for processes that hold the given path
+// open — via a file descriptor, their working directory or their root — and
+// would therefore make an unmount of the path fail with EBUSY. When podUID is
+// non-empty, holders whose cgroup path contains the pod UID are flagged as
+// owned by that pod. The scan is best-effort: processes that exit or that the
+// kubelet may not inspect are silently skipped.
+func FindVolumePathHolders(path string, podUID types.UID) ([]VolumePathHolder, error) {
+	procEntries, err := os.ReadDir("/proc")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read /proc: %v", err)
+	}
+
+	var holders []VolumePathHolder
+	for _, entry := range procEntries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+		procDir := filepath.Join("/proc", entry.Name())
+		if !processHoldsPath(procDir, path) {
+			continue
+		}
+		holder := VolumePathHolder{
+			Pid:     pid,
+			Command: processCommand(procDir),
+		}
+		if ns, err := os.Readlink(filepath.Join(procDir, "ns", "mnt")); err == nil {
+			holder.MountNamespace = ns
+		}
+		if podUID != "" {
+			holder.PodOwned = processBelongsToPod(procDir, podUID)
+		}
+		holders = append(holders, holder)
+	}
+	return holders, nil
+}
+
+// KillVolumePathHolder sends SIGKILL to the given holder process.
+func KillVolumePathHolder(pid int) error {
+	return syscall.Kill(pid, syscall.SIGKILL)
+}
+
+// processHoldsPath returns true when the process behind procDir has the given
+// path (or something under it) open, or uses it as working directory or root.
+func processHoldsPath(procDir, path string) bool {
+	for _, link := range []string{"cwd", "root"} {
+		if target, err := os.Readlink(filepath.Join(procDir, link)); err == nil && pathIsUnder(path, target) {
+			return true
+		}
+	}
+	fdDir := filepath.Join(procDir, "fd")
+	fds, err := os.ReadDir(fdDir)
+	if err != nil {
+		return false
+	}
+	for _, fd := range fds {
+		if target, err := os.Readlink(filepath.Join(fdDir, fd.Name())); err == nil && pathIsUnder(path, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// pathIsUnder returns true when target equals path or is located below it.
+func pathIsUnder(path, target string) bool {
+	return target == path || strings.HasPrefix(target, path+string(os.PathSeparator))
+}
+
+// processCommand returns the command line of the process, falling back to the
+// comm name when cmdline is empty or unreadable.
+func processCommand(procDir string) string {
+	if cmdline, err := os.ReadFile(filepath.Join(procDir, "cmdline")); err == nil && len(cmdline) > 0 {
+		return strings.TrimRight(strings.ReplaceAll(string(cmdline), "\x00", " "), " ")
+	}
+	if comm, err := os.ReadFile(filepath.Join(procDir, "comm")); err == nil {
+		return strings.TrimSpace(string(comm))
+	}
+	return ""
+}
+
+// processBelongsToPod returns true when the process cgroup path references the
+// pod UID. Cgroup drivers encode the UID either verbatim or with dashes
+// replaced by underscores (systemd), so both forms are checked.
+func processBelongsToPod(procDir string, podUID types.UID) bool {
+	cgroups, err := os.ReadFile(filepath.Join(procDir, "cgroup"))
+	if err != nil {
+		return false
+	}
+	content := string(cgroups)
+	return strings.Contains(content, string(podUID)) ||
+		strings.Contains(content, strings.ReplaceAll(string(podUID), "-", "_"))
+}
@@ -0,0 +1,97 @@
+//go:build linux
+// +build linux
+
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+)
+
+func TestIsDeviceBusyError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "nil error", err: nil, expected: false},
+		{name: "EBUSY errno", err: syscall.EBUSY, expected: true},
+		{name: "wrapped EBUSY", err: fmt.Errorf("unmount failed: %w", syscall.EBUSY), expected: true},
+		{name: "umount binary output", err: fmt.Errorf("umount: /target: device or resource busy"), expected: true},
+		{name: "other error", err: fmt.Errorf("no such file or directory"), expected: false},
+	}
+	for _, tc := range testCases {
+		if got := IsDeviceBusyError(tc.err); got != tc.expected {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.expected, got)
+		}
+	}
+}
+
+func TestFindVolumePathHolders(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "device-busy-test")
+	if err != nil {
+		t.Fatalf("failed to create tmp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Hold a file under the path open so this test process shows up as a
+	// holder.
+	file, err := os.Create(filepath.Join(tmpDir, "held"))
+	if err != nil {
+		t.Fatalf("failed to create file: %v", err)
+	}
+	defer file.Close()
+
+	holders, err := FindVolumePathHolders(tmpDir, "")
+	if err != nil {
+		t.Fatalf("FindVolumePathHolders failed: %v", err)
+	}
+	found := false
+	for _, holder := range holders {
+		if holder.Pid == os.Getpid() {
+			found = true
+			if holder.Command == "" {
+				t.Errorf("expected non-empty command for holder %+v", holder)
+			}
+			if holder.MountNamespace == "" {
+				t.Errorf("expected non-empty mount namespace for holder %+v", holder)
+			}
+			if holder.PodOwned {
+				t.Errorf("expected PodOwned to be false without a pod UID, got %+v", holder)
+			}
+		}
+	}
+	if !found {
+		t.Errorf("expected current process (pid %d) in holders, got %+v", os.Getpid(), holders)
+	}
+
+	file.Close()
+	holders, err = FindVolumePathHolders(tmpDir, "")
+	if err != nil {
+		t.Fatalf("FindVolumePathHolders failed: %v", err)
+	}
+	for _, holder := range holders {
+		if holder.Pid == os.Getpid() {
+			t.Errorf("expected current process to no longer hold %s, got %+v", tmpDir, holder)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+//go:build !linux
+// +build !linux
+
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"errors"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var errHolderDetectionUnsupported = errors.New("volume path holder detection is only supported on linux")
+
+// FindVolumePathHolders is not supported on this platform.
+func FindVolumePathHolders(path string, podUID types.UID) ([]VolumePathHolder, error) {
+	return nil, errHolderDetectionUnsupported
+}
+
+// KillVolumePathHolder is not supported on this platform.
+func KillVolumePathHolder(pid int) error {
+	return errHolderDetectionUnsupported
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+// Failpoint names understood by CrashAtFailpoint. Each marks a transition
+// where kubelet state on disk and the actual state of the world can diverge
+// when the process dies. Crashing at a failpoint requires a kubelet built
+// with the volume_failpoints build tag; in regular builds CrashAtFailpoint
+// compiles to a no-op.
+const (
+	// FailpointMountDeviceBeforeMark crashes after MountDevice succeeded on
+	// the node but before the device was marked mounted.
+	FailpointMountDeviceBeforeMark = "mount-device-before-mark"
+	// FailpointMountDeviceAfterMark crashes right after the device was
+	// marked mounted.
+	FailpointMountDeviceAfterMark = "mount-device-after-mark"
+	// FailpointSetUpBeforeMark crashes after SetUp succeeded but before the
+	// volume was marked mounted for the pod.
+	FailpointSetUpBeforeMark = "setup-before-mark"
+	// FailpointSetUpAfterMark crashes right after the volume was marked
+	// mounted for the pod.
+	FailpointSetUpAfterMark = "setup-after-mark"
+	// FailpointTearDownBeforeMark crashes after TearDown succeeded but
+	// before the volume was marked unmounted.
+	FailpointTearDownBeforeMark = "teardown-before-mark"
+	// FailpointUnmountDeviceBeforeMark crashes after UnmountDevice succeeded
+	// but before the device was marked unmounted.
+	FailpointUnmountDeviceBeforeMark = "unmount-device-before-mark"
+	// FailpointReconstructBeforeASWUpdate crashes after volumes were
+	// reconstructed from disk but before they were added to the actual state
+	// of the world.
+	FailpointReconstructBeforeASWUpdate = "reconstruct-before-asw-update"
+)
@@ -0,0 +1,69 @@
+//go:build volume_failpoints
+// +build volume_failpoints
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"os"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+)
+
+// volumeFailpointsEnv selects which crash failpoints are armed. It holds a
+// comma-separated list of failpoint names; when the kubelet reaches one of
+// them it terminates immediately, so e2e node tests can validate volume
+// reconstruction after a crash at a deterministic point instead of relying
+// on timing.
+const volumeFailpointsEnv = "KUBELET_VOLUME_FAILPOINTS"
+
+var (
+	failpointsOnce    sync.Once
+	enabledFailpoints sets.Set[string]
+)
+
+// CrashAtFailpoint terminates the kubelet process when the named failpoint
+// was enabled through the KUBELET_VOLUME_FAILPOINTS environment variable. It
+// is a no-op — without any allocation or locking beyond the first call —
+// when no failpoints are enabled.
+func CrashAtFailpoint(name string) {
+	failpointsOnce.Do(func() {
+		enabledFailpoints = parseFailpoints(os.Getenv(volumeFailpointsEnv))
+	})
+	if !enabledFailpoints.Has(name) {
+		return
+	}
+	klog.InfoS("Crashing at volume failpoint", "failpoint", name)
+	klog.Flush()
+	os.Exit(42)
+}
+
+// parseFailpoints splits the comma-separated failpoint list, dropping empty
+// entries.
+func parseFailpoints(value string) sets.Set[string] {
+	failpoints := sets.New[string]()
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			failpoints.Insert(name)
+		}
+	}
+	return failpoints
+}
@@ -0,0 +1,53 @@
+//go:build volume_failpoints
+// +build volume_failpoints
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+)
+
+func TestParseFailpoints(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    string
+		expected []string
+	}{
+		{name: "empty", value: "", expected: nil},
+		{name: "single", value: FailpointSetUpBeforeMark, expected: []string{FailpointSetUpBeforeMark}},
+		{
+			name:     "multiple with whitespace",
+			value:    FailpointSetUpBeforeMark + ", " + FailpointTearDownBeforeMark + " ,",
+			expected: []string{FailpointSetUpBeforeMark, FailpointTearDownBeforeMark},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			failpoints := parseFailpoints(tc.value)
+			if failpoints.Len() != len(tc.expected) {
+				t.Fatalf("expected %d failpoints, got %v", len(tc.expected), failpoints.UnsortedList())
+			}
+			for _, name := range tc.expected {
+				if !failpoints.Has(name) {
+					t.Errorf("expected failpoint %q to be enabled, got %v", name, failpoints.UnsortedList())
+				}
+			}
+		})
+	}
+}
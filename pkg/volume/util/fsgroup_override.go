@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	v1 "k8s.io/api/core/v1"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/kubernetes/pkg/features"
+)
+
+const (
+	// FSGroupOverrideAnnotationPrefix is the prefix of pod annotations that
+	// override the fsGroup behavior for a single volume, keyed by the volume
+	// name from the pod spec:
+	//
+	//   volume.kubernetes.io/fsgroup-override.<volume-name>: skip|apply|<gid>
+	//
+	// "skip" leaves the volume's ownership untouched, "apply" applies the
+	// pod-level fsGroup (the default behavior) and a numeric value applies
+	// that GID instead of the pod-level fsGroup. This lets pods that mix
+	// shared RWX volumes with private RWO volumes opt individual volumes out
+	// of the recursive ownership change. The annotations are read again when
+	// volumes are remounted after kubelet reconstruction, so the override
+	// survives kubelet restarts. The annotations are only honored behind the
+	// PodVolumeFSGroupOverride feature gate.
+	FSGroupOverrideAnnotationPrefix = "volume.kubernetes.io/fsgroup-override."
+
+	// fsGroupOverrideSkip disables the ownership change for the volume.
+	fsGroupOverrideSkip = "skip"
+	// fsGroupOverrideApply applies the pod-level fsGroup, i.e. the default.
+	fsGroupOverrideApply = "apply"
+)
+
+// ApplyFSGroupOverride returns the fsGroup to use for the named volume of the
+// pod, taking a per-volume override annotation into account. Without an
+// override annotation, or with the PodVolumeFSGroupOverride feature gate
+// disabled, the given pod-level fsGroup is returned unchanged. A GID override
+// must be in the same range the API accepts for securityContext.fsGroup.
+func ApplyFSGroupOverride(pod *v1.Pod, volumeName string, fsGroup *int64) (*int64, error) {
+	if !utilfeature.DefaultFeatureGate.Enabled(features.PodVolumeFSGroupOverride) {
+		return fsGroup, nil
+	}
+	override, found := pod.Annotations[FSGroupOverrideAnnotationPrefix+volumeName]
+	if !found {
+		return fsGroup, nil
+	}
+	switch override {
+	case fsGroupOverrideSkip:
+		return nil, nil
+	case fsGroupOverrideApply:
+		return fsGroup, nil
+	}
+	gid, err := strconv.ParseInt(override, 10, 64)
+	if err != nil || gid < 0 || gid > math.MaxInt32 {
+		return nil, fmt.Errorf("invalid fsGroup override %q for volume %q: must be %q, %q or a GID between 0 and %d", override, volumeName, fsGroupOverrideSkip, fsGroupOverrideApply, math.MaxInt32)
+	}
+	return &gid, nil
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+	"k8s.io/kubernetes/pkg/features"
+	"k8s.io/utils/ptr"
+)
+
+func TestApplyFSGroupOverride(t *testing.T) {
+	tests := []struct {
+		name            string
+		override        string
+		gateDisabled    bool
+		fsGroup         *int64
+		expectedFsGroup *int64
+		expectError     bool
+	}{
+		{
+			name:            "no override keeps the pod-level fsGroup",
+			fsGroup:         ptr.To[int64](1000),
+			expectedFsGroup: ptr.To[int64](1000),
+		},
+		{
+			name:            "skip clears the fsGroup",
+			override:        "skip",
+			fsGroup:         ptr.To[int64](1000),
+			expectedFsGroup: nil,
+		},
+		{
+			name:            "apply keeps the pod-level fsGroup",
+			override:        "apply",
+			fsGroup:         ptr.To[int64](1000),
+			expectedFsGroup: ptr.To[int64](1000),
+		},
+		{
+			name:            "custom GID replaces the pod-level fsGroup",
+			override:        "2000",
+			fsGroup:         ptr.To[int64](1000),
+			expectedFsGroup: ptr.To[int64](2000),
+		},
+		{
+			name:            "custom GID without pod-level fsGroup",
+			override:        "2000",
+			expectedFsGroup: ptr.To[int64](2000),
+		},
+		{
+			name:        "negative GID is rejected",
+			override:    "-1",
+			fsGroup:     ptr.To[int64](1000),
+			expectError: true,
+		},
+		{
+			name:        "GID above the API fsGroup range is rejected",
+			override:    "2147483648",
+			fsGroup:     ptr.To[int64](1000),
+			expectError: true,
+		},
+		{
+			name:            "override is ignored while the feature gate is disabled",
+			override:        "skip",
+			gateDisabled:    true,
+			fsGroup:         ptr.To[int64](1000),
+			expectedFsGroup: ptr.To[int64](1000),
+		},
+		{
+			name:        "garbage value is rejected",
+			override:    "nobody",
+			fsGroup:     ptr.To[int64](1000),
+			expectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, features.PodVolumeFSGroupOverride, !test.gateDisabled)
+			pod := &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pod1",
+					Namespace: "ns",
+				},
+			}
+			if test.override != "" {
+				metav1.SetMetaDataAnnotation(&pod.ObjectMeta, FSGroupOverrideAnnotationPrefix+"vol1", test.override)
+			}
+			// An override for another volume must never apply.
+			metav1.SetMetaDataAnnotation(&pod.ObjectMeta, FSGroupOverrideAnnotationPrefix+"other", "skip")
+
+			fsGroup, err := ApplyFSGroupOverride(pod, "vol1", test.fsGroup)
+			if test.expectError {
+				if err == nil {
+					t.Fatalf("expected an error, got fsGroup %v", fsGroup)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if (fsGroup == nil) != (test.expectedFsGroup == nil) || (fsGroup != nil && *fsGroup != *test.expectedFsGroup) {
+				t.Errorf("expected fsGroup %v, got %v", test.expectedFsGroup, fsGroup)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// FsTypeMismatchPolicyAnnotation is a PersistentVolume annotation selecting
+// what the kubelet does when the filesystem already present on the device
+// does not match the fsType requested by the volume. It is typically copied
+// onto the PV from a StorageClass parameter by the provisioner or set by the
+// admin. Without the annotation the mount fails, as it always has.
+const FsTypeMismatchPolicyAnnotation = "volume.kubernetes.io/fstype-mismatch-policy"
+
+// FsTypeMismatchPolicy is the action taken on a filesystem type mismatch.
+type FsTypeMismatchPolicy string
+
+const (
+	// FsTypeMismatchFail fails the mount. This is the default.
+	FsTypeMismatchFail FsTypeMismatchPolicy = "fail"
+	// FsTypeMismatchMountAnyway mounts the existing filesystem and logs a
+	// warning instead of failing.
+	FsTypeMismatchMountAnyway FsTypeMismatchPolicy = "mount-anyway"
+	// FsTypeMismatchReformatIfEmpty reformats the device with the requested
+	// fsType, but only after verifying that the existing filesystem contains
+	// no data.
+	FsTypeMismatchReformatIfEmpty FsTypeMismatchPolicy = "reformat-if-empty"
+)
+
+// GetFsTypeMismatchPolicy returns the mismatch policy requested by the given
+// PV's annotation, defaulting to FsTypeMismatchFail when the annotation is
+// absent. An unknown policy value is an error so a typo cannot silently
+// reformat a volume.
+func GetFsTypeMismatchPolicy(pv *v1.PersistentVolume) (FsTypeMismatchPolicy, error) {
+	if pv == nil {
+		return FsTypeMismatchFail, nil
+	}
+	value, ok := pv.Annotations[FsTypeMismatchPolicyAnnotation]
+	if !ok {
+		return FsTypeMismatchFail, nil
+	}
+	policy := FsTypeMismatchPolicy(value)
+	switch policy {
+	case FsTypeMismatchFail, FsTypeMismatchMountAnyway, FsTypeMismatchReformatIfEmpty:
+		return policy, nil
+	}
+	return FsTypeMismatchFail, fmt.Errorf("unknown %s value %q", FsTypeMismatchPolicyAnnotation, value)
+}
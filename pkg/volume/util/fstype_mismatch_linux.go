@@ -0,0 +1,116 @@
+//go:build linux
+// +build linux
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+	"os"
+
+	"k8s.io/klog/v2"
+	"k8s.io/mount-utils"
+)
+
+// FormatAndMountWithMismatchPolicy behaves like mounter.FormatAndMount, but
+// applies the given policy when the device already carries a filesystem of a
+// different type than fstype.
+func FormatAndMountWithMismatchPolicy(mounter *mount.SafeFormatAndMount, source, target, fstype string, options []string, policy FsTypeMismatchPolicy) error {
+	if policy == FsTypeMismatchFail || fstype == "" {
+		return mounter.FormatAndMount(source, target, fstype, options)
+	}
+	existing, err := mounter.GetDiskFormat(source)
+	if err != nil {
+		return fmt.Errorf("failed to determine filesystem type of %s: %w", source, err)
+	}
+	if existing == "" || existing == fstype {
+		return mounter.FormatAndMount(source, target, fstype, options)
+	}
+
+	switch policy {
+	case FsTypeMismatchMountAnyway:
+		klog.InfoS("Device already contains a filesystem of a different type, mounting it anyway per mismatch policy",
+			"device", source, "existingFsType", existing, "requestedFsType", fstype)
+		return mounter.Mount(source, target, existing, options)
+	case FsTypeMismatchReformatIfEmpty:
+		if err := verifyFilesystemEmpty(mounter, source, existing); err != nil {
+			return mount.NewMountError(mount.FilesystemMismatch,
+				"device %s already contains a %s filesystem and was not verified empty, refusing to reformat as %s: %v",
+				source, existing, fstype, err)
+		}
+		klog.InfoS("Reformatting empty device with the requested filesystem type per mismatch policy",
+			"device", source, "existingFsType", existing, "requestedFsType", fstype)
+		if err := formatDevice(mounter, source, fstype); err != nil {
+			return fmt.Errorf("failed to reformat %s as %s: %w", source, fstype, err)
+		}
+		return mounter.FormatAndMount(source, target, fstype, options)
+	}
+	return fmt.Errorf("unknown filesystem mismatch policy %q", policy)
+}
+
+// verifyFilesystemEmpty mounts the existing filesystem read-only at a
+// temporary path and fails unless it contains no entries besides the
+// lost+found directory.
+func verifyFilesystemEmpty(mounter *mount.SafeFormatAndMount, source, fstype string) error {
+	probeDir, err := os.MkdirTemp("", "fstype-mismatch-probe")
+	if err != nil {
+		return fmt.Errorf("failed to create probe mount point: %v", err)
+	}
+	defer os.Remove(probeDir)
+
+	if err := mounter.Mount(source, probeDir, fstype, []string{"ro"}); err != nil {
+		return fmt.Errorf("failed to mount %s read-only for emptiness verification: %v", source, err)
+	}
+	defer func() {
+		if unmountErr := mounter.Unmount(probeDir); unmountErr != nil {
+			klog.ErrorS(unmountErr, "Failed to unmount emptiness probe mount", "device", source, "path", probeDir)
+		}
+	}()
+
+	entries, err := os.ReadDir(probeDir)
+	if err != nil {
+		return fmt.Errorf("failed to list filesystem contents: %v", err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() && entry.Name() == "lost+found" {
+			continue
+		}
+		return fmt.Errorf("filesystem contains %q", entry.Name())
+	}
+	return nil
+}
+
+// formatDevice runs mkfs for the requested fstype on the device. The
+// arguments mirror what SafeFormatAndMount uses when formatting an empty
+// disk.
+func formatDevice(mounter *mount.SafeFormatAndMount, source, fstype string) error {
+	var args []string
+	switch fstype {
+	case "ext3", "ext4":
+		args = []string{"-F", "-m0", source}
+	case "xfs":
+		args = []string{"-f", source}
+	default:
+		args = []string{source}
+	}
+	output, err := mounter.Exec.Command("mkfs."+fstype, args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("mkfs.%s failed: %v, output: %q", fstype, err, string(output))
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetFsTypeMismatchPolicy(t *testing.T) {
+	testCases := []struct {
+		name        string
+		pv          *v1.PersistentVolume
+		expected    FsTypeMismatchPolicy
+		expectError bool
+	}{
+		{
+			name:     "nil pv",
+			pv:       nil,
+			expected: FsTypeMismatchFail,
+		},
+		{
+			name:     "no annotation",
+			pv:       &v1.PersistentVolume{},
+			expected: FsTypeMismatchFail,
+		},
+		{
+			name: "mount-anyway",
+			pv: &v1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{FsTypeMismatchPolicyAnnotation: "mount-anyway"},
+				},
+			},
+			expected: FsTypeMismatchMountAnyway,
+		},
+		{
+			name: "reformat-if-empty",
+			pv: &v1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{FsTypeMismatchPolicyAnnotation: "reformat-if-empty"},
+				},
+			},
+			expected: FsTypeMismatchReformatIfEmpty,
+		},
+		{
+			name: "unknown value",
+			pv: &v1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{FsTypeMismatchPolicyAnnotation: "reformat"},
+				},
+			},
+			expected:    FsTypeMismatchFail,
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policy, err := GetFsTypeMismatchPolicy(tc.pv)
+			if tc.expectError != (err != nil) {
+				t.Fatalf("expected error=%v, got %v", tc.expectError, err)
+			}
+			if policy != tc.expected {
+				t.Errorf("expected policy %q, got %q", tc.expected, policy)
+			}
+		})
+	}
+}
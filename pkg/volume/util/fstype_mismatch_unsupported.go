@@ -0,0 +1,35 @@
+//go:build !linux
+// +build !linux
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+
+	"k8s.io/mount-utils"
+)
+
+// FormatAndMountWithMismatchPolicy behaves like mounter.FormatAndMount. Only
+// the default fail policy is supported on this platform.
+func FormatAndMountWithMismatchPolicy(mounter *mount.SafeFormatAndMount, source, target, fstype string, options []string, policy FsTypeMismatchPolicy) error {
+	if policy != FsTypeMismatchFail {
+		return fmt.Errorf("filesystem mismatch policy %q is only supported on linux", policy)
+	}
+	return mounter.FormatAndMount(source, target, fstype, options)
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/kubernetes/pkg/features"
+)
+
+// HardenedMountOptionsAnnotation overrides the kubelet wide hardened mount
+// policy for a single PersistentVolume ("true" or "false"). StorageClasses
+// can stamp it on provisioned volumes to opt a whole class of volumes in or
+// out.
+const HardenedMountOptionsAnnotation = "volume.kubernetes.io/hardened-mount-options"
+
+// hardenedMountOptions are the options injected by the hardened mount policy,
+// keyed by the explicit opposite option that disables the injection.
+var hardenedMountOptions = map[string]string{
+	"nosuid": "suid",
+	"nodev":  "dev",
+	"noexec": "exec",
+}
+
+// AppendHardenedMountOptions injects nosuid, nodev and noexec into the mount
+// options when the hardened mount policy applies to the volume. An option is
+// not injected when the existing options already take an explicit stance, in
+// either direction; a volume that states "exec" keeps it. pv may be nil for
+// volumes that have no PersistentVolume, e.g. emptyDir. Because the policy
+// runs in the common mount path, reconstructed volumes pick it up again when
+// they are remounted.
+func AppendHardenedMountOptions(pv *v1.PersistentVolume, options []string) []string {
+	if !hardenedMountPolicyApplies(pv) {
+		return options
+	}
+	existing := sets.New[string](options...)
+	for option, opposite := range hardenedMountOptions {
+		if existing.Has(option) || existing.Has(opposite) {
+			continue
+		}
+		options = append(options, option)
+	}
+	return options
+}
+
+// hardenedMountPolicyApplies returns whether hardened mount options should be
+// injected for the volume. The kubelet wide policy comes from the
+// HardenedMountOptions feature gate; a PersistentVolume annotation overrides
+// it in both directions.
+func hardenedMountPolicyApplies(pv *v1.PersistentVolume) bool {
+	enabled := utilfeature.DefaultFeatureGate.Enabled(features.HardenedMountOptions)
+	if pv != nil {
+		if override, ok := pv.Annotations[HardenedMountOptionsAnnotation]; ok {
+			enabled = override == "true"
+		}
+	}
+	return enabled
+}
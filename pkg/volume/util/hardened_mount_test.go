@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+	"k8s.io/kubernetes/pkg/features"
+	"k8s.io/kubernetes/pkg/volume"
+)
+
+func TestAppendHardenedMountOptions(t *testing.T) {
+	annotatedPV := func(value string) *v1.PersistentVolume {
+		return &v1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "pv",
+				Annotations: map[string]string{HardenedMountOptionsAnnotation: value},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		gateEnabled bool
+		pv          *v1.PersistentVolume
+		options     []string
+		expected    []string
+	}{
+		{
+			name:     "policy disabled by default",
+			options:  []string{"ro"},
+			expected: []string{"ro"},
+		},
+		{
+			name:        "policy enabled injects all options",
+			gateEnabled: true,
+			options:     []string{"ro"},
+			expected:    []string{"ro", "nosuid", "nodev", "noexec"},
+		},
+		{
+			name:        "explicit exec is respected",
+			gateEnabled: true,
+			options:     []string{"exec"},
+			expected:    []string{"exec", "nosuid", "nodev"},
+		},
+		{
+			name:        "already hardened options are not duplicated",
+			gateEnabled: true,
+			options:     []string{"nosuid", "nodev", "noexec"},
+			expected:    []string{"nosuid", "nodev", "noexec"},
+		},
+		{
+			name:        "PV annotation opts out of enabled policy",
+			gateEnabled: true,
+			pv:          annotatedPV("false"),
+			options:     []string{"ro"},
+			expected:    []string{"ro"},
+		},
+		{
+			name:     "PV annotation opts into disabled policy",
+			pv:       annotatedPV("true"),
+			options:  nil,
+			expected: []string{"nosuid", "nodev", "noexec"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, features.HardenedMountOptions, tc.gateEnabled)
+			got := AppendHardenedMountOptions(tc.pv, tc.options)
+			if !sets.New[string](got...).Equal(sets.New[string](tc.expected...)) {
+				t.Errorf("expected options %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestMountOptionFromSpecHardened(t *testing.T) {
+	featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, features.HardenedMountOptions, true)
+	spec := &volume.Spec{
+		PersistentVolume: &v1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: "pv"},
+			Spec: v1.PersistentVolumeSpec{
+				MountOptions: []string{"ro"},
+			},
+		},
+	}
+	got := sets.New[string](MountOptionFromSpec(spec)...)
+	expected := sets.New[string]("ro", "nosuid", "nodev", "noexec")
+	if !got.Equal(expected) {
+		t.Errorf("expected options %v, got %v", sets.List(expected), sets.List(got))
+	}
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package hooks allows node agents compiled into the kubelet to run logic
+// around volume mount and unmount operations, e.g. cache warmers, virus
+// scanners or backup quiescing. Hooks are registered with a Registry and are
+// invoked by the operation executor before and after SetUp and TearDown of
+// matching volumes.
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+// HookPoint identifies where in a volume operation a hook runs.
+type HookPoint string
+
+const (
+	// PreMount hooks run before SetUp is called for a volume.
+	PreMount HookPoint = "PreMount"
+	// PostMount hooks run after SetUp succeeded for a volume.
+	PostMount HookPoint = "PostMount"
+	// PreUnmount hooks run before TearDown is called for a volume.
+	PreUnmount HookPoint = "PreUnmount"
+	// PostUnmount hooks run after TearDown succeeded for a volume.
+	PostUnmount HookPoint = "PostUnmount"
+)
+
+// FailurePolicy controls how a hook failure affects the volume operation.
+type FailurePolicy string
+
+const (
+	// FailurePolicyFail aborts the volume operation when the hook fails. The
+	// operation is retried by the reconciler like any other failure.
+	FailurePolicyFail FailurePolicy = "Fail"
+	// FailurePolicyIgnore logs hook failures and lets the volume operation
+	// continue.
+	FailurePolicyIgnore FailurePolicy = "Ignore"
+)
+
+// defaultTimeout bounds hook execution when the registered hook does not
+// specify its own timeout.
+const defaultTimeout = 30 * time.Second
+
+// HookContext describes the volume operation a hook is invoked for.
+type HookContext struct {
+	// Point is the hook point the hook is invoked at. It is set by the
+	// registry.
+	Point HookPoint
+	// VolumeName is the unique volume name of the volume.
+	VolumeName string
+	// PluginName is the name of the volume plugin handling the volume.
+	PluginName string
+	// PodUID is the UID of the pod the volume is mounted for.
+	PodUID types.UID
+	// Path is the volume path, when known at the hook point.
+	Path string
+}
+
+// Runner executes the hook logic. The context carries the hook's timeout.
+type Runner interface {
+	Run(ctx context.Context, hookCtx HookContext) error
+}
+
+// Hook is a named piece of logic registered to run at one or more hook
+// points for matching volumes.
+type Hook struct {
+	// Name identifies the hook in logs and error messages.
+	Name string
+	// Points lists the hook points the hook runs at.
+	Points []HookPoint
+	// PluginNames restricts the hook to volumes of the given plugins. An
+	// empty list matches all volumes.
+	PluginNames []string
+	// Timeout bounds a single execution of the hook. Zero means the default
+	// timeout of 30 seconds.
+	Timeout time.Duration
+	// FailurePolicy controls whether a hook failure aborts the volume
+	// operation. An empty value defaults to FailurePolicyFail.
+	FailurePolicy FailurePolicy
+	// Runner executes the hook.
+	Runner Runner
+}
+
+func (h *Hook) matches(point HookPoint, pluginName string) bool {
+	foundPoint := false
+	for _, p := range h.Points {
+		if p == point {
+			foundPoint = true
+			break
+		}
+	}
+	if !foundPoint {
+		return false
+	}
+	if len(h.PluginNames) == 0 {
+		return true
+	}
+	for _, name := range h.PluginNames {
+		if name == pluginName {
+			return true
+		}
+	}
+	return false
+}
+
+// Registry holds registered hooks and runs them at the requested hook
+// points. The zero value is ready to use.
+type Registry struct {
+	sync.RWMutex
+	hooks []*Hook
+}
+
+// DefaultRegistry is the registry consulted by the operation executor. Node
+// agents register their hooks here, typically via a kubelet functional
+// option, before the volume manager starts.
+var DefaultRegistry = &Registry{}
+
+// Register adds a hook to the registry. Hooks run in registration order.
+func (r *Registry) Register(hook *Hook) error {
+	if hook.Name == "" {
+		return fmt.Errorf("hook name must not be empty")
+	}
+	if hook.Runner == nil {
+		return fmt.Errorf("hook %q has no runner", hook.Name)
+	}
+	if len(hook.Points) == 0 {
+		return fmt.Errorf("hook %q has no hook points", hook.Name)
+	}
+	r.Lock()
+	defer r.Unlock()
+	for _, existing := range r.hooks {
+		if existing.Name == hook.Name {
+			return fmt.Errorf("hook %q is already registered", hook.Name)
+		}
+	}
+	r.hooks = append(r.hooks, hook)
+	return nil
+}
+
+// Run invokes all hooks registered for the given point that match the
+// volume's plugin. It returns the first failure of a hook with
+// FailurePolicyFail; failures of hooks with FailurePolicyIgnore are only
+// logged.
+func (r *Registry) Run(point HookPoint, hookCtx HookContext) error {
+	r.RLock()
+	hooks := make([]*Hook, len(r.hooks))
+	copy(hooks, r.hooks)
+	r.RUnlock()
+
+	hookCtx.Point = point
+	for _, hook := range hooks {
+		if !hook.matches(point, hookCtx.PluginName) {
+			continue
+		}
+		timeout := hook.Timeout
+		if timeout == 0 {
+			timeout = defaultTimeout
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		err := hook.Runner.Run(ctx, hookCtx)
+		cancel()
+		if err == nil {
+			continue
+		}
+		if hook.FailurePolicy == FailurePolicyIgnore {
+			klog.V(2).InfoS("Volume hook failed, continuing per failure policy", "hook", hook.Name, "hookPoint", point, "volumeName", hookCtx.VolumeName, "podUID", hookCtx.PodUID, "err", err)
+			continue
+		}
+		return fmt.Errorf("hook %q failed at %s: %w", hook.Name, point, err)
+	}
+	return nil
+}
+
+// execRunner runs a hook as an external command. The hook context is passed
+// through environment variables.
+type execRunner struct {
+	command []string
+}
+
+// NewExecRunner returns a Runner that executes the given command. The hook
+// context is exposed to the command as VOLUME_HOOK_POINT,
+// VOLUME_HOOK_VOLUME_NAME, VOLUME_HOOK_PLUGIN, VOLUME_HOOK_POD_UID and
+// VOLUME_HOOK_PATH environment variables.
+func NewExecRunner(command ...string) Runner {
+	return &execRunner{command: command}
+}
+
+func (e *execRunner) Run(ctx context.Context, hookCtx HookContext) error {
+	if len(e.command) == 0 {
+		return fmt.Errorf("exec hook has no command")
+	}
+	cmd := exec.CommandContext(ctx, e.command[0], e.command[1:]...)
+	cmd.Env = append(cmd.Environ(),
+		"VOLUME_HOOK_POINT="+string(hookCtx.Point),
+		"VOLUME_HOOK_VOLUME_NAME="+hookCtx.VolumeName,
+		"VOLUME_HOOK_PLUGIN="+hookCtx.PluginName,
+		"VOLUME_HOOK_POD_UID="+string(hookCtx.PodUID),
+		"VOLUME_HOOK_PATH="+hookCtx.Path,
+	)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("command failed: %v, output: %q", err, string(output))
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type fakeRunner struct {
+	err      error
+	runCount int
+	lastCtx  HookContext
+}
+
+func (f *fakeRunner) Run(ctx context.Context, hookCtx HookContext) error {
+	f.runCount++
+	f.lastCtx = hookCtx
+	return f.err
+}
+
+func TestRegistryRegister(t *testing.T) {
+	registry := &Registry{}
+	runner := &fakeRunner{}
+
+	if err := registry.Register(&Hook{Runner: runner, Points: []HookPoint{PreMount}}); err == nil {
+		t.Error("expected error registering hook without a name")
+	}
+	if err := registry.Register(&Hook{Name: "no-runner", Points: []HookPoint{PreMount}}); err == nil {
+		t.Error("expected error registering hook without a runner")
+	}
+	if err := registry.Register(&Hook{Name: "no-points", Runner: runner}); err == nil {
+		t.Error("expected error registering hook without hook points")
+	}
+	if err := registry.Register(&Hook{Name: "ok", Runner: runner, Points: []HookPoint{PreMount}}); err != nil {
+		t.Errorf("unexpected error registering hook: %v", err)
+	}
+	if err := registry.Register(&Hook{Name: "ok", Runner: runner, Points: []HookPoint{PostMount}}); err == nil {
+		t.Error("expected error registering hook with a duplicate name")
+	}
+}
+
+func TestRegistryRun(t *testing.T) {
+	hookErr := errors.New("hook failed")
+	tests := []struct {
+		name        string
+		hook        Hook
+		point       HookPoint
+		pluginName  string
+		expectRuns  int
+		expectError bool
+	}{
+		{
+			name:       "hook runs for matching point",
+			hook:       Hook{Points: []HookPoint{PreMount}},
+			point:      PreMount,
+			expectRuns: 1,
+		},
+		{
+			name:       "hook skipped for other point",
+			hook:       Hook{Points: []HookPoint{PreMount}},
+			point:      PostMount,
+			expectRuns: 0,
+		},
+		{
+			name:       "hook runs for matching plugin",
+			hook:       Hook{Points: []HookPoint{PreUnmount}, PluginNames: []string{"kubernetes.io/csi"}},
+			point:      PreUnmount,
+			pluginName: "kubernetes.io/csi",
+			expectRuns: 1,
+		},
+		{
+			name:       "hook skipped for other plugin",
+			hook:       Hook{Points: []HookPoint{PreUnmount}, PluginNames: []string{"kubernetes.io/csi"}},
+			point:      PreUnmount,
+			pluginName: "kubernetes.io/empty-dir",
+			expectRuns: 0,
+		},
+		{
+			name:        "failing hook aborts by default",
+			hook:        Hook{Points: []HookPoint{PreMount}},
+			point:       PreMount,
+			expectRuns:  1,
+			expectError: true,
+		},
+		{
+			name:       "failing hook ignored per policy",
+			hook:       Hook{Points: []HookPoint{PreMount}, FailurePolicy: FailurePolicyIgnore},
+			point:      PreMount,
+			expectRuns: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			registry := &Registry{}
+			runner := &fakeRunner{}
+			if test.expectError || test.hook.FailurePolicy == FailurePolicyIgnore {
+				runner.err = hookErr
+			}
+			hook := test.hook
+			hook.Name = "test-hook"
+			hook.Runner = runner
+			if err := registry.Register(&hook); err != nil {
+				t.Fatalf("unexpected error registering hook: %v", err)
+			}
+
+			err := registry.Run(test.point, HookContext{VolumeName: "vol", PluginName: test.pluginName})
+			if test.expectError != (err != nil) {
+				t.Errorf("expected error=%t, got %v", test.expectError, err)
+			}
+			if runner.runCount != test.expectRuns {
+				t.Errorf("expected %d runs, got %d", test.expectRuns, runner.runCount)
+			}
+			if test.expectRuns > 0 && runner.lastCtx.Point != test.point {
+				t.Errorf("expected hook point %q in context, got %q", test.point, runner.lastCtx.Point)
+			}
+		})
+	}
+}
+
+func TestRegistryRunOrder(t *testing.T) {
+	registry := &Registry{}
+	order := []string{}
+	for _, name := range []string{"first", "second"} {
+		name := name
+		if err := registry.Register(&Hook{
+			Name:   name,
+			Points: []HookPoint{PostUnmount},
+			Runner: runnerFunc(func(ctx context.Context, hookCtx HookContext) error {
+				order = append(order, name)
+				return nil
+			}),
+		}); err != nil {
+			t.Fatalf("unexpected error registering hook: %v", err)
+		}
+	}
+	if err := registry.Run(PostUnmount, HookContext{}); err != nil {
+		t.Fatalf("unexpected error running hooks: %v", err)
+	}
+	if len(order) != 2 || order[0] != "first" || order[1] != "second" {
+		t.Errorf("expected hooks to run in registration order, got %v", order)
+	}
+}
+
+type runnerFunc func(ctx context.Context, hookCtx HookContext) error
+
+func (f runnerFunc) Run(ctx context.Context, hookCtx HookContext) error {
+	return f(ctx, hookCtx)
+}
+
+func TestHookTimeout(t *testing.T) {
+	registry := &Registry{}
+	if err := registry.Register(&Hook{
+		Name:    "slow",
+		Points:  []HookPoint{PreMount},
+		Timeout: time.Millisecond,
+		Runner: runnerFunc(func(ctx context.Context, hookCtx HookContext) error {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(10 * time.Second):
+				return nil
+			}
+		}),
+	}); err != nil {
+		t.Fatalf("unexpected error registering hook: %v", err)
+	}
+	if err := registry.Run(PreMount, HookContext{}); err == nil {
+		t.Error("expected timeout error from slow hook")
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostutil
+
+import (
+	"sync"
+
+	"k8s.io/klog/v2"
+)
+
+// FilesystemCapabilities describes the optional kernel filesystem features
+// available on this node. Volume plugins consult it to degrade gracefully —
+// with an explicit log or event — instead of failing at mount time when a
+// feature is missing.
+type FilesystemCapabilities struct {
+	// IDMappedMounts is true when the kernel supports id-mapped mounts
+	// (mount_setattr with MOUNT_ATTR_IDMAP).
+	IDMappedMounts bool
+	// RecursiveReadOnly is true when the kernel supports making a mount
+	// read-only recursively in one operation (mount_setattr with
+	// AT_RECURSIVE).
+	RecursiveReadOnly bool
+	// TmpfsNoswap is true when tmpfs supports the noswap mount option.
+	TmpfsNoswap bool
+	// ProjectQuotas is true when the kernel supports project quota ids,
+	// which kubelet uses for ephemeral storage monitoring. The backing
+	// filesystem must additionally be mounted with project quotas enabled.
+	ProjectQuotas bool
+	// Reflink is true when the kernel supports reflink copies (the FICLONE
+	// ioctl). The backing filesystem must additionally implement it, e.g.
+	// xfs or btrfs.
+	Reflink bool
+}
+
+var (
+	capabilitiesOnce sync.Once
+	capabilities     FilesystemCapabilities
+)
+
+// GetFilesystemCapabilities returns the kernel filesystem capabilities of
+// this node. The probe runs once on the first call — typically at kubelet
+// startup — and logs the result; subsequent calls return the cached value.
+func GetFilesystemCapabilities() FilesystemCapabilities {
+	capabilitiesOnce.Do(func() {
+		capabilities = probeFilesystemCapabilities()
+		klog.InfoS("Probed kernel filesystem capabilities",
+			"idMappedMounts", capabilities.IDMappedMounts,
+			"recursiveReadOnly", capabilities.RecursiveReadOnly,
+			"tmpfsNoswap", capabilities.TmpfsNoswap,
+			"projectQuotas", capabilities.ProjectQuotas,
+			"reflink", capabilities.Reflink)
+	})
+	return capabilities
+}
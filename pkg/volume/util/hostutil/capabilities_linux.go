@@ -0,0 +1,78 @@
+//go:build linux
+// +build linux
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostutil
+
+import (
+	"strconv"
+	"strings"
+
+	"golang.org/x/sys/unix"
+	"k8s.io/klog/v2"
+)
+
+// probeFilesystemCapabilities derives the capabilities from the running
+// kernel version. The thresholds are the kernel releases that introduced the
+// respective feature; whether a concrete filesystem implements it (e.g.
+// project quotas or reflink) still depends on how the volume is formatted and
+// mounted.
+func probeFilesystemCapabilities() FilesystemCapabilities {
+	var uname unix.Utsname
+	if err := unix.Uname(&uname); err != nil {
+		klog.ErrorS(err, "Failed to read kernel version, assuming no optional filesystem capabilities")
+		return FilesystemCapabilities{}
+	}
+	release := unix.ByteSliceToString(uname.Release[:])
+	return FilesystemCapabilities{
+		// mount_setattr(2) with MOUNT_ATTR_IDMAP and AT_RECURSIVE landed
+		// in 5.12.
+		IDMappedMounts:    releaseAtLeast(release, 5, 12),
+		RecursiveReadOnly: releaseAtLeast(release, 5, 12),
+		// tmpfs understands the noswap mount option since 6.4.
+		TmpfsNoswap: releaseAtLeast(release, 6, 4),
+		// ext4 gained project quota support in 4.5 (xfs had it earlier).
+		ProjectQuotas: releaseAtLeast(release, 4, 5),
+		// The filesystem-independent FICLONE ioctl was introduced in 4.5.
+		Reflink: releaseAtLeast(release, 4, 5),
+	}
+}
+
+// releaseAtLeast returns true when the kernel release string (e.g.
+// "6.1.0-13-amd64") denotes a version of at least major.minor. Unparsable
+// release strings report false so capabilities are never overstated.
+func releaseAtLeast(release string, major, minor int) bool {
+	parts := strings.SplitN(release, ".", 3)
+	if len(parts) < 2 {
+		return false
+	}
+	gotMajor, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return false
+	}
+	// The minor component may carry a non-numeric suffix, e.g. "10-foo".
+	minorDigits := parts[1]
+	if i := strings.IndexFunc(minorDigits, func(r rune) bool { return r < '0' || r > '9' }); i >= 0 {
+		minorDigits = minorDigits[:i]
+	}
+	gotMinor, err := strconv.Atoi(minorDigits)
+	if err != nil {
+		return false
+	}
+	return gotMajor > major || (gotMajor == major && gotMinor >= minor)
+}
@@ -0,0 +1,56 @@
+//go:build linux
+// +build linux
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostutil
+
+import (
+	"testing"
+)
+
+func TestReleaseAtLeast(t *testing.T) {
+	testCases := []struct {
+		release  string
+		major    int
+		minor    int
+		expected bool
+	}{
+		{release: "6.1.0-13-amd64", major: 5, minor: 12, expected: true},
+		{release: "5.12.0", major: 5, minor: 12, expected: true},
+		{release: "5.11.7", major: 5, minor: 12, expected: false},
+		{release: "4.19.0", major: 5, minor: 12, expected: false},
+		{release: "6.4.0", major: 6, minor: 4, expected: true},
+		{release: "6.10-rc1", major: 6, minor: 4, expected: true},
+		{release: "not-a-kernel", major: 4, minor: 5, expected: false},
+		{release: "6", major: 6, minor: 0, expected: false},
+	}
+	for _, tc := range testCases {
+		if got := releaseAtLeast(tc.release, tc.major, tc.minor); got != tc.expected {
+			t.Errorf("releaseAtLeast(%q, %d, %d): expected %v, got %v",
+				tc.release, tc.major, tc.minor, tc.expected, got)
+		}
+	}
+}
+
+func TestGetFilesystemCapabilitiesIsCached(t *testing.T) {
+	first := GetFilesystemCapabilities()
+	second := GetFilesystemCapabilities()
+	if first != second {
+		t.Errorf("expected cached capabilities to be stable, got %+v and %+v", first, second)
+	}
+}
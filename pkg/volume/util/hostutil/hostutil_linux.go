@@ -183,7 +183,7 @@ func isShared(mount string, mountInfoPath string) (bool, error) {
 }
 
 func findMountInfo(path, mountInfoPath string) (mount.MountInfo, error) {
-	infos, err := mount.ParseMountInfo(mountInfoPath)
+	infos, err := listMountInfos(mountInfoPath)
 	if err != nil {
 		return mount.MountInfo{}, err
 	}
@@ -0,0 +1,171 @@
+//go:build linux
+// +build linux
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostutil
+
+import (
+	"io"
+	"os"
+	"sync"
+
+	"golang.org/x/sys/unix"
+	"k8s.io/klog/v2"
+	"k8s.io/mount-utils"
+)
+
+// mountInfoCache caches the parsed content of a mountinfo file between calls.
+// The kernel signals changes of the mount table via POLLPRI/POLLERR on an open
+// /proc/<pid>/mountinfo file descriptor, so the cache keeps one descriptor
+// open and re-parses the file only when a change has been signalled since the
+// last parse. This turns repeated per-volume mountinfo lookups from
+// O(mounts) parses into a single parse per mount table change.
+type mountInfoCache struct {
+	path string
+
+	mu sync.Mutex
+	// file is the descriptor used for change detection. It stays open for
+	// the lifetime of the cache. nil until the first List call; a nil file
+	// after an open error makes the cache fall back to uncached parsing.
+	file *os.File
+	// infos is the cached parse result. nil means no valid snapshot.
+	// The slice is shared with callers and must be treated as read-only.
+	infos []mount.MountInfo
+
+	// changed reports whether the mount table changed since the last parse.
+	// Overridable for unit tests on regular files, where poll does not
+	// signal content changes.
+	changed func() (bool, error)
+}
+
+// procMountInfoCache is the shared cache for /proc/self/mountinfo, used by
+// all hostutil callers that read the kubelet's own mount table.
+var procMountInfoCache = newMountInfoCache(procMountInfoPath)
+
+func newMountInfoCache(path string) *mountInfoCache {
+	c := &mountInfoCache{path: path}
+	c.changed = c.pollChanged
+	return c
+}
+
+// List returns the parsed content of the mountinfo file, re-parsing it only
+// when the mount table changed since the previous call. The returned slice is
+// shared between callers and must be treated as read-only.
+func (c *mountInfoCache) List() ([]mount.MountInfo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.file == nil {
+		file, err := os.Open(c.path)
+		if err != nil {
+			// Without a descriptor there is no change notification;
+			// fall back to an uncached parse.
+			klog.V(4).Infof("Cannot open %s for mount table change detection, parsing uncached: %v", c.path, err)
+			return mount.ParseMountInfo(c.path)
+		}
+		c.file = file
+	}
+
+	if c.infos != nil {
+		changed, err := c.changed()
+		if err != nil {
+			return nil, err
+		}
+		if !changed {
+			return c.infos, nil
+		}
+	}
+
+	// Reading the kept descriptor to EOF clears the pending change
+	// notification, so the next poll only fires on a new change.
+	if _, err := c.file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(io.Discard, c.file); err != nil {
+		return nil, err
+	}
+
+	infos, err := mount.ParseMountInfo(c.path)
+	if err != nil {
+		return nil, err
+	}
+	c.infos = infos
+	return c.infos, nil
+}
+
+// Diff returns the mount points that were added to and removed from the mount
+// table since the given previous snapshot was taken with List.
+func (c *mountInfoCache) Diff(previous []mount.MountInfo) (added, removed []mount.MountInfo, err error) {
+	current, err := c.List()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	previousKeys := make(map[mountInfoKey]bool, len(previous))
+	for _, info := range previous {
+		previousKeys[mountInfoKeyFor(info)] = true
+	}
+	currentKeys := make(map[mountInfoKey]bool, len(current))
+	for _, info := range current {
+		currentKeys[mountInfoKeyFor(info)] = true
+	}
+
+	for _, info := range current {
+		if !previousKeys[mountInfoKeyFor(info)] {
+			added = append(added, info)
+		}
+	}
+	for _, info := range previous {
+		if !currentKeys[mountInfoKeyFor(info)] {
+			removed = append(removed, info)
+		}
+	}
+	return added, removed, nil
+}
+
+// mountInfoKey identifies a mount table entry across snapshots. Mount IDs can
+// be reused after unmount, so the mount point and source are part of the key.
+type mountInfoKey struct {
+	id         int
+	mountPoint string
+	source     string
+}
+
+func mountInfoKeyFor(info mount.MountInfo) mountInfoKey {
+	return mountInfoKey{id: info.ID, mountPoint: info.MountPoint, source: info.Source}
+}
+
+// pollChanged checks the kept descriptor for a pending mount table change
+// notification without blocking.
+func (c *mountInfoCache) pollChanged() (bool, error) {
+	fds := []unix.PollFd{{Fd: int32(c.file.Fd()), Events: unix.POLLPRI}}
+	n, err := unix.Poll(fds, 0 /* timeout, do not block */)
+	if err != nil {
+		return false, err
+	}
+	return n > 0 && fds[0].Revents&(unix.POLLPRI|unix.POLLERR) != 0, nil
+}
+
+// listMountInfos parses the given mountinfo file, serving the kubelet's own
+// mount table from the shared change-notified cache.
+func listMountInfos(mountInfoPath string) ([]mount.MountInfo, error) {
+	if mountInfoPath == procMountInfoPath {
+		return procMountInfoCache.List()
+	}
+	return mount.ParseMountInfo(mountInfoPath)
+}
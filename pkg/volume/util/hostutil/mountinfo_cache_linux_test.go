@@ -0,0 +1,124 @@
+//go:build linux
+// +build linux
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hostutil
+
+import (
+	"os"
+	"testing"
+)
+
+const cacheTestMountInfo = `62 0 253:0 / / rw,relatime shared:1 - ext4 /dev/mapper/ssd-root rw,seclabel,data=ordered
+76 62 8:1 / /boot rw,relatime shared:29 - ext4 /dev/sda1 rw,seclabel,data=ordered
+78 62 0:41 / /tmp rw,nosuid,nodev shared:30 - tmpfs tmpfs rw,seclabel
+`
+
+// newTestMountInfoCache returns a cache on a temporary mountinfo file whose
+// change detection is driven by the test instead of poll, because poll does
+// not signal content changes on regular files.
+func newTestMountInfoCache(t *testing.T, content string) (*mountInfoCache, *bool) {
+	tempDir, filename, err := writeFile(content)
+	if err != nil {
+		t.Fatalf("cannot create temporary file: %v", err)
+	}
+	t.Cleanup(func() { os.RemoveAll(tempDir) })
+
+	changed := false
+	cache := newMountInfoCache(filename)
+	cache.changed = func() (bool, error) { return changed, nil }
+	return cache, &changed
+}
+
+func TestMountInfoCacheList(t *testing.T) {
+	cache, changed := newTestMountInfoCache(t, cacheTestMountInfo)
+
+	infos, err := cache.List()
+	if err != nil {
+		t.Fatalf("unexpected error on initial parse: %v", err)
+	}
+	if len(infos) != 3 {
+		t.Fatalf("expected 3 mount entries, got %d", len(infos))
+	}
+
+	// Without a change notification the cached snapshot is served even
+	// though the file content changed.
+	if err := os.WriteFile(cache.path, []byte(cacheTestMountInfo+"80 62 0:42 / /var/lib/foo rw,relatime shared:31 - tmpfs tmpfs rw\n"), 0600); err != nil {
+		t.Fatalf("cannot update temporary file: %v", err)
+	}
+	infos, err = cache.List()
+	if err != nil {
+		t.Fatalf("unexpected error on cached read: %v", err)
+	}
+	if len(infos) != 3 {
+		t.Errorf("expected cached snapshot with 3 mount entries, got %d", len(infos))
+	}
+
+	// A change notification invalidates the snapshot.
+	*changed = true
+	infos, err = cache.List()
+	if err != nil {
+		t.Fatalf("unexpected error on re-parse: %v", err)
+	}
+	if len(infos) != 4 {
+		t.Errorf("expected re-parsed snapshot with 4 mount entries, got %d", len(infos))
+	}
+}
+
+func TestMountInfoCacheDiff(t *testing.T) {
+	cache, changed := newTestMountInfoCache(t, cacheTestMountInfo)
+
+	previous, err := cache.List()
+	if err != nil {
+		t.Fatalf("unexpected error on initial parse: %v", err)
+	}
+
+	// Replace the /tmp mount with a new /var/lib/foo mount.
+	newContent := `62 0 253:0 / / rw,relatime shared:1 - ext4 /dev/mapper/ssd-root rw,seclabel,data=ordered
+76 62 8:1 / /boot rw,relatime shared:29 - ext4 /dev/sda1 rw,seclabel,data=ordered
+80 62 0:42 / /var/lib/foo rw,relatime shared:31 - tmpfs tmpfs rw
+`
+	if err := os.WriteFile(cache.path, []byte(newContent), 0600); err != nil {
+		t.Fatalf("cannot update temporary file: %v", err)
+	}
+	*changed = true
+
+	added, removed, err := cache.Diff(previous)
+	if err != nil {
+		t.Fatalf("unexpected error on diff: %v", err)
+	}
+	if len(added) != 1 || added[0].MountPoint != "/var/lib/foo" {
+		t.Errorf("expected /var/lib/foo as the only added mount, got %+v", added)
+	}
+	if len(removed) != 1 || removed[0].MountPoint != "/tmp" {
+		t.Errorf("expected /tmp as the only removed mount, got %+v", removed)
+	}
+
+	// A diff against the current snapshot is empty.
+	current, err := cache.List()
+	if err != nil {
+		t.Fatalf("unexpected error on re-list: %v", err)
+	}
+	added, removed, err = cache.Diff(current)
+	if err != nil {
+		t.Fatalf("unexpected error on diff: %v", err)
+	}
+	if len(added) != 0 || len(removed) != 0 {
+		t.Errorf("expected empty diff, got added %+v removed %+v", added, removed)
+	}
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package localclone
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// checksumCopyCloner clones by copying every file and verifying that the
+// sha256 checksum of the written destination file matches the checksum of the
+// data read from the source. It works on any filesystem and reports progress
+// per copied file.
+type checksumCopyCloner struct{}
+
+func (c *checksumCopyCloner) Name() string {
+	return "checksum-copy"
+}
+
+func (c *checksumCopyCloner) Clone(src, dst string, progress ProgressFunc) error {
+	totalBytes, err := directorySize(src)
+	if err != nil {
+		return fmt.Errorf("failed to determine source size: %v", err)
+	}
+
+	var copiedBytes int64
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		dstPath := filepath.Join(dst, relPath)
+
+		switch {
+		case info.IsDir():
+			if relPath == "." {
+				return nil
+			}
+			return os.MkdirAll(dstPath, info.Mode().Perm())
+		case info.Mode()&os.ModeSymlink == os.ModeSymlink:
+			target, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			if err := os.Remove(dstPath); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			return os.Symlink(target, dstPath)
+		case info.Mode().IsRegular():
+			if err := copyFileChecksummed(path, dstPath, info.Mode().Perm()); err != nil {
+				return err
+			}
+			copiedBytes += info.Size()
+			if progress != nil {
+				progress(copiedBytes, totalBytes)
+			}
+			return nil
+		default:
+			// Sockets, FIFOs and device nodes are not cloned; they carry no
+			// data and are recreated by whatever created them on the source.
+			return nil
+		}
+	})
+}
+
+// copyFileChecksummed copies src to dst and fails when the sha256 checksum of
+// the destination after the copy does not match the data read from the
+// source.
+func copyFileChecksummed(src, dst string, perm os.FileMode) error {
+	srcFile, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer srcFile.Close()
+
+	dstFile, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+
+	srcHash := sha256.New()
+	_, err = io.Copy(dstFile, io.TeeReader(srcFile, srcHash))
+	if closeErr := dstFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to copy %s: %v", src, err)
+	}
+
+	dstHash, err := fileChecksum(dst)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(srcHash.Sum(nil), dstHash) {
+		return fmt.Errorf("checksum mismatch after copying %s to %s", src, dst)
+	}
+	return nil
+}
+
+func fileChecksum(path string) ([]byte, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return nil, fmt.Errorf("failed to checksum %s: %v", path, err)
+	}
+	return hash.Sum(nil), nil
+}
+
+// directorySize returns the aggregate size of the regular files under root.
+func directorySize(root string) (int64, error) {
+	var size int64
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return size, nil
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package localclone implements node-local cloning of volume data for PVC
+// dataSource clones whose source and destination are local PVs on the same
+// node. Cloners are pluggable: the manager tries each in order and falls back
+// to the next one, so an efficient reflink copy is used on filesystems that
+// support it and a checksum-verified plain copy everywhere else.
+package localclone
+
+import (
+	"fmt"
+
+	"k8s.io/klog/v2"
+	utilexec "k8s.io/utils/exec"
+)
+
+// ProgressFunc is called by cloners while data is being copied, e.g. to
+// surface progress on the destination PVC.
+type ProgressFunc func(copiedBytes, totalBytes int64)
+
+// Cloner copies the contents of a source directory to an empty destination
+// directory.
+type Cloner interface {
+	// Name identifies the cloner in logs and PVC conditions.
+	Name() string
+	// Clone copies the contents of src into dst, reporting progress through
+	// progress when it is non-nil.
+	Clone(src, dst string, progress ProgressFunc) error
+}
+
+// CloneManager tries its cloners in order until one succeeds.
+type CloneManager struct {
+	cloners []Cloner
+}
+
+// NewCloneManager returns a CloneManager using the given cloners in order.
+func NewCloneManager(cloners ...Cloner) *CloneManager {
+	return &CloneManager{cloners: cloners}
+}
+
+// NewDefaultCloneManager returns a CloneManager that attempts a reflink copy
+// first and falls back to a checksum-verified plain copy.
+func NewDefaultCloneManager(exec utilexec.Interface) *CloneManager {
+	return NewCloneManager(
+		&reflinkCloner{exec: exec},
+		&checksumCopyCloner{},
+	)
+}
+
+// Clone copies the contents of src into dst using the first cloner that
+// succeeds and returns its name. The destination must be an empty directory;
+// a cloner that fails may leave partial data behind, which the next cloner
+// overwrites.
+func (m *CloneManager) Clone(src, dst string, progress ProgressFunc) (string, error) {
+	var errs []error
+	for _, cloner := range m.cloners {
+		err := cloner.Clone(src, dst, progress)
+		if err == nil {
+			klog.V(4).InfoS("Cloned volume data", "cloner", cloner.Name(), "src", src, "dst", dst)
+			return cloner.Name(), nil
+		}
+		klog.V(4).InfoS("Cloner failed, trying next", "cloner", cloner.Name(), "src", src, "dst", dst, "err", err)
+		errs = append(errs, fmt.Errorf("%s: %v", cloner.Name(), err))
+	}
+	return "", fmt.Errorf("all cloners failed: %v", errs)
+}
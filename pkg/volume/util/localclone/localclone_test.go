@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package localclone
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+type failingCloner struct{}
+
+func (c *failingCloner) Name() string { return "failing" }
+func (c *failingCloner) Clone(src, dst string, progress ProgressFunc) error {
+	return fmt.Errorf("not supported here")
+}
+
+func makeCloneSource(t *testing.T) string {
+	t.Helper()
+	src := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(src, "subdir"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(src, "file1"), []byte("hello"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(src, "subdir", "file2"), []byte(strings.Repeat("data", 1024)), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink("file1", filepath.Join(src, "link1")); err != nil {
+		t.Fatal(err)
+	}
+	return src
+}
+
+func TestChecksumCopyCloner(t *testing.T) {
+	src := makeCloneSource(t)
+	dst := t.TempDir()
+
+	var lastCopied, lastTotal int64
+	progressCalls := 0
+	cloner := &checksumCopyCloner{}
+	err := cloner.Clone(src, dst, func(copiedBytes, totalBytes int64) {
+		progressCalls++
+		lastCopied, lastTotal = copiedBytes, totalBytes
+	})
+	if err != nil {
+		t.Fatalf("Clone failed: %v", err)
+	}
+
+	if progressCalls != 2 {
+		t.Errorf("expected 2 progress calls (one per regular file), got %d", progressCalls)
+	}
+	if lastCopied != lastTotal || lastTotal != int64(len("hello")+4*1024) {
+		t.Errorf("unexpected final progress: copied %d of %d", lastCopied, lastTotal)
+	}
+
+	content, err := os.ReadFile(filepath.Join(dst, "file1"))
+	if err != nil || string(content) != "hello" {
+		t.Errorf("unexpected content of file1: %q, err: %v", content, err)
+	}
+	info, err := os.Stat(filepath.Join(dst, "subdir", "file2"))
+	if err != nil {
+		t.Fatalf("expected subdir/file2 in destination: %v", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("expected file mode 0600 to be preserved, got %v", info.Mode().Perm())
+	}
+	target, err := os.Readlink(filepath.Join(dst, "link1"))
+	if err != nil || target != "file1" {
+		t.Errorf("expected symlink to file1, got %q, err: %v", target, err)
+	}
+}
+
+func TestCloneManagerFallback(t *testing.T) {
+	src := makeCloneSource(t)
+	dst := t.TempDir()
+
+	manager := NewCloneManager(&failingCloner{}, &checksumCopyCloner{})
+	name, err := manager.Clone(src, dst, nil)
+	if err != nil {
+		t.Fatalf("Clone failed: %v", err)
+	}
+	if name != "checksum-copy" {
+		t.Errorf("expected fallback to checksum-copy, got %q", name)
+	}
+
+	manager = NewCloneManager(&failingCloner{})
+	if _, err := manager.Clone(src, dst, nil); err == nil {
+		t.Errorf("expected error when all cloners fail")
+	}
+}
+
+func TestPVCProgressReporter(t *testing.T) {
+	pvc := &v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "clone-dst", Namespace: "ns"},
+	}
+	client := fake.NewSimpleClientset(pvc)
+	progress := PVCProgressReporter(client, pvc)
+
+	progress(1024, 4096)
+	updated, err := client.CoreV1().PersistentVolumeClaims("ns").Get(context.TODO(), "clone-dst", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(updated.Status.Conditions) != 1 {
+		t.Fatalf("expected 1 condition, got %d", len(updated.Status.Conditions))
+	}
+	condition := updated.Status.Conditions[0]
+	if condition.Type != CloneProgressConditionType || condition.Status != v1.ConditionTrue {
+		t.Errorf("unexpected condition: %+v", condition)
+	}
+	if !strings.Contains(condition.Message, "1024 of 4096") {
+		t.Errorf("expected progress in message, got %q", condition.Message)
+	}
+
+	progress(4096, 4096)
+	updated, err = client.CoreV1().PersistentVolumeClaims("ns").Get(context.TODO(), "clone-dst", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(updated.Status.Conditions) != 1 {
+		t.Fatalf("expected condition to be replaced, got %d conditions", len(updated.Status.Conditions))
+	}
+	if updated.Status.Conditions[0].Status != v1.ConditionFalse {
+		t.Errorf("expected condition status False after completion, got %v", updated.Status.Conditions[0].Status)
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package localclone
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientset "k8s.io/client-go/kubernetes"
+)
+
+// CloneProgressConditionType is set on a destination PVC while its data is
+// being cloned from a node-local source, carrying the progress in the
+// condition message.
+const CloneProgressConditionType v1.PersistentVolumeClaimConditionType = "NodeLocalCloneProgress"
+
+// PVCProgressReporter returns a ProgressFunc that mirrors clone progress into
+// a condition on the destination PVC. Update failures are ignored because
+// progress reporting is best-effort; the clone itself is not affected.
+func PVCProgressReporter(kubeClient clientset.Interface, pvc *v1.PersistentVolumeClaim) ProgressFunc {
+	return func(copiedBytes, totalBytes int64) {
+		message := fmt.Sprintf("Cloned %d of %d bytes", copiedBytes, totalBytes)
+		status := v1.ConditionTrue
+		if copiedBytes >= totalBytes {
+			status = v1.ConditionFalse
+			message = fmt.Sprintf("Clone of %d bytes complete", totalBytes)
+		}
+		updatePVCCloneCondition(kubeClient, pvc, status, message)
+	}
+}
+
+// updatePVCCloneCondition sets (or replaces) the clone progress condition on
+// the PVC status. It refetches the PVC so that repeated progress updates do
+// not conflict.
+func updatePVCCloneCondition(kubeClient clientset.Interface, pvc *v1.PersistentVolumeClaim, status v1.ConditionStatus, message string) {
+	current, err := kubeClient.CoreV1().PersistentVolumeClaims(pvc.Namespace).Get(context.TODO(), pvc.Name, metav1.GetOptions{})
+	if err != nil {
+		return
+	}
+	condition := v1.PersistentVolumeClaimCondition{
+		Type:               CloneProgressConditionType,
+		Status:             status,
+		LastTransitionTime: metav1.Now(),
+		Reason:             "NodeLocalClone",
+		Message:            message,
+	}
+	replaced := false
+	for i := range current.Status.Conditions {
+		if current.Status.Conditions[i].Type == CloneProgressConditionType {
+			if current.Status.Conditions[i].Status == status {
+				condition.LastTransitionTime = current.Status.Conditions[i].LastTransitionTime
+			}
+			current.Status.Conditions[i] = condition
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		current.Status.Conditions = append(current.Status.Conditions, condition)
+	}
+	_, _ = kubeClient.CoreV1().PersistentVolumeClaims(current.Namespace).UpdateStatus(context.TODO(), current, metav1.UpdateOptions{})
+}
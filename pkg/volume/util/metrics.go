@@ -63,6 +63,15 @@ var storageOperationEndToEndLatencyMetric = metrics.NewHistogramVec(
 	[]string{"plugin_name", "operation_name"},
 )
 
+var fsGroupDecisionMetric = metrics.NewCounterVec(
+	&metrics.CounterOpts{
+		Name:           "volume_fsgroup_decisions_total",
+		Help:           "Number of fsGroup application decisions made while mounting volumes, partitioned by volume plugin, whether ownership was applied, and the reason.",
+		StabilityLevel: metrics.ALPHA,
+	},
+	[]string{"volume_plugin", "applied", "reason"},
+)
+
 var csiOperationsLatencyMetric = metrics.NewHistogramVec(
 	&metrics.HistogramOpts{
 		Subsystem:      "csi",
@@ -84,6 +93,13 @@ func registerMetrics() {
 	legacyregistry.MustRegister(StorageOperationMetric)
 	legacyregistry.MustRegister(storageOperationEndToEndLatencyMetric)
 	legacyregistry.MustRegister(csiOperationsLatencyMetric)
+	legacyregistry.MustRegister(fsGroupDecisionMetric)
+}
+
+// RecordFSGroupDecisionMetric records one fsGroup application decision for a
+// mount into metric volume_fsgroup_decisions_total.
+func RecordFSGroupDecisionMetric(plugin string, applied bool, reason string) {
+	fsGroupDecisionMetric.WithLabelValues(plugin, strconv.FormatBool(applied), reason).Inc()
 }
 
 // OperationCompleteHook returns a hook to call when an operation is completed
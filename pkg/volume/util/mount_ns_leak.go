@@ -0,0 +1,38 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+// MountNamespaceLeak describes a process that still has a volume path mounted
+// in its own mount namespace after the path was unmounted on the host, e.g.
+// because mount propagation kept a copy of the mount alive in the container's
+// namespace.
+type MountNamespaceLeak struct {
+	// Pid is the process id of the leaking process.
+	Pid int
+	// Command is the process command line (or comm when the command line is
+	// not readable).
+	Command string
+	// MountNamespace identifies the mount namespace holding the leaked
+	// mount, e.g. "mnt:[4026531840]".
+	MountNamespace string
+	// MountPoint is the path of the leaked mount inside the process's mount
+	// namespace.
+	MountPoint string
+	// PodOwned is true when the process cgroup indicates it belongs to the
+	// pod whose UID was passed to FindMountNamespaceLeaks.
+	PodOwned bool
+}
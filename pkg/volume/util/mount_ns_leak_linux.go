@@ -0,0 +1,112 @@
+//go:build linux
+// +build linux
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// FindMountNamespaceLeaks scans /proc for processes in other mount namespaces
+// that still have the given path (or something under it) mounted after the
+// host unmount completed. When podUID is non-empty, leaking processes whose
+// cgroup path contains the pod UID are flagged as owned by that pod. The scan
+// is best-effort: processes that exit or that the kubelet may not inspect are
+// silently skipped.
+func FindMountNamespaceLeaks(path string, podUID types.UID) ([]MountNamespaceLeak, error) {
+	ownNamespace, err := os.Readlink("/proc/self/ns/mnt")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read own mount namespace: %v", err)
+	}
+	procEntries, err := os.ReadDir("/proc")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read /proc: %v", err)
+	}
+
+	var leaks []MountNamespaceLeak
+	for _, entry := range procEntries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+		procDir := filepath.Join("/proc", entry.Name())
+		namespace, err := os.Readlink(filepath.Join(procDir, "ns", "mnt"))
+		if err != nil || namespace == ownNamespace {
+			// The host unmount already removed the mount from the
+			// kubelet's own namespace; only foreign namespaces can
+			// leak it.
+			continue
+		}
+		mountPoint := processMountUnder(procDir, path)
+		if mountPoint == "" {
+			continue
+		}
+		leak := MountNamespaceLeak{
+			Pid:            pid,
+			Command:        processCommand(procDir),
+			MountNamespace: namespace,
+			MountPoint:     mountPoint,
+		}
+		if podUID != "" {
+			leak.PodOwned = processBelongsToPod(procDir, podUID)
+		}
+		leaks = append(leaks, leak)
+	}
+	return leaks, nil
+}
+
+// processMountUnder parses the mountinfo of the process behind procDir and
+// returns the first mount point that equals the given path or is located
+// below it, or "" when the process has no such mount.
+func processMountUnder(procDir, path string) string {
+	file, err := os.Open(filepath.Join(procDir, "mountinfo"))
+	if err != nil {
+		return ""
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		// The mount point is the fifth field of a mountinfo line, see
+		// proc(5).
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 5 {
+			continue
+		}
+		mountPoint := unescapeMountPath(fields[4])
+		if pathIsUnder(path, mountPoint) {
+			return mountPoint
+		}
+	}
+	return ""
+}
+
+// unescapeMountPath decodes the octal escapes mountinfo uses for whitespace
+// and backslashes in mount paths.
+func unescapeMountPath(path string) string {
+	replacer := strings.NewReplacer(`\040`, " ", `\011`, "\t", `\012`, "\n", `\134`, `\`)
+	return replacer.Replace(path)
+}
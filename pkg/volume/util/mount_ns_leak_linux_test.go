@@ -0,0 +1,89 @@
+//go:build linux
+// +build linux
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestProcessMountUnder(t *testing.T) {
+	procDir := t.TempDir()
+	mountinfo := `25 1 8:1 / / rw,relatime shared:1 - ext4 /dev/sda1 rw
+616 25 8:16 / /var/lib/kubelet/pods/uid1/volumes/kubernetes.io~csi/pv1/mount rw,relatime shared:2 - ext4 /dev/sdb rw
+617 25 8:17 / /var/lib/kubelet/pods/uid1/volumes/kubernetes.io~csi/pv\040two/mount rw,relatime shared:3 - ext4 /dev/sdc rw
+`
+	if err := os.WriteFile(filepath.Join(procDir, "mountinfo"), []byte(mountinfo), 0644); err != nil {
+		t.Fatalf("failed to write mountinfo: %v", err)
+	}
+
+	testCases := []struct {
+		name     string
+		path     string
+		expected string
+	}{
+		{
+			name:     "exact mount point",
+			path:     "/var/lib/kubelet/pods/uid1/volumes/kubernetes.io~csi/pv1/mount",
+			expected: "/var/lib/kubelet/pods/uid1/volumes/kubernetes.io~csi/pv1/mount",
+		},
+		{
+			name:     "mount below path",
+			path:     "/var/lib/kubelet/pods/uid1/volumes",
+			expected: "/var/lib/kubelet/pods/uid1/volumes/kubernetes.io~csi/pv1/mount",
+		},
+		{
+			name:     "escaped space in mount point",
+			path:     "/var/lib/kubelet/pods/uid1/volumes/kubernetes.io~csi/pv two/mount",
+			expected: "/var/lib/kubelet/pods/uid1/volumes/kubernetes.io~csi/pv two/mount",
+		},
+		{
+			name:     "no mount under path",
+			path:     "/var/lib/kubelet/pods/uid2",
+			expected: "",
+		},
+		{
+			name:     "prefix is not a path boundary",
+			path:     "/var/lib/kubelet/pods/uid1/volumes/kubernetes.io~csi/pv",
+			expected: "",
+		},
+	}
+	for _, tc := range testCases {
+		if got := processMountUnder(procDir, tc.path); got != tc.expected {
+			t.Errorf("%s: expected %q, got %q", tc.name, tc.expected, got)
+		}
+	}
+}
+
+func TestFindMountNamespaceLeaksSkipsOwnNamespace(t *testing.T) {
+	tmpDir := t.TempDir()
+	leaks, err := FindMountNamespaceLeaks(tmpDir, "")
+	if err != nil {
+		t.Fatalf("FindMountNamespaceLeaks failed: %v", err)
+	}
+	// Every mount this test process can see lives in its own mount
+	// namespace, which is never reported as a leak.
+	for _, leak := range leaks {
+		if leak.Pid == os.Getpid() {
+			t.Errorf("expected current process not to be reported as a leak, got %+v", leak)
+		}
+	}
+}
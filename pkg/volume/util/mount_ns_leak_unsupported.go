@@ -0,0 +1,33 @@
+//go:build !linux
+// +build !linux
+
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"errors"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var errMountNSLeakDetectionUnsupported = errors.New("mount namespace leak detection is only supported on linux")
+
+// FindMountNamespaceLeaks is not supported on this platform.
+func FindMountNamespaceLeaks(path string, podUID types.UID) ([]MountNamespaceLeak, error) {
+	return nil, errMountNSLeakDetectionUnsupported
+}
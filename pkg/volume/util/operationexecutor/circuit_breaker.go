@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operationexecutor
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+)
+
+const (
+	// breakerWindowSize is the number of recent mount outcomes tracked per
+	// volume plugin.
+	breakerWindowSize = 10
+
+	// breakerMinSamples is the minimum number of recent outcomes required
+	// before the breaker may open. It avoids opening on the very first error.
+	breakerMinSamples = 5
+
+	// breakerErrorRateThreshold is the error rate in the tracked window at
+	// which the breaker opens.
+	breakerErrorRateThreshold = 0.8
+
+	// breakerProbeInterval is how often a single probe mount is let through
+	// while the breaker is open. A successful probe closes the breaker.
+	breakerProbeInterval = 30 * time.Second
+)
+
+// mountCircuitBreaker stops issuing new mount operations for a volume plugin
+// whose recent mount operations are failing at a high rate, e.g. because the
+// CSI driver on the node is unhealthy. While open, a single probe mount is
+// let through periodically; the breaker closes as soon as a probe succeeds.
+type mountCircuitBreaker struct {
+	sync.Mutex
+	clock  clock.Clock
+	states map[string]*breakerState
+}
+
+type breakerState struct {
+	// outcomes is a ring buffer of the most recent operation results,
+	// true meaning failure.
+	outcomes [breakerWindowSize]bool
+	next     int
+	filled   int
+
+	// open is true while new operations are rejected for the plugin.
+	open bool
+	// lastProbe is the time the last probe operation was let through while
+	// the breaker was open.
+	lastProbe time.Time
+}
+
+func newMountCircuitBreaker() *mountCircuitBreaker {
+	return &mountCircuitBreaker{
+		clock:  clock.RealClock{},
+		states: make(map[string]*breakerState),
+	}
+}
+
+// allowOperation returns nil when a mount operation for the given plugin may
+// be issued. When the breaker for the plugin is open and no probe is due, an
+// error describing the breaker state is returned.
+func (cb *mountCircuitBreaker) allowOperation(fullPluginName string) error {
+	cb.Lock()
+	defer cb.Unlock()
+
+	state, exists := cb.states[fullPluginName]
+	if !exists || !state.open {
+		return nil
+	}
+	now := cb.clock.Now()
+	if now.Sub(state.lastProbe) >= breakerProbeInterval {
+		state.lastProbe = now
+		klog.V(2).InfoS("Mount circuit breaker open, letting probe operation through", "pluginName", fullPluginName)
+		return nil
+	}
+	return fmt.Errorf(
+		"circuit breaker for volume plugin %q is open because of a high mount error rate on this node; next probe in %v",
+		fullPluginName, breakerProbeInterval-now.Sub(state.lastProbe))
+}
+
+// isOpen reports whether the breaker for the given plugin is currently open,
+// i.e. new mount operations for it are being rejected.
+func (cb *mountCircuitBreaker) isOpen(fullPluginName string) bool {
+	cb.Lock()
+	defer cb.Unlock()
+
+	state, exists := cb.states[fullPluginName]
+	return exists && state.open
+}
+
+// recordOperationOutcome records the result of a mount operation for the
+// given plugin, opening or closing the breaker as required.
+func (cb *mountCircuitBreaker) recordOperationOutcome(fullPluginName string, err error) {
+	cb.Lock()
+	defer cb.Unlock()
+
+	state, exists := cb.states[fullPluginName]
+	if !exists {
+		state = &breakerState{}
+		cb.states[fullPluginName] = state
+	}
+
+	if err == nil && state.open {
+		// A successful probe closes the breaker and resets the window.
+		klog.InfoS("Mount circuit breaker closed after successful probe", "pluginName", fullPluginName)
+		*state = breakerState{}
+		return
+	}
+
+	state.outcomes[state.next] = err != nil
+	state.next = (state.next + 1) % breakerWindowSize
+	if state.filled < breakerWindowSize {
+		state.filled++
+	}
+
+	if state.open || state.filled < breakerMinSamples {
+		return
+	}
+	failures := 0
+	for i := 0; i < state.filled; i++ {
+		if state.outcomes[i] {
+			failures++
+		}
+	}
+	if float64(failures)/float64(state.filled) >= breakerErrorRateThreshold {
+		state.open = true
+		state.lastProbe = cb.clock.Now()
+		klog.InfoS("Mount circuit breaker opened because of a high mount error rate",
+			"pluginName", fullPluginName, "failures", failures, "samples", state.filled)
+	}
+}
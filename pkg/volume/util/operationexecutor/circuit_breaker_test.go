@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operationexecutor
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+const breakerTestPlugin = "kubernetes.io/csi:test-driver"
+
+func newTestBreaker() (*mountCircuitBreaker, *clocktesting.FakeClock) {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	breaker := newMountCircuitBreaker()
+	breaker.clock = fakeClock
+	return breaker, fakeClock
+}
+
+func TestCircuitBreakerOpensOnHighErrorRate(t *testing.T) {
+	breaker, _ := newTestBreaker()
+	opErr := errors.New("mount failed")
+
+	// Stays closed below the minimum sample count.
+	for i := 0; i < breakerMinSamples-1; i++ {
+		breaker.recordOperationOutcome(breakerTestPlugin, opErr)
+		if err := breaker.allowOperation(breakerTestPlugin); err != nil {
+			t.Fatalf("breaker opened after %d failures: %v", i+1, err)
+		}
+	}
+
+	breaker.recordOperationOutcome(breakerTestPlugin, opErr)
+	if err := breaker.allowOperation(breakerTestPlugin); err == nil {
+		t.Fatal("expected breaker to be open after repeated failures")
+	}
+}
+
+func TestCircuitBreakerStaysClosedOnLowErrorRate(t *testing.T) {
+	breaker, _ := newTestBreaker()
+	opErr := errors.New("mount failed")
+
+	for i := 0; i < 2*breakerWindowSize; i++ {
+		if i%2 == 0 {
+			breaker.recordOperationOutcome(breakerTestPlugin, opErr)
+		} else {
+			breaker.recordOperationOutcome(breakerTestPlugin, nil)
+		}
+	}
+	if err := breaker.allowOperation(breakerTestPlugin); err != nil {
+		t.Fatalf("breaker opened at 50%% error rate: %v", err)
+	}
+}
+
+func TestCircuitBreakerProbesAndCloses(t *testing.T) {
+	breaker, fakeClock := newTestBreaker()
+	opErr := errors.New("mount failed")
+
+	for i := 0; i < breakerWindowSize; i++ {
+		breaker.recordOperationOutcome(breakerTestPlugin, opErr)
+	}
+	if err := breaker.allowOperation(breakerTestPlugin); err == nil {
+		t.Fatal("expected breaker to be open")
+	}
+
+	// A probe is let through after the probe interval.
+	fakeClock.Step(breakerProbeInterval)
+	if err := breaker.allowOperation(breakerTestPlugin); err != nil {
+		t.Fatalf("expected probe operation to be allowed: %v", err)
+	}
+	// Only one probe per interval.
+	if err := breaker.allowOperation(breakerTestPlugin); err == nil {
+		t.Fatal("expected second operation within probe interval to be rejected")
+	}
+
+	// A successful probe closes the breaker.
+	breaker.recordOperationOutcome(breakerTestPlugin, nil)
+	if err := breaker.allowOperation(breakerTestPlugin); err != nil {
+		t.Fatalf("expected breaker to be closed after successful probe: %v", err)
+	}
+}
+
+func TestCircuitBreakerIsPerPlugin(t *testing.T) {
+	breaker, _ := newTestBreaker()
+	opErr := errors.New("mount failed")
+
+	for i := 0; i < breakerWindowSize; i++ {
+		breaker.recordOperationOutcome(breakerTestPlugin, opErr)
+	}
+	if err := breaker.allowOperation("kubernetes.io/csi:other-driver"); err != nil {
+		t.Fatalf("breaker of other plugin should be closed: %v", err)
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operationexecutor
+
+import (
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// deviceMountCoalescer deduplicates concurrent MountDevice calls for the same
+// volume. When many pods using one volume land on a node at once, each pod's
+// mount operation wants the device staged; the first caller performs the
+// actual MountDevice and callers arriving while it is in flight wait for that
+// result instead of queueing their own staging call against the driver.
+type deviceMountCoalescer struct {
+	mutex    sync.Mutex
+	inFlight map[v1.UniqueVolumeName]*inFlightDeviceMount
+}
+
+type inFlightDeviceMount struct {
+	// done is closed once the leader's MountDevice call returned and err is
+	// safe to read.
+	done chan struct{}
+	err  error
+	// waiters counts the callers sharing this call's result.
+	waiters int
+}
+
+func newDeviceMountCoalescer() *deviceMountCoalescer {
+	return &deviceMountCoalescer{
+		inFlight: make(map[v1.UniqueVolumeName]*inFlightDeviceMount),
+	}
+}
+
+// mountDevice runs mountFunc unless a MountDevice call for the volume is
+// already in flight, in which case it waits for and returns that call's
+// result. The second return value reports whether the result was shared with
+// an in-flight call.
+func (dc *deviceMountCoalescer) mountDevice(volumeName v1.UniqueVolumeName, mountFunc func() error) (error, bool) {
+	dc.mutex.Lock()
+	if existing, ok := dc.inFlight[volumeName]; ok {
+		existing.waiters++
+		dc.mutex.Unlock()
+		<-existing.done
+		return existing.err, true
+	}
+	call := &inFlightDeviceMount{done: make(chan struct{})}
+	dc.inFlight[volumeName] = call
+	dc.mutex.Unlock()
+
+	call.err = mountFunc()
+
+	dc.mutex.Lock()
+	delete(dc.inFlight, volumeName)
+	dc.mutex.Unlock()
+	close(call.done)
+	return call.err, false
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operationexecutor
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+const coalescerTestVolume = v1.UniqueVolumeName("kubernetes.io/csi/test-driver^vol1")
+
+// waitForWaiters blocks until the given number of callers are waiting on the
+// in-flight call for the volume.
+func waitForWaiters(coalescer *deviceMountCoalescer, volumeName v1.UniqueVolumeName, count int) {
+	for {
+		coalescer.mutex.Lock()
+		call, ok := coalescer.inFlight[volumeName]
+		waiters := 0
+		if ok {
+			waiters = call.waiters
+		}
+		coalescer.mutex.Unlock()
+		if waiters >= count {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestDeviceMountCoalescerSharesInFlightCall(t *testing.T) {
+	coalescer := newDeviceMountCoalescer()
+	var mountCalls int32
+	mountStarted := make(chan struct{})
+	releaseMount := make(chan struct{})
+
+	// Leader blocks inside MountDevice until released.
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		err, shared := coalescer.mountDevice(coalescerTestVolume, func() error {
+			atomic.AddInt32(&mountCalls, 1)
+			close(mountStarted)
+			<-releaseMount
+			return nil
+		})
+		if err != nil || shared {
+			t.Errorf("leader: expected unshared success, got err=%v shared=%v", err, shared)
+		}
+	}()
+	<-mountStarted
+
+	// Waiters arriving while the call is in flight share its result.
+	const waiters = 3
+	wg.Add(waiters)
+	sharedResults := make(chan bool, waiters)
+	for i := 0; i < waiters; i++ {
+		go func() {
+			defer wg.Done()
+			err, shared := coalescer.mountDevice(coalescerTestVolume, func() error {
+				atomic.AddInt32(&mountCalls, 1)
+				return nil
+			})
+			if err != nil {
+				t.Errorf("waiter: unexpected error: %v", err)
+			}
+			sharedResults <- shared
+		}()
+	}
+	waitForWaiters(coalescer, coalescerTestVolume, waiters)
+	close(releaseMount)
+	wg.Wait()
+
+	if calls := atomic.LoadInt32(&mountCalls); calls != 1 {
+		t.Errorf("expected a single MountDevice call, got %d", calls)
+	}
+	for i := 0; i < waiters; i++ {
+		if !<-sharedResults {
+			t.Errorf("expected all waiters to share the in-flight result")
+		}
+	}
+}
+
+func TestDeviceMountCoalescerSharesErrors(t *testing.T) {
+	coalescer := newDeviceMountCoalescer()
+	mountErr := errors.New("staging failed")
+	mountStarted := make(chan struct{})
+	releaseMount := make(chan struct{})
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		err, _ := coalescer.mountDevice(coalescerTestVolume, func() error {
+			close(mountStarted)
+			<-releaseMount
+			return mountErr
+		})
+		if !errors.Is(err, mountErr) {
+			t.Errorf("leader: expected %v, got %v", mountErr, err)
+		}
+	}()
+	<-mountStarted
+
+	waiterDone := make(chan error)
+	go func() {
+		err, shared := coalescer.mountDevice(coalescerTestVolume, func() error { return nil })
+		if !shared {
+			t.Errorf("waiter: expected to share the in-flight result")
+		}
+		waiterDone <- err
+	}()
+	waitForWaiters(coalescer, coalescerTestVolume, 1)
+	close(releaseMount)
+	if err := <-waiterDone; !errors.Is(err, mountErr) {
+		t.Errorf("waiter: expected shared error %v, got %v", mountErr, err)
+	}
+	wg.Wait()
+
+	// With the failed call retired, the next caller runs its own mount.
+	err, shared := coalescer.mountDevice(coalescerTestVolume, func() error { return nil })
+	if err != nil || shared {
+		t.Errorf("retry: expected unshared success, got err=%v shared=%v", err, shared)
+	}
+}
+
+func TestDeviceMountCoalescerDistinctVolumes(t *testing.T) {
+	coalescer := newDeviceMountCoalescer()
+	mountStarted := make(chan struct{})
+	releaseMount := make(chan struct{})
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, _ = coalescer.mountDevice(coalescerTestVolume, func() error {
+			close(mountStarted)
+			<-releaseMount
+			return nil
+		})
+	}()
+	<-mountStarted
+
+	// A different volume is not held up by the in-flight call.
+	err, shared := coalescer.mountDevice(v1.UniqueVolumeName("kubernetes.io/csi/test-driver^vol2"), func() error { return nil })
+	if err != nil || shared {
+		t.Errorf("expected independent mount for a different volume, got err=%v shared=%v", err, shared)
+	}
+
+	close(releaseMount)
+	wg.Wait()
+}
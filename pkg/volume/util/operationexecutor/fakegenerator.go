@@ -91,6 +91,14 @@ func (f *fakeOGCounter) GetVolumePluginMgr() *volume.VolumePluginMgr {
 	return nil
 }
 
+func (f *fakeOGCounter) GenerateOfflineExpandVolumeFunc(volumeSpec *volume.Spec, pvc *v1.PersistentVolumeClaim, newSize resource.Quantity) (volumetypes.GeneratedOperations, error) {
+	return f.recordFuncCall("GenerateOfflineExpandVolumeFunc"), nil
+}
+
+func (f *fakeOGCounter) IsMountCircuitBreakerOpen(fullPluginName string) bool {
+	return false
+}
+
 func (f *fakeOGCounter) GenerateExpandVolumeFunc(*v1.PersistentVolumeClaim, *v1.PersistentVolume) (volumetypes.GeneratedOperations, error) {
 	return f.recordFuncCall("GenerateExpandVolumeFunc"), nil
 }
@@ -48,6 +48,11 @@ type NodeExpander struct {
 	// This can happen when a RWX PVC is expanded.
 	pvcAlreadyUpdated bool
 
+	// expansionCancelled indicates that a pending expansion was cancelled by the user:
+	// allocatedResources shrank back to the already realized capacity, so there is
+	// nothing left to expand on the node.
+	expansionCancelled bool
+
 	// testStatus is used for testing purposes only.
 	testStatus testResponseData
 }
@@ -96,6 +101,18 @@ func (ne *NodeExpander) runPreCheck() bool {
 		ne.markExpansionInfeasibleOnFailure = true
 	}
 
+	// When allocatedResources shrank back to (or below) the already realized capacity
+	// while a node resize was still pending or in progress, the user cancelled the
+	// expansion and kubelet should reconcile by dropping the retry instead of expanding.
+	if allocatedResource, ok := ne.pvc.Status.AllocatedResources[v1.ResourceStorage]; ok {
+		if allocatedResource.Cmp(ne.pvcStatusCap) <= 0 &&
+			(ne.resizeStatus == v1.PersistentVolumeClaimNodeResizePending ||
+				ne.resizeStatus == v1.PersistentVolumeClaimNodeResizeInProgress) {
+			ne.expansionCancelled = true
+			return false
+		}
+	}
+
 	// PVC is already expanded but we are still trying to expand the volume because
 	// last recorded size in ASOW is older. This can happen for RWX volume types.
 	if ne.pvcStatusCap.Cmp(ne.pluginResizeOpts.NewSize) >= 0 &&
@@ -121,8 +138,33 @@ func (ne *NodeExpander) runPreCheck() bool {
 	return false
 }
 
+// cancelExpansion reconciles a cancelled node expansion: the final-error marker
+// is cleared from the actual state of the world so that pending NodeExpand retries
+// stop, and resize status and conditions are removed from the PVC.
+func (ne *NodeExpander) cancelExpansion() (bool, resource.Quantity, error) {
+	ne.actualStateOfWorld.RemoveVolumeFromFailedWithFinalErrors(ne.vmt.VolumeName)
+
+	var err error
+	ne.pvc, err = util.MarkNodeExpansionCancelled(ne.pvc, ne.kubeClient)
+	if err != nil {
+		msg := ne.vmt.GenerateErrorDetailed("MountVolume.NodeExpandVolume failed to mark node expansion as cancelled: %v", err)
+		klog.Error(msg.Error())
+		ne.testStatus = testResponseData{}
+		return false, ne.pluginResizeOpts.OldSize, err
+	}
+
+	simpleMsg, detailedMsg := ne.vmt.GenerateMsg("MountVolume.NodeExpandVolume expansion cancelled", "allocated resources shrank back to the current capacity")
+	ne.recorder.Eventf(ne.pvc, v1.EventTypeNormal, kevents.FileSystemResizeCancelled, simpleMsg)
+	klog.InfoS(detailedMsg)
+	ne.testStatus = testResponseData{false /* resizeCalledOnPlugin */, true /* assumeResizeFinished */}
+	return true, ne.pvcStatusCap, nil
+}
+
 func (ne *NodeExpander) expandOnPlugin() (bool, resource.Quantity, error) {
 	allowExpansion := ne.runPreCheck()
+	if ne.expansionCancelled {
+		return ne.cancelExpansion()
+	}
 	if !allowExpansion {
 		klog.V(3).Infof("NodeExpandVolume is not allowed to proceed for volume %s with resizeStatus %s", ne.vmt.VolumeName, ne.resizeStatus)
 		ne.testStatus = testResponseData{false /* resizeCalledOnPlugin */, true /* assumeResizeFinished */}
@@ -109,6 +109,17 @@ func TestNodeExpander(t *testing.T) {
 			expectFinalErrors:        true,
 			expectedStatusSize:       resource.MustParse("1G"),
 		},
+		{
+			name: "pv.spec.cap > pvc.status.cap, resizeStatus=node_expansion_pending, allocated shrank back (cancelled)",
+			pvc:  getTestPVC("test-vol0", "1G", "1G", "1G", &nodeResizePending),
+			pv:   getTestPV("test-vol0", "2G"),
+
+			expectedResizeStatus:     "",
+			expectResizeCall:         false,
+			assumeResizeOpAsFinished: true,
+			expectFinalErrors:        false,
+			expectedStatusSize:       resource.MustParse("1G"),
+		},
 		{
 			name: "RWO volumes, pv.spec.cap = pvc.status.cap, resizeStatus='', desiredSize > actualSize",
 			pvc:  getTestPVC("test-vol0", "2G", "2G", "2G", nil),
@@ -232,6 +243,10 @@ func (f *fakeActualStateOfWorld) GetDeviceMountState(volumeName v1.UniqueVolumeN
 }
 
 // GetVolumeMountState implements ActualStateOfWorldMounterUpdater.
+func (f *fakeActualStateOfWorld) GetConflictingDeviceMountPathOwner(volumeName v1.UniqueVolumeName, deviceMountPath string) (v1.UniqueVolumeName, bool) {
+	return "", false
+}
+
 func (f *fakeActualStateOfWorld) GetVolumeMountState(volumName v1.UniqueVolumeName, podName volumetypes.UniquePodName) VolumeMountState {
 	panic("unimplemented")
 }
@@ -145,8 +145,16 @@ type OperationExecutor interface {
 	// IsOperationSafeToRetry returns false if an operation for the given volumeName
 	// and one of podName or nodeName is pending or in exponential backoff, otherwise it returns true
 	IsOperationSafeToRetry(volumeName v1.UniqueVolumeName, podName volumetypes.UniquePodName, nodeName types.NodeName, operationName string) bool
+	// IsMountCircuitBreakerOpen reports whether new mount operations for the
+	// given fully qualified plugin name are currently rejected by the mount
+	// circuit breaker.
+	IsMountCircuitBreakerOpen(fullPluginName string) bool
 	// ExpandInUseVolume will resize volume's file system to expected size without unmounting the volume.
 	ExpandInUseVolume(volumeToMount VolumeToMount, actualStateOfWorld ActualStateOfWorldMounterUpdater, currentSize resource.Quantity) error
+	// OfflineExpandVolume finishes a pending filesystem expansion of a volume
+	// that is attached to the node but not used by any pod. The operation is
+	// serialized with all other operations on the same volume.
+	OfflineExpandVolume(volumeName v1.UniqueVolumeName, volumeSpec *volume.Spec, pvc *v1.PersistentVolumeClaim, newSize resource.Quantity) error
 	// ReconstructVolumeOperation construct a new volumeSpec and returns it created by plugin
 	ReconstructVolumeOperation(volumeMode v1.PersistentVolumeMode, plugin volume.VolumePlugin, mapperPlugin volume.BlockVolumePlugin, uid types.UID, podName volumetypes.UniquePodName, volumeSpecName string, volumePath string, pluginName string) (volume.ReconstructedVolume, error)
 }
@@ -203,6 +211,13 @@ type ActualStateOfWorldMounterUpdater interface {
 	// GetDeviceMountState returns mount state of the device in global path
 	GetDeviceMountState(volumeName v1.UniqueVolumeName) DeviceMountState
 
+	// GetConflictingDeviceMountPathOwner returns the name of another volume
+	// that has already claimed the given global device mount path, if any.
+	// Two plugins can compute the same device mount path for different
+	// volumes (e.g. an in-tree plugin and its migrated CSI driver during a
+	// migration window); mounting the second device must be blocked.
+	GetConflictingDeviceMountPathOwner(volumeName v1.UniqueVolumeName, deviceMountPath string) (v1.UniqueVolumeName, bool)
+
 	// GetVolumeMountState returns mount state of the volume for the Pod
 	GetVolumeMountState(volumName v1.UniqueVolumeName, podName volumetypes.UniquePodName) VolumeMountState
 
@@ -795,6 +810,10 @@ func (oe *operationExecutor) IsOperationPending(
 	return oe.pendingOperations.IsOperationPending(volumeName, podName, nodeName)
 }
 
+func (oe *operationExecutor) IsMountCircuitBreakerOpen(fullPluginName string) bool {
+	return oe.operationGenerator.IsMountCircuitBreakerOpen(fullPluginName)
+}
+
 func (oe *operationExecutor) IsOperationSafeToRetry(
 	volumeName v1.UniqueVolumeName,
 	podName volumetypes.UniquePodName,
@@ -976,6 +995,14 @@ func (oe *operationExecutor) ExpandInUseVolume(volumeToMount VolumeToMount, actu
 	return oe.pendingOperations.Run(volumeToMount.VolumeName, "", "" /* nodeName */, generatedOperations)
 }
 
+func (oe *operationExecutor) OfflineExpandVolume(volumeName v1.UniqueVolumeName, volumeSpec *volume.Spec, pvc *v1.PersistentVolumeClaim, newSize resource.Quantity) error {
+	generatedOperations, err := oe.operationGenerator.GenerateOfflineExpandVolumeFunc(volumeSpec, pvc, newSize)
+	if err != nil {
+		return err
+	}
+	return oe.pendingOperations.Run(volumeName, "", "" /* nodeName */, generatedOperations)
+}
+
 func (oe *operationExecutor) VerifyControllerAttachedVolume(
 	logger klog.Logger,
 	volumeToMount VolumeToMount,
@@ -659,6 +659,20 @@ func (fopg *fakeOperationGenerator) GenerateVerifyControllerAttachedVolumeFunc(l
 	}, nil
 }
 
+func (fopg *fakeOperationGenerator) GenerateOfflineExpandVolumeFunc(volumeSpec *volume.Spec, pvc *v1.PersistentVolumeClaim, newSize resource.Quantity) (volumetypes.GeneratedOperations, error) {
+	opFunc := func() volumetypes.OperationContext {
+		startOperationAndBlock(fopg.ch, fopg.quit)
+		return volumetypes.NewOperationContext(nil, nil, false)
+	}
+	return volumetypes.GeneratedOperations{
+		OperationFunc: opFunc,
+	}, nil
+}
+
+func (fopg *fakeOperationGenerator) IsMountCircuitBreakerOpen(fullPluginName string) bool {
+	return false
+}
+
 func (fopg *fakeOperationGenerator) GenerateExpandVolumeFunc(pvc *v1.PersistentVolumeClaim, pv *v1.PersistentVolume) (volumetypes.GeneratedOperations, error) {
 	opFunc := func() volumetypes.OperationContext {
 		startOperationAndBlock(fopg.ch, fopg.quit)
@@ -42,7 +42,10 @@ import (
 	kevents "k8s.io/kubernetes/pkg/kubelet/events"
 	"k8s.io/kubernetes/pkg/volume"
 	"k8s.io/kubernetes/pkg/volume/util"
+	csilib "k8s.io/kubernetes/pkg/volume/csi"
+	volumehooks "k8s.io/kubernetes/pkg/volume/util/hooks"
 	"k8s.io/kubernetes/pkg/volume/util/hostutil"
+	volumetelemetry "k8s.io/kubernetes/pkg/volume/util/telemetry"
 	volumetypes "k8s.io/kubernetes/pkg/volume/util/types"
 	"k8s.io/kubernetes/pkg/volume/util/volumepathhandler"
 )
@@ -70,6 +73,14 @@ type operationGenerator struct {
 
 	// blkUtil provides volume path related operations for block volume
 	blkUtil volumepathhandler.BlockVolumePathHandler
+
+	// mountBreaker stops issuing new mount operations for plugins with a
+	// high mount error rate on this node.
+	mountBreaker *mountCircuitBreaker
+
+	// deviceMounts lets concurrent mount operations for the same volume
+	// share one in-flight MountDevice call.
+	deviceMounts *deviceMountCoalescer
 }
 
 type inTreeResizeResponse struct {
@@ -93,6 +104,8 @@ func NewOperationGenerator(kubeClient clientset.Interface,
 		volumePluginMgr: volumePluginMgr,
 		recorder:        recorder,
 		blkUtil:         blkUtil,
+		mountBreaker:    newMountCircuitBreaker(),
+		deviceMounts:    newDeviceMountCoalescer(),
 	}
 }
 
@@ -131,6 +144,11 @@ type OperationGenerator interface {
 	// GetVolumePluginMgr returns volume plugin manager
 	GetVolumePluginMgr() *volume.VolumePluginMgr
 
+	// IsMountCircuitBreakerOpen reports whether new mount operations for the
+	// given fully qualified plugin name are currently rejected by the mount
+	// circuit breaker.
+	IsMountCircuitBreakerOpen(fullPluginName string) bool
+
 	GenerateExpandVolumeFunc(*v1.PersistentVolumeClaim, *v1.PersistentVolume) (volumetypes.GeneratedOperations, error)
 
 	GenerateExpandAndRecoverVolumeFunc(*v1.PersistentVolumeClaim, *v1.PersistentVolume, string) (volumetypes.GeneratedOperations, error)
@@ -139,6 +157,11 @@ type OperationGenerator interface {
 	// Along with volumeToMount and actualStateOfWorld, the function expects current size of volume on the node as an argument. The current
 	// size here always refers to capacity last recorded in actualStateOfWorld from pvc.Status.Capacity
 	GenerateExpandInUseVolumeFunc(volumeToMount VolumeToMount, actualStateOfWorld ActualStateOfWorldMounterUpdater, currentSize resource.Quantity) (volumetypes.GeneratedOperations, error)
+
+	// Generates the function that finishes a pending filesystem expansion of
+	// a volume that is attached to the node but not used by any pod, and
+	// records the finished resize on the claim.
+	GenerateOfflineExpandVolumeFunc(volumeSpec *volume.Spec, pvc *v1.PersistentVolumeClaim, newSize resource.Quantity) (volumetypes.GeneratedOperations, error)
 }
 
 type inTreeResizeOpts struct {
@@ -237,12 +260,12 @@ func (og *operationGenerator) GenerateVolumesAreAttachedFunc(
 		return volumetypes.NewOperationContext(nil, nil, false)
 	}
 
-	return volumetypes.GeneratedOperations{
+	return withOperationLogging("", "", util.GetFullQualifiedPluginNameForVolume("<n/a>", nil), volumetypes.GeneratedOperations{
 		OperationName:     "verify_volumes_are_attached_per_node",
 		OperationFunc:     volumesAreAttachedFunc,
 		CompleteFunc:      util.OperationCompleteHook(util.GetFullQualifiedPluginNameForVolume("<n/a>", nil), "verify_volumes_are_attached_per_node"),
 		EventRecorderFunc: nil, // nil because we do not want to generate event on error
-	}, nil
+	}), nil
 }
 
 func (og *operationGenerator) GenerateAttachVolumeFunc(
@@ -296,6 +319,11 @@ func (og *operationGenerator) GenerateAttachVolumeFunc(
 			og.recorder.Eventf(pod, v1.EventTypeNormal, kevents.SuccessfulAttachVolume, simpleMsg)
 		}
 		klog.Info(volumeToAttach.GenerateMsgDetailed("AttachVolume.Attach succeeded", ""))
+		volumetelemetry.Record(volumetelemetry.Event{
+			Transition: volumetelemetry.TransitionAttached,
+			VolumeName: string(volumeToAttach.VolumeName),
+			PluginName: attachableVolumePlugin.GetPluginName(),
+		})
 
 		// Update actual state of world
 		addVolumeNodeErr := actualStateOfWorld.MarkVolumeAsAttached(
@@ -330,18 +358,22 @@ func (og *operationGenerator) GenerateAttachVolumeFunc(
 		attachableVolumePluginName = attachableVolumePlugin.GetPluginName()
 	}
 
-	return volumetypes.GeneratedOperations{
+	return withOperationLogging(volumeToAttach.VolumeName, "", util.GetFullQualifiedPluginNameForVolume(attachableVolumePluginName, volumeToAttach.VolumeSpec), volumetypes.GeneratedOperations{
 		OperationName:     "volume_attach",
 		OperationFunc:     attachVolumeFunc,
 		EventRecorderFunc: eventRecorderFunc,
 		CompleteFunc:      util.OperationCompleteHook(util.GetFullQualifiedPluginNameForVolume(attachableVolumePluginName, volumeToAttach.VolumeSpec), "volume_attach"),
-	}
+	})
 }
 
 func (og *operationGenerator) GetVolumePluginMgr() *volume.VolumePluginMgr {
 	return og.volumePluginMgr
 }
 
+func (og *operationGenerator) IsMountCircuitBreakerOpen(fullPluginName string) bool {
+	return og.mountBreaker.isOpen(fullPluginName)
+}
+
 func (og *operationGenerator) GenerateDetachVolumeFunc(
 	logger klog.Logger,
 	volumeToDetach AttachedVolume,
@@ -412,6 +444,11 @@ func (og *operationGenerator) GenerateDetachVolumeFunc(
 		}
 
 		klog.Info(volumeToDetach.GenerateMsgDetailed("DetachVolume.Detach succeeded", ""))
+		volumetelemetry.Record(volumetelemetry.Event{
+			Transition: volumetelemetry.TransitionDetached,
+			VolumeName: string(volumeToDetach.VolumeName),
+			PluginName: pluginName,
+		})
 
 		// Update actual state of world
 		actualStateOfWorld.MarkVolumeAsDetached(
@@ -420,12 +457,12 @@ func (og *operationGenerator) GenerateDetachVolumeFunc(
 		return volumetypes.NewOperationContext(nil, nil, migrated)
 	}
 
-	return volumetypes.GeneratedOperations{
+	return withOperationLogging(volumeToDetach.VolumeName, "", util.GetFullQualifiedPluginNameForVolume(pluginName, volumeToDetach.VolumeSpec), volumetypes.GeneratedOperations{
 		OperationName:     DetachOperationName,
 		OperationFunc:     detachVolumeFunc,
 		CompleteFunc:      util.OperationCompleteHook(util.GetFullQualifiedPluginNameForVolume(pluginName, volumeToDetach.VolumeSpec), DetachOperationName),
 		EventRecorderFunc: nil, // nil because we do not want to generate event on error
-	}, nil
+	}), nil
 }
 
 func (og *operationGenerator) GenerateMountVolumeFunc(
@@ -458,6 +495,13 @@ func (og *operationGenerator) GenerateMountVolumeFunc(
 			return volumetypes.NewOperationContext(eventErr, detailedErr, migrated)
 		}
 
+		// Fail fast while the circuit breaker for this plugin is open because
+		// of a high mount error rate on this node.
+		if breakerErr := og.mountBreaker.allowOperation(util.GetFullQualifiedPluginNameForVolume(volumePlugin.GetPluginName(), volumeToMount.VolumeSpec)); breakerErr != nil {
+			eventErr, detailedErr := volumeToMount.GenerateError("MountVolume.CircuitBreakerOpen", breakerErr)
+			return volumetypes.NewOperationContext(eventErr, detailedErr, migrated)
+		}
+
 		volumeMounter, newMounterErr := volumePlugin.NewMounter(
 			volumeToMount.VolumeSpec,
 			volumeToMount.Pod)
@@ -508,6 +552,13 @@ func (og *operationGenerator) GenerateMountVolumeFunc(
 				fsGroupChangePolicy = podSc.FSGroupChangePolicy
 			}
 		}
+		// The pod may override the fsGroup behavior for individual volumes,
+		// e.g. to skip the ownership change on a shared RWX volume.
+		fsGroup, err = util.ApplyFSGroupOverride(volumeToMount.Pod, volumeToMount.OuterVolumeSpecName, fsGroup)
+		if err != nil {
+			eventErr, detailedErr := volumeToMount.GenerateError("MountVolume.ApplyFSGroupOverride failed", err)
+			return volumetypes.NewOperationContext(eventErr, detailedErr, migrated)
+		}
 
 		devicePath := volumeToMount.DevicePath
 		if volumeAttacher != nil {
@@ -539,13 +590,38 @@ func (og *operationGenerator) GenerateMountVolumeFunc(
 				return volumetypes.NewOperationContext(eventErr, detailedErr, migrated)
 			}
 
-			// Mount device to global mount path
-			err = volumeDeviceMounter.MountDevice(
-				volumeToMount.VolumeSpec,
-				devicePath,
-				deviceMountPath,
-				volume.DeviceMounterArgs{FsGroup: fsGroup, SELinuxLabel: volumeToMount.SELinuxLabel},
-			)
+			// Re-validate node affinity right before staging the device. The
+			// node labels may have changed since the initial check (e.g. while
+			// waiting for attach) and the CSI driver would otherwise fail with
+			// an opaque error.
+			if affinityErr := checkNodeAffinity(og, volumeToMount); affinityErr != nil {
+				eventErr, detailedErr := volumeToMount.GenerateError("MountVolume.MountDevice node affinity re-validation failed", affinityErr)
+				return volumetypes.NewOperationContext(eventErr, detailedErr, migrated)
+			}
+
+			// Refuse to stage the device over a mount path another volume has
+			// already claimed. Different plugins can compute the same global
+			// device mount path for different volumes, e.g. an in-tree plugin
+			// and its migrated CSI driver during a migration window, and the
+			// second mount would corrupt both volumes.
+			if owner, conflict := actualStateOfWorld.GetConflictingDeviceMountPathOwner(volumeToMount.VolumeName, deviceMountPath); conflict {
+				eventErr, detailedErr := volumeToMount.GenerateError("MountVolume.MountDevice failed", fmt.Errorf("device mount path %q is already claimed by volume %q", deviceMountPath, owner))
+				return volumetypes.NewOperationContext(eventErr, detailedErr, migrated)
+			}
+
+			// Mount device to global mount path. Concurrent operations for
+			// the same volume share a single in-flight MountDevice call.
+			err, shared := og.deviceMounts.mountDevice(volumeToMount.VolumeName, func() error {
+				return volumeDeviceMounter.MountDevice(
+					volumeToMount.VolumeSpec,
+					devicePath,
+					deviceMountPath,
+					volume.DeviceMounterArgs{FsGroup: fsGroup, SELinuxLabel: volumeToMount.SELinuxLabel},
+				)
+			})
+			if shared {
+				klog.V(4).InfoS(volumeToMount.GenerateMsgDetailed("MountVolume.MountDevice joined an in-flight device mount", ""), "pod", klog.KObj(volumeToMount.Pod))
+			}
 			if err != nil {
 				og.checkForFailedMount(volumeToMount, err)
 				og.markDeviceErrorState(volumeToMount, devicePath, deviceMountPath, err, actualStateOfWorld)
@@ -555,6 +631,15 @@ func (og *operationGenerator) GenerateMountVolumeFunc(
 			}
 
 			klog.InfoS(volumeToMount.GenerateMsgDetailed("MountVolume.MountDevice succeeded", fmt.Sprintf("device mount path %q", deviceMountPath)), "pod", klog.KObj(volumeToMount.Pod))
+			if !shared {
+				volumetelemetry.Record(volumetelemetry.Event{
+					Transition: volumetelemetry.TransitionStaged,
+					VolumeName: string(volumeToMount.VolumeName),
+					PluginName: volumePlugin.GetPluginName(),
+				})
+			}
+
+			util.CrashAtFailpoint(util.FailpointMountDeviceBeforeMark)
 
 			// Update actual state of world to reflect volume is globally mounted
 			markDeviceMountedErr := actualStateOfWorld.MarkDeviceAsMounted(
@@ -564,6 +649,7 @@ func (og *operationGenerator) GenerateMountVolumeFunc(
 				eventErr, detailedErr := volumeToMount.GenerateError("MountVolume.MarkDeviceAsMounted failed", markDeviceMountedErr)
 				return volumetypes.NewOperationContext(eventErr, detailedErr, migrated)
 			}
+			util.CrashAtFailpoint(util.FailpointMountDeviceAfterMark)
 			// set staging path for volume expansion
 			resizeOptions.DeviceStagePath = deviceMountPath
 		}
@@ -578,6 +664,17 @@ func (og *operationGenerator) GenerateMountVolumeFunc(
 			resizeOptions.DeviceStagePath = deviceStagePath
 		}
 
+		// Run registered pre-mount hooks, e.g. cache warmers of node agents.
+		hookCtx := volumehooks.HookContext{
+			VolumeName: string(volumeToMount.VolumeName),
+			PluginName: volumePlugin.GetPluginName(),
+			PodUID:     volumeToMount.Pod.UID,
+		}
+		if hookErr := volumehooks.DefaultRegistry.Run(volumehooks.PreMount, hookCtx); hookErr != nil {
+			eventErr, detailedErr := volumeToMount.GenerateError("MountVolume.PreMountHook failed", hookErr)
+			return volumetypes.NewOperationContext(eventErr, detailedErr, migrated)
+		}
+
 		// Execute mount
 		mountErr := volumeMounter.SetUp(volume.MounterArgs{
 			FsUser:              util.FsUserFrom(volumeToMount.Pod),
@@ -612,8 +709,34 @@ func (og *operationGenerator) GenerateMountVolumeFunc(
 			verbosity = klog.Level(4)
 		}
 		klog.V(verbosity).InfoS(detailedMsg, "pod", klog.KObj(volumeToMount.Pod))
+		if !isRemount {
+			volumetelemetry.Record(volumetelemetry.Event{
+				Transition: volumetelemetry.TransitionPublished,
+				VolumeName: string(volumeToMount.VolumeName),
+				PluginName: volumePlugin.GetPluginName(),
+				PodUID:     volumeToMount.Pod.UID,
+			})
+		}
 		resizeOptions.DeviceMountPath = volumeMounter.GetPath()
 
+		// Run registered post-mount hooks now that the volume path exists.
+		hookCtx.Path = volumeMounter.GetPath()
+		if hookErr := volumehooks.DefaultRegistry.Run(volumehooks.PostMount, hookCtx); hookErr != nil {
+			og.markVolumeErrorState(volumeToMount, markOpts, hookErr, actualStateOfWorld)
+			eventErr, detailedErr := volumeToMount.GenerateError("MountVolume.PostMountHook failed", hookErr)
+			return volumetypes.NewOperationContext(eventErr, detailedErr, migrated)
+		}
+
+		// Persist the inner->outer volume spec name mapping so that volume
+		// reconstruction after a kubelet restart can resolve the outer name
+		// even when it differs from the inner one (e.g. ephemeral volumes or
+		// expanded-in-use PVCs). This is best effort; on failure the
+		// reconstructed volume falls back to the inner name as before.
+		podDir := filepath.Join(og.volumePluginMgr.Host.GetPodsDir(), string(volumeToMount.Pod.UID))
+		if err := util.PersistOuterVolumeSpecName(podDir, volumeToMount.VolumeSpec.Name(), volumeToMount.OuterVolumeSpecName); err != nil {
+			klog.V(2).InfoS("MountVolume.PersistOuterVolumeSpecName failed", "pod", klog.KObj(volumeToMount.Pod), "err", err)
+		}
+
 		_, resizeError = og.expandVolumeDuringMount(volumeToMount, actualStateOfWorld, resizeOptions)
 		if resizeError != nil {
 			klog.Errorf("MountVolume.NodeExpandVolume failed with %v", resizeError)
@@ -635,12 +758,14 @@ func (og *operationGenerator) GenerateMountVolumeFunc(
 		totalTimeTaken := time.Since(mountRequestTime).Seconds()
 		util.RecordOperationLatencyMetric(util.GetFullQualifiedPluginNameForVolume(volumePluginName, volumeToMount.VolumeSpec), "overall_volume_mount", totalTimeTaken)
 
+		util.CrashAtFailpoint(util.FailpointSetUpBeforeMark)
 		markVolMountedErr := actualStateOfWorld.MarkVolumeAsMounted(markOpts)
 		if markVolMountedErr != nil {
 			// On failure, return error. Caller will log and retry.
 			eventErr, detailedErr := volumeToMount.GenerateError("MountVolume.MarkVolumeAsMounted failed", markVolMountedErr)
 			return volumetypes.NewOperationContext(eventErr, detailedErr, migrated)
 		}
+		util.CrashAtFailpoint(util.FailpointSetUpAfterMark)
 		return volumetypes.NewOperationContext(nil, nil, migrated)
 	}
 
@@ -650,12 +775,17 @@ func (og *operationGenerator) GenerateMountVolumeFunc(
 		}
 	}
 
-	return volumetypes.GeneratedOperations{
+	fullPluginName := util.GetFullQualifiedPluginNameForVolume(volumePluginName, volumeToMount.VolumeSpec)
+	operationCompleteHook := util.OperationCompleteHook(fullPluginName, "volume_mount")
+	return withOperationLogging(volumeToMount.VolumeName, volumeToMount.Pod.UID, fullPluginName, volumetypes.GeneratedOperations{
 		OperationName:     "volume_mount",
 		OperationFunc:     mountVolumeFunc,
 		EventRecorderFunc: eventRecorderFunc,
-		CompleteFunc:      util.OperationCompleteHook(util.GetFullQualifiedPluginNameForVolume(volumePluginName, volumeToMount.VolumeSpec), "volume_mount"),
-	}
+		CompleteFunc: func(c volumetypes.CompleteFuncParam) {
+			og.mountBreaker.recordOperationOutcome(fullPluginName, *c.Err)
+			operationCompleteHook(c)
+		},
+	})
 }
 
 func (og *operationGenerator) checkForFailedMount(volumeToMount VolumeToMount, mountError error) {
@@ -753,6 +883,31 @@ func (og *operationGenerator) GenerateUnmountVolumeFunc(
 			return volumetypes.NewOperationContext(eventErr, detailedErr, migrated)
 		}
 
+		// Run registered pre-unmount hooks, e.g. backup quiescing of node
+		// agents.
+		hookCtx := volumehooks.HookContext{
+			VolumeName: string(volumeToUnmount.VolumeName),
+			PluginName: volumePlugin.GetPluginName(),
+			PodUID:     volumeToUnmount.PodUID,
+			Path:       volumeUnmounter.GetPath(),
+		}
+		if hookErr := volumehooks.DefaultRegistry.Run(volumehooks.PreUnmount, hookCtx); hookErr != nil {
+			eventErr, detailedErr := volumeToUnmount.GenerateError("UnmountVolume.PreUnmountHook failed", hookErr)
+			return volumetypes.NewOperationContext(eventErr, detailedErr, migrated)
+		}
+
+		// Flush dirty pages before the teardown when the syncfs barrier is
+		// enabled, so a subsequent detach never races with pending
+		// writeback. A failed or timed out flush fails the unmount, which
+		// keeps the volume mounted in the actual state of the world and
+		// retries shortly.
+		if syncOnUnmountEnabled() {
+			if flushErr := flushVolumeFilesystem(volumeUnmounter.GetPath()); flushErr != nil {
+				eventErr, detailedErr := volumeToUnmount.GenerateError("UnmountVolume.FlushFilesystem failed", flushErr)
+				return volumetypes.NewOperationContext(eventErr, detailedErr, migrated)
+			}
+		}
+
 		// Execute unmount
 		unmountErr := volumeUnmounter.TearDown()
 		if unmountErr != nil {
@@ -772,6 +927,10 @@ func (og *operationGenerator) GenerateUnmountVolumeFunc(
 				klog.Error(volumeToUnmount.GenerateErrorDetailed("UnmountVolume.MarkVolumeMountAsUncertain failed", markMountUncertainErr).Error())
 			}
 
+			if util.IsDeviceBusyError(unmountErr) {
+				diagnoseDeviceBusy(volumeUnmounter.GetPath(), volumeToUnmount.PodUID)
+			}
+
 			// On failure, return error. Caller will log and retry.
 			eventErr, detailedErr := volumeToUnmount.GenerateError("UnmountVolume.TearDown failed", unmountErr)
 			return volumetypes.NewOperationContext(eventErr, detailedErr, migrated)
@@ -786,6 +945,30 @@ func (og *operationGenerator) GenerateUnmountVolumeFunc(
 			volumeToUnmount.InnerVolumeSpecName,
 			volumeToUnmount.PluginName,
 			volumeToUnmount.VolumeGIDValue)
+		volumetelemetry.Record(volumetelemetry.Event{
+			Transition: volumetelemetry.TransitionTornDown,
+			VolumeName: string(volumeToUnmount.VolumeName),
+			PluginName: volumeToUnmount.PluginName,
+			PodUID:     volumeToUnmount.PodUID,
+		})
+
+		// The host unmount succeeded, but mount propagation may have kept
+		// a copy of the mount alive in a container's mount namespace.
+		if utilfeature.DefaultFeatureGate.Enabled(features.DetectMountNamespaceLeaks) ||
+			utilfeature.DefaultFeatureGate.Enabled(features.RestartMountNamespaceLeakers) {
+			detectMountNamespaceLeaks(volumeUnmounter.GetPath(), volumeToUnmount.PodUID)
+		}
+
+		// Run registered post-unmount hooks. The volume path no longer
+		// exists, so failures are only logged by hooks that ignore them; a
+		// failing policy retries the whole unmount, which is idempotent.
+		hookCtx.Path = ""
+		if hookErr := volumehooks.DefaultRegistry.Run(volumehooks.PostUnmount, hookCtx); hookErr != nil {
+			eventErr, detailedErr := volumeToUnmount.GenerateError("UnmountVolume.PostUnmountHook failed", hookErr)
+			return volumetypes.NewOperationContext(eventErr, detailedErr, migrated)
+		}
+
+		util.CrashAtFailpoint(util.FailpointTearDownBeforeMark)
 
 		// Update actual state of world
 		markVolMountedErr := actualStateOfWorld.MarkVolumeAsUnmounted(
@@ -798,12 +981,74 @@ func (og *operationGenerator) GenerateUnmountVolumeFunc(
 		return volumetypes.NewOperationContext(nil, nil, migrated)
 	}
 
-	return volumetypes.GeneratedOperations{
+	return withOperationLogging(volumeToUnmount.VolumeName, volumeToUnmount.PodUID, util.GetFullQualifiedPluginNameForVolume(volumePlugin.GetPluginName(), volumeToUnmount.VolumeSpec), volumetypes.GeneratedOperations{
 		OperationName:     "volume_unmount",
 		OperationFunc:     unmountVolumeFunc,
 		CompleteFunc:      util.OperationCompleteHook(util.GetFullQualifiedPluginNameForVolume(volumePlugin.GetPluginName(), volumeToUnmount.VolumeSpec), "volume_unmount"),
 		EventRecorderFunc: nil, // nil because we do not want to generate event on error
-	}, nil
+	}), nil
+}
+
+// diagnoseDeviceBusy lists the processes that keep path busy after an unmount
+// failed with EBUSY and logs them, so the holder of a stuck volume can be
+// identified from the kubelet log without a node shell. With the
+// KillVolumeHolderProcesses feature gate enabled and a non-empty podUID,
+// holder processes that belong to that pod are killed so a leaked container
+// process cannot wedge the pod's own unmount forever. Processes outside the
+// pod are never killed.
+func diagnoseDeviceBusy(path string, podUID types.UID) {
+	holders, err := util.FindVolumePathHolders(path, podUID)
+	if err != nil {
+		klog.V(4).InfoS("Failed to look up processes holding volume path", "path", path, "err", err)
+		return
+	}
+	killHolders := utilfeature.DefaultFeatureGate.Enabled(features.KillVolumeHolderProcesses)
+	for _, holder := range holders {
+		klog.InfoS("Volume path is kept busy by process",
+			"path", path, "pid", holder.Pid, "command", holder.Command,
+			"mountNamespace", holder.MountNamespace, "podOwned", holder.PodOwned)
+		if killHolders && holder.PodOwned {
+			if killErr := util.KillVolumePathHolder(holder.Pid); killErr != nil {
+				klog.ErrorS(killErr, "Failed to kill process holding volume path",
+					"path", path, "pid", holder.Pid, "podUID", podUID)
+			} else {
+				klog.InfoS("Killed pod process holding volume path",
+					"path", path, "pid", holder.Pid, "podUID", podUID)
+			}
+		}
+	}
+}
+
+// detectMountNamespaceLeaks lists the processes in other mount namespaces
+// that still have path mounted after the host unmount succeeded and logs
+// them, so a mount leaked into a long-running container can be identified
+// from the kubelet log without a node shell. With the
+// RestartMountNamespaceLeakers feature gate enabled and a non-empty podUID,
+// leaking processes that belong to that pod are killed so the container
+// runtime restarts them with a fresh mount namespace. Processes outside the
+// pod are never killed.
+func detectMountNamespaceLeaks(path string, podUID types.UID) {
+	leaks, err := util.FindMountNamespaceLeaks(path, podUID)
+	if err != nil {
+		klog.V(4).InfoS("Failed to look up mount namespace leaks for volume path", "path", path, "err", err)
+		return
+	}
+	restartLeakers := utilfeature.DefaultFeatureGate.Enabled(features.RestartMountNamespaceLeakers)
+	for _, leak := range leaks {
+		klog.InfoS("Volume mount is leaked into a foreign mount namespace",
+			"path", path, "mountPoint", leak.MountPoint, "pid", leak.Pid,
+			"command", leak.Command, "mountNamespace", leak.MountNamespace,
+			"podOwned", leak.PodOwned)
+		if restartLeakers && leak.PodOwned {
+			if killErr := util.KillVolumePathHolder(leak.Pid); killErr != nil {
+				klog.ErrorS(killErr, "Failed to kill process leaking volume mount",
+					"path", path, "pid", leak.Pid, "podUID", podUID)
+			} else {
+				klog.InfoS("Killed pod process leaking volume mount, its container will be restarted",
+					"path", path, "pid", leak.Pid, "podUID", podUID)
+			}
+		}
+	}
 }
 
 func (og *operationGenerator) GenerateUnmountDeviceFunc(
@@ -865,6 +1110,11 @@ func (og *operationGenerator) GenerateUnmountDeviceFunc(
 				klog.Error(deviceToDetach.GenerateErrorDetailed("UnmountDevice.MarkDeviceAsUncertain failed", markDeviceUncertainErr).Error())
 			}
 
+			// Device mounts have no owning pod, so holders are only logged.
+			if util.IsDeviceBusyError(unmountDeviceErr) {
+				diagnoseDeviceBusy(deviceMountPath, "")
+			}
+
 			// On failure, return error. Caller will log and retry.
 			eventErr, detailedErr := deviceToDetach.GenerateError("UnmountDevice failed", unmountDeviceErr)
 			return volumetypes.NewOperationContext(eventErr, detailedErr, migrated)
@@ -892,6 +1142,13 @@ func (og *operationGenerator) GenerateUnmountDeviceFunc(
 		}
 
 		klog.Info(deviceToDetach.GenerateMsgDetailed("UnmountDevice succeeded", ""))
+		volumetelemetry.Record(volumetelemetry.Event{
+			Transition: volumetelemetry.TransitionUnstaged,
+			VolumeName: string(deviceToDetach.VolumeName),
+			PluginName: deviceMountableVolumePlugin.GetPluginName(),
+		})
+
+		util.CrashAtFailpoint(util.FailpointUnmountDeviceBeforeMark)
 
 		// Update actual state of world
 		markDeviceUnmountedErr := actualStateOfWorld.MarkDeviceAsUnmounted(
@@ -905,12 +1162,12 @@ func (og *operationGenerator) GenerateUnmountDeviceFunc(
 		return volumetypes.NewOperationContext(nil, nil, migrated)
 	}
 
-	return volumetypes.GeneratedOperations{
+	return withOperationLogging(deviceToDetach.VolumeName, "", util.GetFullQualifiedPluginNameForVolume(deviceMountableVolumePlugin.GetPluginName(), deviceToDetach.VolumeSpec), volumetypes.GeneratedOperations{
 		OperationName:     "unmount_device",
 		OperationFunc:     unmountDeviceFunc,
 		CompleteFunc:      util.OperationCompleteHook(util.GetFullQualifiedPluginNameForVolume(deviceMountableVolumePlugin.GetPluginName(), deviceToDetach.VolumeSpec), "unmount_device"),
 		EventRecorderFunc: nil, // nil because we do not want to generate event on error
-	}, nil
+	}), nil
 }
 
 // GenerateMapVolumeFunc marks volume as mounted based on following steps.
@@ -1149,12 +1406,12 @@ func (og *operationGenerator) GenerateMapVolumeFunc(
 		}
 	}
 
-	return volumetypes.GeneratedOperations{
+	return withOperationLogging(volumeToMount.VolumeName, volumeToMount.Pod.UID, util.GetFullQualifiedPluginNameForVolume(blockVolumePlugin.GetPluginName(), volumeToMount.VolumeSpec), volumetypes.GeneratedOperations{
 		OperationName:     "map_volume",
 		OperationFunc:     mapVolumeFunc,
 		EventRecorderFunc: eventRecorderFunc,
 		CompleteFunc:      util.OperationCompleteHook(util.GetFullQualifiedPluginNameForVolume(blockVolumePlugin.GetPluginName(), volumeToMount.VolumeSpec), "map_volume"),
-	}, nil
+	}), nil
 }
 
 // GenerateUnmapVolumeFunc marks volume as unmonuted based on following steps.
@@ -1253,12 +1510,12 @@ func (og *operationGenerator) GenerateUnmapVolumeFunc(
 		return volumetypes.NewOperationContext(nil, nil, migrated)
 	}
 
-	return volumetypes.GeneratedOperations{
+	return withOperationLogging(volumeToUnmount.VolumeName, volumeToUnmount.PodUID, util.GetFullQualifiedPluginNameForVolume(blockVolumePlugin.GetPluginName(), volumeToUnmount.VolumeSpec), volumetypes.GeneratedOperations{
 		OperationName:     "unmap_volume",
 		OperationFunc:     unmapVolumeFunc,
 		CompleteFunc:      util.OperationCompleteHook(util.GetFullQualifiedPluginNameForVolume(blockVolumePlugin.GetPluginName(), volumeToUnmount.VolumeSpec), "unmap_volume"),
 		EventRecorderFunc: nil, // nil because we do not want to generate event on error
-	}, nil
+	}), nil
 }
 
 // GenerateUnmapDeviceFunc marks device as unmounted based on following steps.
@@ -1375,12 +1632,12 @@ func (og *operationGenerator) GenerateUnmapDeviceFunc(
 		return volumetypes.NewOperationContext(nil, nil, migrated)
 	}
 
-	return volumetypes.GeneratedOperations{
+	return withOperationLogging(deviceToDetach.VolumeName, "", util.GetFullQualifiedPluginNameForVolume(blockVolumePlugin.GetPluginName(), deviceToDetach.VolumeSpec), volumetypes.GeneratedOperations{
 		OperationName:     "unmap_device",
 		OperationFunc:     unmapDeviceFunc,
 		CompleteFunc:      util.OperationCompleteHook(util.GetFullQualifiedPluginNameForVolume(blockVolumePlugin.GetPluginName(), deviceToDetach.VolumeSpec), "unmap_device"),
 		EventRecorderFunc: nil, // nil because we do not want to generate event on error
-	}, nil
+	}), nil
 }
 
 func (og *operationGenerator) GenerateVerifyControllerAttachedVolumeFunc(
@@ -1481,12 +1738,12 @@ func (og *operationGenerator) GenerateVerifyControllerAttachedVolumeFunc(
 		return volumetypes.NewOperationContext(eventErr, detailedErr, migrated)
 	}
 
-	return volumetypes.GeneratedOperations{
+	return withOperationLogging(volumeToMount.VolumeName, volumeToMount.Pod.UID, util.GetFullQualifiedPluginNameForVolume(volumePlugin.GetPluginName(), volumeToMount.VolumeSpec), volumetypes.GeneratedOperations{
 		OperationName:     VerifyControllerAttachedVolumeOpName,
 		OperationFunc:     verifyControllerAttachedVolumeFunc,
 		CompleteFunc:      util.OperationCompleteHook(util.GetFullQualifiedPluginNameForVolume(volumePlugin.GetPluginName(), volumeToMount.VolumeSpec), "verify_controller_attached_volume"),
 		EventRecorderFunc: nil, // nil because we do not want to generate event on error
-	}, nil
+	}), nil
 
 }
 
@@ -1601,12 +1858,12 @@ func (og *operationGenerator) GenerateExpandVolumeFunc(
 		}
 	}
 
-	return volumetypes.GeneratedOperations{
+	return withOperationLogging("", "", util.GetFullQualifiedPluginNameForVolume(volumePlugin.GetPluginName(), volumeSpec), volumetypes.GeneratedOperations{
 		OperationName:     "expand_volume",
 		OperationFunc:     expandVolumeFunc,
 		EventRecorderFunc: eventRecorderFunc,
 		CompleteFunc:      util.OperationCompleteHook(util.GetFullQualifiedPluginNameForVolume(volumePlugin.GetPluginName(), volumeSpec), "expand_volume"),
-	}, nil
+	}), nil
 }
 
 func (og *operationGenerator) GenerateExpandAndRecoverVolumeFunc(
@@ -1646,12 +1903,12 @@ func (og *operationGenerator) GenerateExpandAndRecoverVolumeFunc(
 		}
 	}
 
-	return volumetypes.GeneratedOperations{
+	return withOperationLogging("", "", util.GetFullQualifiedPluginNameForVolume(volumePlugin.GetPluginName(), volumeSpec), volumetypes.GeneratedOperations{
 		OperationName:     "expand_volume",
 		OperationFunc:     expandVolumeFunc,
 		EventRecorderFunc: eventRecorderFunc,
 		CompleteFunc:      util.OperationCompleteHook(util.GetFullQualifiedPluginNameForVolume(volumePlugin.GetPluginName(), volumeSpec), "expand_volume"),
-	}, nil
+	}), nil
 }
 
 // Deprecated: This function should not called by any controller code in future and should be removed
@@ -1922,11 +2179,50 @@ func (og *operationGenerator) GenerateExpandInUseVolumeFunc(
 		}
 	}
 
-	return volumetypes.GeneratedOperations{
+	return withOperationLogging(volumeToMount.VolumeName, volumeToMount.Pod.UID, util.GetFullQualifiedPluginNameForVolume(volumePlugin.GetPluginName(), volumeToMount.VolumeSpec), volumetypes.GeneratedOperations{
 		OperationName:     "volume_fs_resize",
 		OperationFunc:     fsResizeFunc,
 		EventRecorderFunc: eventRecorderFunc,
 		CompleteFunc:      util.OperationCompleteHook(util.GetFullQualifiedPluginNameForVolume(volumePlugin.GetPluginName(), volumeToMount.VolumeSpec), "volume_fs_resize"),
+	}), nil
+}
+
+func (og *operationGenerator) GenerateOfflineExpandVolumeFunc(
+	volumeSpec *volume.Spec,
+	pvc *v1.PersistentVolumeClaim,
+	newSize resource.Quantity) (volumetypes.GeneratedOperations, error) {
+
+	expandablePlugin, err := og.volumePluginMgr.FindNodeExpandablePluginBySpec(volumeSpec)
+	if err != nil || expandablePlugin == nil {
+		return volumetypes.GeneratedOperations{}, fmt.Errorf("OfflineExpandVolume.FindNodeExpandablePluginBySpec failed for volume %q: %v", volumeSpec.Name(), err)
+	}
+	offlineExpander, ok := expandablePlugin.(csilib.OfflineNodeExpander)
+	if !ok {
+		return volumetypes.GeneratedOperations{}, fmt.Errorf("OfflineExpandVolume failed: plugin %q does not support offline node expansion", expandablePlugin.GetPluginName())
+	}
+	fullPluginName := util.GetFullQualifiedPluginNameForVolume(expandablePlugin.GetPluginName(), volumeSpec)
+
+	offlineExpandFunc := func() volumetypes.OperationContext {
+		resized, expandErr := offlineExpander.OfflineNodeExpand(volumeSpec, newSize)
+		if expandErr != nil {
+			err := fmt.Errorf("OfflineExpandVolume.OfflineNodeExpand failed for volume %q: %v", volumeSpec.Name(), expandErr)
+			return volumetypes.NewOperationContext(err, err, false)
+		}
+		if !resized {
+			return volumetypes.NewOperationContext(nil, nil, false)
+		}
+		if _, markErr := util.MarkFSResizeFinished(pvc, newSize, og.kubeClient); markErr != nil {
+			err := fmt.Errorf("OfflineExpandVolume.MarkFSResizeFinished failed for volume %q: %v", volumeSpec.Name(), markErr)
+			return volumetypes.NewOperationContext(err, err, false)
+		}
+		klog.InfoS("Expanded volume offline", "volumeName", volumeSpec.Name(), "PVC", klog.KObj(pvc), "newSize", newSize.String())
+		return volumetypes.NewOperationContext(nil, nil, false)
+	}
+
+	return volumetypes.GeneratedOperations{
+		OperationName: "volume_fs_resize_offline",
+		OperationFunc: offlineExpandFunc,
+		CompleteFunc:  util.OperationCompleteHook(fullPluginName, "volume_fs_resize_offline"),
 	}, nil
 }
 
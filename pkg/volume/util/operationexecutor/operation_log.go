@@ -0,0 +1,79 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operationexecutor
+
+import (
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	volumetypes "k8s.io/kubernetes/pkg/volume/util/types"
+)
+
+// Keys used by every per-operation log entry. Operation logging must go
+// through withOperationLogging so that all operations emit the same key set
+// and log-based SLOs do not need per-call-site parsing. Do not log operation
+// start or completion with ad-hoc klog calls.
+const (
+	logKeyOperation  = "operation"
+	logKeyVolumeName = "volumeName"
+	logKeyPodUID     = "podUID"
+	logKeyPlugin     = "plugin"
+	logKeyDurationMs = "durationMs"
+)
+
+// withOperationLogging decorates the generated operations so that the
+// operation logs its start at V(4), successful completion at V(4) and failure
+// as an error, each with the contract key set (operation, volumeName, podUID,
+// plugin) plus the duration in milliseconds on completion. Fields that do not
+// apply to an operation (e.g. podUID for attach) are logged empty rather than
+// omitted so the key set stays fixed.
+func withOperationLogging(volumeName v1.UniqueVolumeName, podUID k8stypes.UID, pluginName string, ops volumetypes.GeneratedOperations) volumetypes.GeneratedOperations {
+	// Attach pprof labels around the operation itself so that profiles
+	// attribute CPU on operation goroutines to the plugin and operation.
+	ops = withProfilingLabels(pluginName, ops)
+	operationFunc := ops.OperationFunc
+	operationName := ops.OperationName
+	ops.OperationFunc = func() volumetypes.OperationContext {
+		startTime := time.Now()
+		klog.V(4).InfoS("Volume operation started",
+			logKeyOperation, operationName,
+			logKeyVolumeName, volumeName,
+			logKeyPodUID, podUID,
+			logKeyPlugin, pluginName)
+		context := operationFunc()
+		durationMs := time.Since(startTime).Milliseconds()
+		if context.DetailedErr != nil {
+			klog.ErrorS(context.DetailedErr, "Volume operation failed",
+				logKeyOperation, operationName,
+				logKeyVolumeName, volumeName,
+				logKeyPodUID, podUID,
+				logKeyPlugin, pluginName,
+				logKeyDurationMs, durationMs)
+		} else {
+			klog.V(4).InfoS("Volume operation finished",
+				logKeyOperation, operationName,
+				logKeyVolumeName, volumeName,
+				logKeyPodUID, podUID,
+				logKeyPlugin, pluginName,
+				logKeyDurationMs, durationMs)
+		}
+		return context
+	}
+	return ops
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2025 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operationexecutor
+
+import (
+	"fmt"
+	"testing"
+
+	volumetypes "k8s.io/kubernetes/pkg/volume/util/types"
+)
+
+func TestWithOperationLogging(t *testing.T) {
+	testCases := []struct {
+		name        string
+		detailedErr error
+	}{
+		{name: "successful operation", detailedErr: nil},
+		{name: "failed operation", detailedErr: fmt.Errorf("operation failed")},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			executed := false
+			ops := withOperationLogging("fake-volume", "fake-pod-uid", "fake-plugin", volumetypes.GeneratedOperations{
+				OperationName: "fake_operation",
+				OperationFunc: func() volumetypes.OperationContext {
+					executed = true
+					return volumetypes.NewOperationContext(tc.detailedErr, tc.detailedErr, true)
+				},
+			})
+			context := ops.OperationFunc()
+			if !executed {
+				t.Errorf("expected wrapped operation func to run")
+			}
+			if context.DetailedErr != tc.detailedErr {
+				t.Errorf("expected DetailedErr %v, got: %v", tc.detailedErr, context.DetailedErr)
+			}
+			if !context.Migrated {
+				t.Errorf("expected Migrated to be preserved by the wrapper")
+			}
+		})
+	}
+}
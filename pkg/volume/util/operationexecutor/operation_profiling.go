@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operationexecutor
+
+import (
+	"context"
+	"runtime/pprof"
+
+	volumetypes "k8s.io/kubernetes/pkg/volume/util/types"
+)
+
+// Label keys attached to operation goroutines. Profiles collected from the
+// kubelet's /debug/pprof endpoints group samples by these labels.
+const (
+	profileLabelPlugin    = "volume_plugin"
+	profileLabelOperation = "volume_operation"
+)
+
+// withProfilingLabels decorates the generated operations so that the
+// operation function runs with pprof labels identifying the volume plugin
+// and the operation name. CPU profiles collected from the kubelet while
+// volume operations are in flight then attribute samples to specific
+// drivers and operations, which helps when escalating performance problems
+// to storage vendors.
+func withProfilingLabels(pluginName string, ops volumetypes.GeneratedOperations) volumetypes.GeneratedOperations {
+	operationFunc := ops.OperationFunc
+	labels := pprof.Labels(
+		profileLabelPlugin, pluginName,
+		profileLabelOperation, ops.OperationName)
+	ops.OperationFunc = func() volumetypes.OperationContext {
+		var opContext volumetypes.OperationContext
+		pprof.Do(context.Background(), labels, func(context.Context) {
+			opContext = operationFunc()
+		})
+		return opContext
+	}
+	return ops
+}
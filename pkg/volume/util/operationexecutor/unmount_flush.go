@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operationexecutor
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/kubernetes/pkg/features"
+)
+
+// defaultSyncOnUnmountTimeout bounds a single flush attempt. Unmounts whose
+// flush exceeds it fail and are retried by the reconciler.
+const defaultSyncOnUnmountTimeout = 30 * time.Second
+
+// syncOnUnmountEnabled returns true if the syncfs barrier before volume
+// teardown is enabled. With the SyncOnVolumeUnmount feature gate enabled the
+// kubelet flushes the filesystem of a volume before tearing down its last
+// mount; the unmount fails and is retried while the flush has not completed,
+// so the attach/detach controller never detaches a device with dirty pages.
+func syncOnUnmountEnabled() bool {
+	return utilfeature.DefaultFeatureGate.Enabled(features.SyncOnVolumeUnmount)
+}
+
+// flushVolumeFilesystem writes back all dirty pages of the filesystem
+// mounted at the given path and waits for completion, at most for the
+// configured timeout. A missing path is not an error: the volume is already
+// gone and there is nothing to flush. On timeout the flush keeps running in
+// the background and the returned error makes the caller retry the unmount,
+// which finds a clean filesystem once the flush completed.
+func flushVolumeFilesystem(path string) error {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil
+	}
+
+	timeout := defaultSyncOnUnmountTimeout
+	done := make(chan error, 1)
+	go func() {
+		done <- syncFilesystem(path)
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(timeout):
+		return fmt.Errorf("flushing filesystem at %s did not complete within %v", path, timeout)
+	}
+}
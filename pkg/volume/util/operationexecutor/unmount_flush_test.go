@@ -0,0 +1,44 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operationexecutor
+
+import (
+	"testing"
+
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+	"k8s.io/kubernetes/pkg/features"
+)
+
+func TestSyncOnUnmountEnabled(t *testing.T) {
+	if syncOnUnmountEnabled() {
+		t.Errorf("expected the syncfs barrier to be disabled by default")
+	}
+	featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, features.SyncOnVolumeUnmount, true)
+	if !syncOnUnmountEnabled() {
+		t.Errorf("expected the syncfs barrier to be enabled")
+	}
+}
+
+func TestFlushVolumeFilesystem(t *testing.T) {
+	if err := flushVolumeFilesystem(t.TempDir()); err != nil {
+		t.Errorf("expected the flush of an existing directory to succeed, got %v", err)
+	}
+	if err := flushVolumeFilesystem("/nonexistent-volume-path"); err != nil {
+		t.Errorf("expected the flush of a missing path to be a no-op, got %v", err)
+	}
+}
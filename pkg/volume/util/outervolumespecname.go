@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// outerVolumeSpecNamesFile is the name of the per-pod metadata file that
+// records the mapping from inner to outer volume spec names. It lives
+// directly in the pod's directory, next to the "volumes" directory.
+const outerVolumeSpecNamesFile = "volume_spec_names.json"
+
+// outerVolumeSpecNamesLock serializes updates to the per-pod metadata files.
+// Mount operations for different volumes of the same pod may run in parallel.
+var outerVolumeSpecNamesLock sync.Mutex
+
+// PersistOuterVolumeSpecName records the mapping from a volume's inner spec
+// name (the name of the PV or the generated in-tree spec name) to its outer
+// spec name (pod.Spec.Volumes[x].Name) in a small JSON file in the pod's
+// directory. The mapping is used to resolve the outer name during volume
+// reconstruction after a kubelet restart, when the pod object may no longer
+// be available. The file is removed together with the pod directory.
+func PersistOuterVolumeSpecName(podDir, innerVolumeSpecName, outerVolumeSpecName string) error {
+	outerVolumeSpecNamesLock.Lock()
+	defer outerVolumeSpecNamesLock.Unlock()
+
+	names, err := loadOuterVolumeSpecNames(podDir)
+	if err != nil {
+		return err
+	}
+	if names[innerVolumeSpecName] == outerVolumeSpecName {
+		// Already up to date, avoid rewriting the file on every remount.
+		return nil
+	}
+	names[innerVolumeSpecName] = outerVolumeSpecName
+
+	data, err := json.Marshal(names)
+	if err != nil {
+		return fmt.Errorf("failed to marshal volume spec names for pod dir %q: %w", podDir, err)
+	}
+	filePath := filepath.Join(podDir, outerVolumeSpecNamesFile)
+	tmpPath := filePath + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0600); err != nil {
+		return fmt.Errorf("failed to write %q: %w", tmpPath, err)
+	}
+	if err := os.Rename(tmpPath, filePath); err != nil {
+		return fmt.Errorf("failed to rename %q to %q: %w", tmpPath, filePath, err)
+	}
+	return nil
+}
+
+// GetPersistedOuterVolumeSpecNames returns the inner to outer volume spec
+// name mapping persisted for the pod, or an empty map if none was recorded.
+func GetPersistedOuterVolumeSpecNames(podDir string) (map[string]string, error) {
+	outerVolumeSpecNamesLock.Lock()
+	defer outerVolumeSpecNamesLock.Unlock()
+	return loadOuterVolumeSpecNames(podDir)
+}
+
+func loadOuterVolumeSpecNames(podDir string) (map[string]string, error) {
+	filePath := filepath.Join(podDir, outerVolumeSpecNamesFile)
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[string]string{}, nil
+		}
+		return nil, fmt.Errorf("failed to read %q: %w", filePath, err)
+	}
+	names := map[string]string{}
+	if err := json.Unmarshal(data, &names); err != nil {
+		return nil, fmt.Errorf("failed to parse %q: %w", filePath, err)
+	}
+	return names, nil
+}
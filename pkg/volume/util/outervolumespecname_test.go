@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestPersistOuterVolumeSpecName(t *testing.T) {
+	podDir := t.TempDir()
+
+	// No file yet: expect an empty map.
+	names, err := GetPersistedOuterVolumeSpecNames(podDir)
+	if err != nil {
+		t.Fatalf("unexpected error reading empty pod dir: %v", err)
+	}
+	if len(names) != 0 {
+		t.Errorf("expected empty map, got %v", names)
+	}
+
+	if err := PersistOuterVolumeSpecName(podDir, "pvc-12345", "my-volume"); err != nil {
+		t.Fatalf("failed to persist mapping: %v", err)
+	}
+	if err := PersistOuterVolumeSpecName(podDir, "pvc-67890", "other-volume"); err != nil {
+		t.Fatalf("failed to persist second mapping: %v", err)
+	}
+	// Re-persisting the same mapping must be a no-op.
+	if err := PersistOuterVolumeSpecName(podDir, "pvc-12345", "my-volume"); err != nil {
+		t.Fatalf("failed to re-persist mapping: %v", err)
+	}
+
+	names, err = GetPersistedOuterVolumeSpecNames(podDir)
+	if err != nil {
+		t.Fatalf("failed to read persisted mappings: %v", err)
+	}
+	expected := map[string]string{
+		"pvc-12345": "my-volume",
+		"pvc-67890": "other-volume",
+	}
+	if !reflect.DeepEqual(expected, names) {
+		t.Errorf("expected %v, got %v", expected, names)
+	}
+}
+
+func TestGetPersistedOuterVolumeSpecNamesCorruptFile(t *testing.T) {
+	podDir := t.TempDir()
+	filePath := filepath.Join(podDir, outerVolumeSpecNamesFile)
+	if err := os.WriteFile(filePath, []byte("not json"), 0600); err != nil {
+		t.Fatalf("failed to write corrupt file: %v", err)
+	}
+
+	if _, err := GetPersistedOuterVolumeSpecNames(podDir); err == nil {
+		t.Error("expected error reading corrupt file, got none")
+	}
+}
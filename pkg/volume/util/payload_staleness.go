@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// PayloadRefreshKey identifies a single API-object-backed volume on this
+// node for payload staleness tracking.
+type PayloadRefreshKey struct {
+	// PodUID is the UID of the pod the volume is mounted for.
+	PodUID types.UID
+	// VolumeName is the name of the volume within the pod spec.
+	VolumeName string
+	// PluginName is the name of the volume plugin that wrote the payload.
+	PluginName string
+}
+
+// PayloadRefresh records the last time a volume plugin successfully wrote
+// the payload of an API object (secret, configmap, projected sources) to
+// disk, together with the pod identity used to label metrics.
+type PayloadRefresh struct {
+	// PodNamespace is the namespace of the pod the volume belongs to.
+	PodNamespace string
+	// PodName is the name of the pod the volume belongs to.
+	PodName string
+	// LastWrite is the time the payload was last written to disk.
+	LastWrite time.Time
+}
+
+// payloadRefreshTracker keeps the last successful payload write per volume.
+// Entries are added on SetUp and removed on TearDown, so the tracker only
+// covers volumes that are currently mounted on the node.
+type payloadRefreshTracker struct {
+	sync.Mutex
+	refreshes map[PayloadRefreshKey]PayloadRefresh
+}
+
+var payloadRefreshes = &payloadRefreshTracker{
+	refreshes: map[PayloadRefreshKey]PayloadRefresh{},
+}
+
+// RecordPayloadRefresh records a successful payload write for the given
+// volume. It is called by the secret, configmap and projected volume
+// plugins after the atomic writer has published a new payload.
+func RecordPayloadRefresh(key PayloadRefreshKey, podNamespace, podName string, when time.Time) {
+	payloadRefreshes.Lock()
+	defer payloadRefreshes.Unlock()
+	payloadRefreshes.refreshes[key] = PayloadRefresh{
+		PodNamespace: podNamespace,
+		PodName:      podName,
+		LastWrite:    when,
+	}
+}
+
+// ForgetPayloadRefresh drops the tracked refresh for the given volume.
+// It is called when the volume is torn down so that unmounted volumes do
+// not report ever-growing staleness.
+func ForgetPayloadRefresh(key PayloadRefreshKey) {
+	payloadRefreshes.Lock()
+	defer payloadRefreshes.Unlock()
+	delete(payloadRefreshes.refreshes, key)
+}
+
+// VisitPayloadRefreshes calls visit for every tracked volume payload.
+// The visit function must not call back into this package.
+func VisitPayloadRefreshes(visit func(key PayloadRefreshKey, refresh PayloadRefresh)) {
+	payloadRefreshes.Lock()
+	defer payloadRefreshes.Unlock()
+	for key, refresh := range payloadRefreshes.refreshes {
+		visit(key, refresh)
+	}
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPayloadRefreshTracking(t *testing.T) {
+	key1 := PayloadRefreshKey{PodUID: "uid1", VolumeName: "vol1", PluginName: "kubernetes.io/secret"}
+	key2 := PayloadRefreshKey{PodUID: "uid2", VolumeName: "vol2", PluginName: "kubernetes.io/configmap"}
+	defer func() {
+		ForgetPayloadRefresh(key1)
+		ForgetPayloadRefresh(key2)
+	}()
+
+	firstWrite := time.Now().Add(-time.Minute)
+	RecordPayloadRefresh(key1, "ns1", "pod1", firstWrite)
+	RecordPayloadRefresh(key2, "ns2", "pod2", time.Now())
+
+	collect := func() map[PayloadRefreshKey]PayloadRefresh {
+		refreshes := map[PayloadRefreshKey]PayloadRefresh{}
+		VisitPayloadRefreshes(func(key PayloadRefreshKey, refresh PayloadRefresh) {
+			refreshes[key] = refresh
+		})
+		return refreshes
+	}
+
+	refreshes := collect()
+	refresh, ok := refreshes[key1]
+	if !ok {
+		t.Fatalf("expected a refresh tracked for %v", key1)
+	}
+	if refresh.PodNamespace != "ns1" || refresh.PodName != "pod1" || !refresh.LastWrite.Equal(firstWrite) {
+		t.Errorf("unexpected refresh for %v: %+v", key1, refresh)
+	}
+	if _, ok := refreshes[key2]; !ok {
+		t.Fatalf("expected a refresh tracked for %v", key2)
+	}
+
+	// A second write for the same volume replaces the previous entry.
+	secondWrite := time.Now()
+	RecordPayloadRefresh(key1, "ns1", "pod1", secondWrite)
+	if refresh := collect()[key1]; !refresh.LastWrite.Equal(secondWrite) {
+		t.Errorf("expected last write %v, got %v", secondWrite, refresh.LastWrite)
+	}
+
+	ForgetPayloadRefresh(key1)
+	refreshes = collect()
+	if _, ok := refreshes[key1]; ok {
+		t.Errorf("expected no refresh tracked for %v after forgetting it", key1)
+	}
+	if _, ok := refreshes[key2]; !ok {
+		t.Errorf("expected refresh for %v to survive forgetting %v", key2, key1)
+	}
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	v1 "k8s.io/api/core/v1"
+)
+
+// SnapshotInProgressAnnotation is set on a PersistentVolume by an external
+// snapshotting component while a point-in-time snapshot of the staged volume
+// is being taken on the node. While the annotation is present the kubelet
+// defers NodeUnstage of the volume for a bounded time, so pod teardown does
+// not pull the filesystem out from under an in-flight snapshot.
+const SnapshotInProgressAnnotation = "volume.kubernetes.io/snapshot-in-progress"
+
+// SnapshotInProgress returns true if the given PV carries the
+// SnapshotInProgressAnnotation with a value other than "false".
+func SnapshotInProgress(pv *v1.PersistentVolume) bool {
+	if pv == nil {
+		return false
+	}
+	value, ok := pv.Annotations[SnapshotInProgressAnnotation]
+	return ok && value != "false"
+}
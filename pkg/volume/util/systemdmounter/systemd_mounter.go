@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package systemdmounter provides an optional mounter backend that performs
+// kubelet mounts through transient systemd mount units. Mount units give
+// systemd full knowledge of the mount, so ordered teardown at node shutdown
+// comes for free and every kubelet mount is inspectable with systemctl.
+package systemdmounter
+
+import (
+	"fmt"
+	"strings"
+
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/features"
+	"k8s.io/mount-utils"
+	utilexec "k8s.io/utils/exec"
+)
+
+const (
+	systemdMountCmd  = "systemd-mount"
+	systemdUmountCmd = "systemd-umount"
+)
+
+// Enabled returns whether the systemd mount unit backend was selected for
+// this node via the KubeletSystemdMountUnits feature gate. Nodes without
+// systemd keep the default mounter.
+func Enabled() bool {
+	return utilfeature.DefaultFeatureGate.Enabled(features.KubeletSystemdMountUnits)
+}
+
+// systemdMounter performs mounts through transient systemd mount units and
+// delegates everything else to the wrapped mounter. Mounts with sensitive
+// options bypass systemd: unit properties are world-readable through
+// systemctl and must never carry credentials.
+type systemdMounter struct {
+	mount.Interface
+
+	exec utilexec.Interface
+}
+
+// New wraps the given mounter so that plain mounts and unmounts go through
+// transient systemd mount units.
+func New(delegate mount.Interface, exec utilexec.Interface) mount.Interface {
+	return &systemdMounter{
+		Interface: delegate,
+		exec:      exec,
+	}
+}
+
+// Mount creates a transient systemd mount unit for the target. When
+// systemd-mount fails, e.g. on a node that turns out not to run systemd, the
+// mount falls back to the wrapped mounter so the volume still comes up.
+func (sm *systemdMounter) Mount(source string, target string, fstype string, options []string) error {
+	args := []string{"--collect"}
+	if fstype != "" {
+		args = append(args, "-t", fstype)
+	}
+	if len(options) > 0 {
+		args = append(args, "-o", strings.Join(options, ","))
+	}
+	args = append(args, source, target)
+
+	output, err := sm.exec.Command(systemdMountCmd, args...).CombinedOutput()
+	if err == nil {
+		klog.V(4).InfoS("Mounted via transient systemd mount unit", "source", source, "target", target, "fstype", fstype)
+		return nil
+	}
+	klog.ErrorS(err, "systemd-mount failed, falling back to plain mount",
+		"source", source, "target", target, "fstype", fstype, "output", string(output))
+	return sm.Interface.Mount(source, target, fstype, options)
+}
+
+// MountSensitive delegates to the wrapped mounter; sensitive options must
+// not end up in systemd unit properties.
+func (sm *systemdMounter) MountSensitive(source string, target string, fstype string, options []string, sensitiveOptions []string) error {
+	return sm.Interface.MountSensitive(source, target, fstype, options, sensitiveOptions)
+}
+
+// Unmount stops the mount unit of the target. Targets that systemd does not
+// know about, e.g. mounts created before the backend was enabled, are
+// unmounted by the wrapped mounter instead.
+func (sm *systemdMounter) Unmount(target string) error {
+	output, err := sm.exec.Command(systemdUmountCmd, target).CombinedOutput()
+	if err == nil {
+		klog.V(4).InfoS("Unmounted systemd mount unit", "target", target)
+		return nil
+	}
+	klog.V(4).InfoS("systemd-umount failed, falling back to plain unmount",
+		"target", target, "err", err, "output", string(output))
+	if unmountErr := sm.Interface.Unmount(target); unmountErr != nil {
+		return fmt.Errorf("systemd-umount failed (%v) and so did the fallback unmount: %w", err, unmountErr)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package systemdmounter
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	utilfeature "k8s.io/apiserver/pkg/util/feature"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+	"k8s.io/kubernetes/pkg/features"
+	"k8s.io/mount-utils"
+	utilexec "k8s.io/utils/exec"
+	fakeexec "k8s.io/utils/exec/testing"
+)
+
+func fakeExecutor(t *testing.T, expectedCmd string, expectedArgs []string, err error) *fakeexec.FakeExec {
+	fakeCmd := &fakeexec.FakeCmd{
+		CombinedOutputScript: []fakeexec.FakeAction{
+			func() ([]byte, []byte, error) { return []byte("fake output"), nil, err },
+		},
+	}
+	return &fakeexec.FakeExec{
+		CommandScript: []fakeexec.FakeCommandAction{
+			func(cmd string, args ...string) utilexec.Cmd {
+				if cmd != expectedCmd {
+					t.Errorf("Expected command %q, got %q", expectedCmd, cmd)
+				}
+				if !reflect.DeepEqual(expectedArgs, args) {
+					t.Errorf("Expected args %v, got %v", expectedArgs, args)
+				}
+				return fakeexec.InitFakeCmd(fakeCmd, cmd, args...)
+			},
+		},
+	}
+}
+
+func TestEnabled(t *testing.T) {
+	if Enabled() {
+		t.Errorf("Expected the backend to be disabled by default")
+	}
+	featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultFeatureGate, features.KubeletSystemdMountUnits, true)
+	if !Enabled() {
+		t.Errorf("Expected the backend to be enabled")
+	}
+}
+
+func TestSystemdMounterMount(t *testing.T) {
+	expectedArgs := []string{"--collect", "-t", "ext4", "-o", "ro,noexec", "/dev/sda1", "/mnt/target"}
+
+	// Successful systemd-mount does not touch the delegate.
+	delegate := mount.NewFakeMounter(nil)
+	mounter := New(delegate, fakeExecutor(t, systemdMountCmd, expectedArgs, nil))
+	if err := mounter.Mount("/dev/sda1", "/mnt/target", "ext4", []string{"ro", "noexec"}); err != nil {
+		t.Fatalf("Expected success: %v", err)
+	}
+	if len(delegate.GetLog()) != 0 {
+		t.Errorf("Expected no delegate calls, got %v", delegate.GetLog())
+	}
+
+	// Failing systemd-mount falls back to the delegate mounter.
+	delegate = mount.NewFakeMounter(nil)
+	mounter = New(delegate, fakeExecutor(t, systemdMountCmd, expectedArgs, fmt.Errorf("systemd not running")))
+	if err := mounter.Mount("/dev/sda1", "/mnt/target", "ext4", []string{"ro", "noexec"}); err != nil {
+		t.Fatalf("Expected fallback success: %v", err)
+	}
+	log := delegate.GetLog()
+	if len(log) != 1 || log[0].Action != mount.FakeActionMount || log[0].Target != "/mnt/target" {
+		t.Errorf("Expected one fallback mount of /mnt/target, got %v", log)
+	}
+}
+
+func TestSystemdMounterUnmount(t *testing.T) {
+	// Successful systemd-umount does not touch the delegate.
+	delegate := mount.NewFakeMounter([]mount.MountPoint{{Device: "/dev/sda1", Path: "/mnt/target"}})
+	mounter := New(delegate, fakeExecutor(t, systemdUmountCmd, []string{"/mnt/target"}, nil))
+	if err := mounter.Unmount("/mnt/target"); err != nil {
+		t.Fatalf("Expected success: %v", err)
+	}
+	if len(delegate.GetLog()) != 0 {
+		t.Errorf("Expected no delegate calls, got %v", delegate.GetLog())
+	}
+
+	// Unknown targets fall back to the delegate mounter.
+	delegate = mount.NewFakeMounter([]mount.MountPoint{{Device: "/dev/sda1", Path: "/mnt/target"}})
+	mounter = New(delegate, fakeExecutor(t, systemdUmountCmd, []string{"/mnt/target"}, fmt.Errorf("not a mount unit")))
+	if err := mounter.Unmount("/mnt/target"); err != nil {
+		t.Fatalf("Expected fallback success: %v", err)
+	}
+	log := delegate.GetLog()
+	if len(log) != 1 || log[0].Action != mount.FakeActionUnmount || log[0].Target != "/mnt/target" {
+		t.Errorf("Expected one fallback unmount of /mnt/target, got %v", log)
+	}
+}
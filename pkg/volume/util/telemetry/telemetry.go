@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package telemetry ships volume lifecycle transitions to an external sink,
+// so that storage vendors can collect fleet-wide mount telemetry without
+// scraping kubelet logs. Events are recorded by the operation executor and
+// the reconciler at lifecycle transitions; a webhook sink is configured via
+// volumeTelemetryWebhookConfigFile in KubeletConfiguration, or installed
+// programmatically by agents compiled into the kubelet. Recording is best
+// effort and never blocks a volume operation.
+package telemetry
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Transitions of the volume lifecycle that are reported.
+const (
+	TransitionAttached      = "Attached"
+	TransitionStaged        = "Staged"
+	TransitionPublished     = "Published"
+	TransitionTornDown      = "TornDown"
+	TransitionUnstaged      = "Unstaged"
+	TransitionDetached      = "Detached"
+	TransitionReconstructed = "Reconstructed"
+)
+
+// Event is one volume lifecycle transition on this node.
+type Event struct {
+	// Transition is one of the Transition constants.
+	Transition string `json:"transition"`
+	// VolumeName is the unique volume name of the volume.
+	VolumeName string `json:"volumeName"`
+	// PluginName is the name of the volume plugin handling the volume, when
+	// known at the transition.
+	PluginName string `json:"pluginName,omitempty"`
+	// PodUID is the UID of the pod the transition happened for, when the
+	// transition is pod-scoped.
+	PodUID types.UID `json:"podUID,omitempty"`
+	// Error holds the failure message of a failed transition; empty for
+	// successful transitions.
+	Error string `json:"error,omitempty"`
+	// Timestamp is when the transition was recorded.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Sink receives volume lifecycle events. Implementations must not block;
+// shipping is expected to happen asynchronously.
+type Sink interface {
+	Record(event Event)
+}
+
+var (
+	sinkLock sync.RWMutex
+	sink     Sink
+)
+
+// SetSink installs the sink that receives volume lifecycle events, replacing
+// any previous one. A nil sink disables event recording.
+func SetSink(s Sink) {
+	sinkLock.Lock()
+	defer sinkLock.Unlock()
+	sink = s
+}
+
+// Record sends the event to the installed sink, if any. A zero timestamp is
+// filled in with the current time.
+func Record(event Event) {
+	sinkLock.RLock()
+	s := sink
+	sinkLock.RUnlock()
+	if s == nil {
+		return
+	}
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+	s.Record(event)
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package telemetry
+
+import (
+	"testing"
+	"time"
+)
+
+type recordingSink struct {
+	events []Event
+}
+
+func (rs *recordingSink) Record(event Event) {
+	rs.events = append(rs.events, event)
+}
+
+func TestRecord(t *testing.T) {
+	// Without a sink, recording is a no-op.
+	SetSink(nil)
+	Record(Event{Transition: TransitionAttached, VolumeName: "vol-1"})
+
+	rs := &recordingSink{}
+	SetSink(rs)
+	defer SetSink(nil)
+
+	Record(Event{Transition: TransitionPublished, VolumeName: "vol-1", PodUID: "pod-uid"})
+	if len(rs.events) != 1 {
+		t.Fatalf("expected 1 recorded event, got %d", len(rs.events))
+	}
+	event := rs.events[0]
+	if event.Transition != TransitionPublished || event.VolumeName != "vol-1" || event.PodUID != "pod-uid" {
+		t.Errorf("unexpected event: %+v", event)
+	}
+	if event.Timestamp.IsZero() {
+		t.Errorf("expected a zero timestamp to be filled in")
+	}
+
+	// An explicit timestamp is preserved.
+	ts := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	Record(Event{Transition: TransitionTornDown, VolumeName: "vol-1", Timestamp: ts})
+	if got := rs.events[1].Timestamp; !got.Equal(ts) {
+		t.Errorf("expected timestamp %v to be preserved, got %v", ts, got)
+	}
+}
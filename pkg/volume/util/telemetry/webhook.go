@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package telemetry
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// webhookQueueSize bounds the number of events buffered for shipping;
+	// further events are dropped so that a slow receiver cannot back up
+	// volume operations.
+	webhookQueueSize = 1000
+	// webhookTimeout bounds a single POST to the receiver.
+	webhookTimeout = 10 * time.Second
+)
+
+// webhookSink POSTs each event as a JSON object to the server named in a
+// kubeconfig file. Events are queued and shipped by a single background
+// goroutine; when the queue is full, events are dropped with a log.
+type webhookSink struct {
+	url    string
+	client *http.Client
+	queue  chan Event
+}
+
+// InstallWebhookSink builds a webhook sink from the kubeconfig file
+// configured as volumeTelemetryWebhookConfigFile in KubeletConfiguration and
+// installs it. The kubeconfig names the receiving server and carries the TLS
+// and authentication credentials the kubelet presents, in the same way the
+// API server's webhook configurations do.
+func InstallWebhookSink(configFile string, stopCh <-chan struct{}) error {
+	config, err := clientcmd.BuildConfigFromFlags("", configFile)
+	if err != nil {
+		return fmt.Errorf("loading volume telemetry webhook kubeconfig %q: %w", configFile, err)
+	}
+	config.Timeout = webhookTimeout
+	client, err := rest.HTTPClientFor(config)
+	if err != nil {
+		return fmt.Errorf("building volume telemetry webhook client from %q: %w", configFile, err)
+	}
+	ws := &webhookSink{
+		url:    strings.TrimSuffix(config.Host, "/"),
+		client: client,
+		queue:  make(chan Event, webhookQueueSize),
+	}
+	go ws.run(stopCh)
+	SetSink(ws)
+	klog.InfoS("Installed volume lifecycle telemetry webhook sink", "host", config.Host)
+	return nil
+}
+
+func (ws *webhookSink) Record(event Event) {
+	select {
+	case ws.queue <- event:
+	default:
+		klog.V(4).InfoS("Dropping volume lifecycle telemetry event, webhook queue is full", "transition", event.Transition, "volumeName", event.VolumeName)
+	}
+}
+
+func (ws *webhookSink) run(stopCh <-chan struct{}) {
+	for {
+		select {
+		case event := <-ws.queue:
+			ws.ship(event)
+		case <-stopCh:
+			return
+		}
+	}
+}
+
+func (ws *webhookSink) ship(event Event) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		klog.ErrorS(err, "Failed to encode volume lifecycle telemetry event")
+		return
+	}
+	resp, err := ws.client.Post(ws.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		klog.V(4).InfoS("Failed to ship volume lifecycle telemetry event", "err", err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		klog.V(4).InfoS("Volume lifecycle telemetry receiver returned an error", "status", resp.Status)
+	}
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package telemetry
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeWebhookKubeconfig(t *testing.T, server string) string {
+	t.Helper()
+	kubeconfig := fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: telemetry
+  cluster:
+    server: %s
+users:
+- name: telemetry
+  user: {}
+contexts:
+- name: telemetry
+  context:
+    cluster: telemetry
+    user: telemetry
+current-context: telemetry
+`, server)
+	configFile := filepath.Join(t.TempDir(), "telemetry.kubeconfig")
+	if err := os.WriteFile(configFile, []byte(kubeconfig), 0600); err != nil {
+		t.Fatalf("failed to write kubeconfig: %v", err)
+	}
+	return configFile
+}
+
+func TestWebhookSink(t *testing.T) {
+	received := make(chan Event, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event Event
+		if err := json.NewDecoder(r.Body).Decode(&event); err != nil {
+			t.Errorf("failed to decode event: %v", err)
+		}
+		received <- event
+	}))
+	defer server.Close()
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	defer SetSink(nil)
+	if err := InstallWebhookSink(writeWebhookKubeconfig(t, server.URL), stopCh); err != nil {
+		t.Fatalf("failed to install webhook sink: %v", err)
+	}
+
+	Record(Event{
+		Transition: TransitionPublished,
+		VolumeName: "vol1",
+		PluginName: "kubernetes.io/csi",
+	})
+
+	select {
+	case event := <-received:
+		if event.Transition != TransitionPublished || event.VolumeName != "vol1" {
+			t.Errorf("unexpected event received: %+v", event)
+		}
+		if event.Timestamp.IsZero() {
+			t.Error("expected the event timestamp to be filled in")
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for the webhook to receive the event")
+	}
+}
+
+func TestInstallWebhookSinkInvalidConfig(t *testing.T) {
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	if err := InstallWebhookSink(filepath.Join(t.TempDir(), "no-such-file"), stopCh); err == nil {
+		t.Error("expected an error for a missing kubeconfig file")
+	}
+}
@@ -191,12 +191,14 @@ func MountOptionFromSpec(spec *volume.Spec, options ...string) []string {
 		// Use beta annotation first
 		if mo, ok := pv.Annotations[v1.MountOptionAnnotation]; ok {
 			moList := strings.Split(mo, ",")
-			return JoinMountOptions(moList, options)
+			return AppendHardenedMountOptions(pv, JoinMountOptions(moList, options))
 		}
 
 		if len(pv.Spec.MountOptions) > 0 {
-			return JoinMountOptions(pv.Spec.MountOptions, options)
+			return AppendHardenedMountOptions(pv, JoinMountOptions(pv.Spec.MountOptions, options))
 		}
+
+		return AppendHardenedMountOptions(pv, options)
 	}
 
 	return options
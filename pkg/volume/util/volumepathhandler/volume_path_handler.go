@@ -45,6 +45,9 @@ type BlockVolumePathHandler interface {
 	IsSymlinkExist(mapPath string) (bool, error)
 	// IsDeviceBindMountExist returns true if specified bind mount exists
 	IsDeviceBindMountExist(mapPath string) (bool, error)
+	// IsDeviceSymlinkCurrent returns true if the symlink under specified map
+	// path resolves to a device with the same major:minor number as devicePath
+	IsDeviceSymlinkCurrent(mapPath string, linkName string, devicePath string) (bool, error)
 	// GetDeviceBindMountRefs searches bind mounts under global map path
 	GetDeviceBindMountRefs(devPath string, mapPath string) ([]string, error)
 	// FindGlobalMapPathUUIDFromPod finds {pod uuid} symbolic link under globalMapPath
@@ -216,6 +216,38 @@ func compareBindMountAndSymlinks(global, pod string) (bool, error) {
 	return false, nil
 }
 
+// IsDeviceSymlinkCurrent returns true if the symlink at mapPath/linkName
+// resolves to a device with the same major/minor number as devicePath.
+// A missing or dangling symlink returns false with no error, so that the
+// caller can recreate it.
+func (v VolumePathHandler) IsDeviceSymlinkCurrent(mapPath string, linkName string, devicePath string) (bool, error) {
+	linkPath := filepath.Join(mapPath, linkName)
+	islinkExist, err := v.IsSymlinkExist(linkPath)
+	if err != nil {
+		return false, err
+	}
+	if !islinkExist {
+		return false, nil
+	}
+
+	// A dangling symlink means the device node it pointed at is gone, e.g.
+	// after device renumbering; report it as stale rather than failing.
+	target, err := filepath.EvalSymlinks(linkPath)
+	if err != nil {
+		return false, nil
+	}
+
+	devNumExpected, err := getDeviceMajorMinor(devicePath)
+	if err != nil {
+		return false, fmt.Errorf("getDeviceMajorMinor failed for path %s: %v", devicePath, err)
+	}
+	devNumTarget, err := getDeviceMajorMinor(target)
+	if err != nil {
+		return false, fmt.Errorf("getDeviceMajorMinor failed for path %s: %v", target, err)
+	}
+	return devNumExpected == devNumTarget, nil
+}
+
 // getDeviceMajorMinor returns major/minor number for the path with below format:
 // major:minor (in hex)
 // ex)
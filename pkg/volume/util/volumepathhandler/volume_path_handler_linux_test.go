@@ -18,6 +18,8 @@ package volumepathhandler
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -63,3 +65,57 @@ func TestCleanBackingFilePath(t *testing.T) {
 		})
 	}
 }
+
+func TestIsDeviceSymlinkCurrent(t *testing.T) {
+	tmpDir, err := os.MkdirTemp("", "symlink-current-test")
+	if err != nil {
+		t.Fatalf("failed to create tmp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	handler := NewBlockVolumePathHandler()
+
+	// Missing symlink is stale, not an error.
+	current, err := handler.IsDeviceSymlinkCurrent(tmpDir, "missing", "/dev/null")
+	if err != nil {
+		t.Fatalf("unexpected error for missing symlink: %v", err)
+	}
+	if current {
+		t.Errorf("expected missing symlink to be reported as stale")
+	}
+
+	// Dangling symlink is stale, not an error.
+	if err := os.Symlink(filepath.Join(tmpDir, "gone"), filepath.Join(tmpDir, "dangling")); err != nil {
+		t.Fatalf("failed to create symlink: %v", err)
+	}
+	current, err = handler.IsDeviceSymlinkCurrent(tmpDir, "dangling", "/dev/null")
+	if err != nil {
+		t.Fatalf("unexpected error for dangling symlink: %v", err)
+	}
+	if current {
+		t.Errorf("expected dangling symlink to be reported as stale")
+	}
+
+	// Symlink pointing to the device with the expected major/minor number is
+	// current.
+	if err := os.Symlink("/dev/null", filepath.Join(tmpDir, "volume")); err != nil {
+		t.Fatalf("failed to create symlink: %v", err)
+	}
+	current, err = handler.IsDeviceSymlinkCurrent(tmpDir, "volume", "/dev/null")
+	if err != nil {
+		t.Fatalf("unexpected error for matching symlink: %v", err)
+	}
+	if !current {
+		t.Errorf("expected symlink to /dev/null to be current for device path /dev/null")
+	}
+
+	// Symlink pointing to a device with a different major/minor number is
+	// stale.
+	current, err = handler.IsDeviceSymlinkCurrent(tmpDir, "volume", "/dev/zero")
+	if err != nil {
+		t.Fatalf("unexpected error for mismatched symlink: %v", err)
+	}
+	if current {
+		t.Errorf("expected symlink to /dev/null to be stale for device path /dev/zero")
+	}
+}
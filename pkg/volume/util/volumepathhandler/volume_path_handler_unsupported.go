@@ -42,6 +42,12 @@ func (v VolumePathHandler) GetLoopDevice(path string) (string, error) {
 	return "", fmt.Errorf("GetLoopDevice not supported for this build.")
 }
 
+// IsDeviceSymlinkCurrent checks if the symlink under specified map path still
+// points to a device with the expected major/minor number.
+func (v VolumePathHandler) IsDeviceSymlinkCurrent(mapPath string, linkName string, devicePath string) (bool, error) {
+	return false, fmt.Errorf("IsDeviceSymlinkCurrent not supported for this build.")
+}
+
 // FindGlobalMapPathUUIDFromPod finds {pod uuid} bind mount under globalMapPath
 // corresponding to map path symlink, and then return global map path with pod uuid.
 func (v VolumePathHandler) FindGlobalMapPathUUIDFromPod(pluginDir, mapPath string, podUID types.UID) (string, error) {
@@ -17,6 +17,7 @@ limitations under the License.
 package volume
 
 import (
+	"context"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
@@ -63,9 +64,29 @@ type BlockVolume interface {
 type MetricsProvider interface {
 	// GetMetrics returns the Metrics for the Volume. Maybe expensive for
 	// some implementations.
+	//
+	// Deprecated: implementations that talk to external components should
+	// also implement MetricsProviderWithContext so callers can bound
+	// individual calls; GetMetrics remains for plugins that cannot accept
+	// a context.
 	GetMetrics() (*Metrics, error)
 }
 
+// MetricsProviderWithContext exposes the same information as MetricsProvider
+// but accepts a context, so callers can cancel or apply a timeout to
+// individual calls. The returned Metrics carry the volume condition
+// (Abnormal/Message) next to capacity and inode usage, replacing the
+// previous split between the stats and health paths. Implementations of
+// this interface are preferred by the kubelet stats collector over plain
+// GetMetrics.
+type MetricsProviderWithContext interface {
+	// GetMetricsWithContext returns the Metrics for the Volume, including
+	// the volume condition when the plugin can report one. Maybe expensive
+	// for some implementations. Implementations must honor cancellation of
+	// the supplied context.
+	GetMetricsWithContext(ctx context.Context) (*Metrics, error)
+}
+
 // Metrics represents the used and available bytes of the Volume.
 type Metrics struct {
 	// The time at which these stats were updated.
@@ -118,6 +139,12 @@ type Attributes struct {
 	ReadOnly       bool
 	Managed        bool
 	SELinuxRelabel bool
+	// SharedFilesystem declares that the volume is served by a shared
+	// network filesystem that may enforce server-side permission mapping,
+	// e.g. root squash on NFS. fsGroup ownership changes on such volumes
+	// are probed first and skipped safely when the server denies them,
+	// instead of failing on every file.
+	SharedFilesystem bool
 }
 
 // MounterArgs provides more easily extensible arguments to Mounter
@@ -132,6 +159,15 @@ type MounterArgs struct {
 	SELinuxLabel        string
 }
 
+// OwnershipProgressReporter is an optional interface a Mounter can implement
+// to receive periodic progress reports while a recursive ownership change of
+// its volume is running, e.g. to surface them as pod events.
+type OwnershipProgressReporter interface {
+	// ReportOwnershipProgress is called with the number of completed and
+	// total top level entries of the volume.
+	ReportOwnershipProgress(completedEntries, totalEntries int)
+}
+
 // Mounter interface provides methods to set up/mount the volume.
 type Mounter interface {
 	// Uses Interface to provide the path for Docker binds.
@@ -20,13 +20,17 @@ limitations under the License.
 package volume
 
 import (
+	"errors"
 	"path/filepath"
+	"strings"
+	"sync/atomic"
 	"syscall"
 
 	"os"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 	"k8s.io/kubernetes/pkg/volume/util/types"
 )
@@ -35,6 +39,10 @@ const (
 	rwMask   = os.FileMode(0660)
 	roMask   = os.FileMode(0440)
 	execMask = os.FileMode(0110)
+
+	// ownershipProgressInterval is how often progress of a long running
+	// ownership change is reported.
+	ownershipProgressInterval = 30 * time.Second
 )
 
 // SetVolumeOwnership modifies the given volume to be owned by
@@ -55,12 +63,16 @@ func SetVolumeOwnership(mounter Mounter, dir string, fsGroup *int64, fsGroupChan
 		return nil
 	}
 
-	err := walkDeep(dir, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
-		return changeFilePermission(path, fsGroup, mounter.GetAttributes().ReadOnly, info)
-	})
+	// Shared filesystems may enforce server-side permission mapping, e.g.
+	// root squash on NFS, where every chown fails with EPERM. Probe the
+	// volume root once and skip the recursive change with a single summary
+	// line instead of failing on every file.
+	if mounter.GetAttributes().SharedFilesystem && !ownershipChangePermitted(dir, fsGroup) {
+		klog.InfoS("Skipping fsGroup change for shared filesystem volume because the server denies ownership changes (root squash?)", "path", dir, "fsGroup", *fsGroup)
+		return nil
+	}
+
+	err := changeOwnershipProgressively(mounter, dir, fsGroup)
 	if completeFunc != nil {
 		completeFunc(types.CompleteFuncParam{
 			Err: &err,
@@ -69,6 +81,124 @@ func SetVolumeOwnership(mounter Mounter, dir string, fsGroup *int64, fsGroupChan
 	return err
 }
 
+// changeOwnershipProgressively applies fsGroup to the volume one top level
+// entry at a time, persisting every completed entry in a checkpoint next to
+// the volume. A change that is interrupted by a kubelet restart resumes from
+// the checkpoint instead of starting over. Progress is reported periodically
+// while the change is running.
+func changeOwnershipProgressively(mounter Mounter, dir string, fsGroup *int64) error {
+	readonly := mounter.GetAttributes().ReadOnly
+	names, err := readDirNames(dir)
+	if err != nil {
+		// The volume cannot be enumerated; fall back to the plain deep walk
+		// so the error surfaces the same way it always did.
+		return walkDeep(dir, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			return changeFilePermission(path, fsGroup, readonly, info)
+		})
+	}
+
+	checkpointPath := ownershipCheckpointPath(dir)
+	alreadyCompleted := loadOwnershipCheckpoint(checkpointPath)
+
+	var completedEntries atomic.Int64
+	stopProgress := make(chan struct{})
+	defer close(stopProgress)
+	go func() {
+		ticker := time.NewTicker(ownershipProgressInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stopProgress:
+				return
+			case <-ticker.C:
+				done := int(completedEntries.Load())
+				klog.InfoS("Volume ownership change in progress", "path", dir, "completedEntries", done, "totalEntries", len(names))
+				if reporter, ok := mounter.(OwnershipProgressReporter); ok {
+					reporter.ReportOwnershipProgress(done, len(names))
+				}
+			}
+		}
+	}()
+
+	for _, name := range names {
+		if alreadyCompleted.Has(name) {
+			completedEntries.Add(1)
+			continue
+		}
+		err := walkDeep(filepath.Join(dir, name), func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			return changeFilePermission(path, fsGroup, readonly, info)
+		})
+		if err != nil {
+			return err
+		}
+		recordOwnershipCheckpoint(checkpointPath, name)
+		completedEntries.Add(1)
+	}
+
+	// The root of the volume is changed last, exactly like walkDeep does.
+	info, err := os.Lstat(dir)
+	if err != nil {
+		return err
+	}
+	if err := changeFilePermission(dir, fsGroup, readonly, info); err != nil {
+		return err
+	}
+	// The change is complete; the next one must start from a clean checkpoint.
+	if err := os.Remove(checkpointPath); err != nil && !os.IsNotExist(err) {
+		klog.V(4).InfoS("Failed to remove ownership change checkpoint", "path", checkpointPath, "err", err)
+	}
+	return nil
+}
+
+// ownershipCheckpointPath returns the path of the progress marker for the
+// volume directory. The marker lives next to the volume, not inside it, so
+// that it is not subject to the ownership change itself.
+func ownershipCheckpointPath(dir string) string {
+	return filepath.Join(filepath.Dir(dir), "."+filepath.Base(dir)+ownershipCheckpointSuffix)
+}
+
+// loadOwnershipCheckpoint returns the top level entries a previous,
+// interrupted ownership change already completed. A missing or unreadable
+// checkpoint yields an empty set, which makes the change start over.
+func loadOwnershipCheckpoint(path string) sets.Set[string] {
+	completed := sets.New[string]()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return completed
+	}
+	for _, name := range strings.Split(string(data), "\n") {
+		if name != "" {
+			completed.Insert(name)
+		}
+	}
+	return completed
+}
+
+// recordOwnershipCheckpoint appends a completed top level entry to the
+// checkpoint. Failures only cost a resume point, so they are not fatal.
+func recordOwnershipCheckpoint(path string, name string) {
+	// Entries with a newline in their name cannot be recorded in the line
+	// oriented checkpoint; they are simply walked again on resume.
+	if strings.Contains(name, "\n") {
+		return
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		klog.V(4).InfoS("Failed to record ownership change checkpoint", "path", path, "err", err)
+		return
+	}
+	defer f.Close()
+	if _, err := f.WriteString(name + "\n"); err != nil {
+		klog.V(4).InfoS("Failed to record ownership change checkpoint", "path", path, "err", err)
+	}
+}
+
 func changeFilePermission(filename string, fsGroup *int64, readonly bool, info os.FileInfo) error {
 	err := os.Lchown(filename, -1, int(*fsGroup))
 	if err != nil {
@@ -104,6 +234,16 @@ func changeFilePermission(filename string, fsGroup *int64, readonly bool, info o
 	return nil
 }
 
+// ownershipChangePermitted probes whether the filesystem backing the volume
+// accepts ownership changes, by applying the fsGroup to the volume root.
+// Only a permission error makes the probe fail; any other error is left for
+// the recursive change to surface as usual. A successful probe is not undone:
+// the root gets the same group the recursive change would set anyway.
+func ownershipChangePermitted(dir string, fsGroup *int64) bool {
+	err := os.Chown(dir, -1, int(*fsGroup))
+	return !errors.Is(err, syscall.EPERM)
+}
+
 func skipPermissionChange(mounter Mounter, dir string, fsGroup *int64, fsGroupChangePolicy *v1.PodFSGroupChangePolicy) bool {
 	if fsGroupChangePolicy == nil || *fsGroupChangePolicy != v1.FSGroupChangeOnRootMismatch {
 		klog.V(4).InfoS("Perform recursive ownership change for directory", "path", dir)
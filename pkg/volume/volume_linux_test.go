@@ -469,3 +469,87 @@ func verifyFileOwner(path string, uid, gid int) bool {
 
 	return true
 }
+
+func TestSetVolumeOwnershipSharedFilesystem(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("running as non-root")
+	}
+	always := v1.FSGroupChangeAlways
+	fsGroup := int64(3000)
+
+	tmpDir, err := utiltesting.MkTmpdir("volume_linux_shared")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+	filename := filepath.Join(tmpDir, "file.txt")
+	file, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE, 0755)
+	if err != nil {
+		t.Fatalf("error creating file: %v", err)
+	}
+	file.Close()
+
+	// A shared filesystem that permits ownership changes gets the fsGroup
+	// applied like any other volume; the probe must not skip it.
+	mounter := &localFakeMounter{attributes: Attributes{SharedFilesystem: true}}
+	if err := SetVolumeOwnership(mounter, tmpDir, &fsGroup, &always, nil); err != nil {
+		t.Fatalf("SetVolumeOwnership failed: %v", err)
+	}
+	if !verifyFileOwner(filename, os.Geteuid(), int(fsGroup)) {
+		t.Errorf("expected fsGroup to be applied to %s", filename)
+	}
+}
+
+func TestSetVolumeOwnershipResumesFromCheckpoint(t *testing.T) {
+	always := v1.FSGroupChangeAlways
+	fsGroup := int64(os.Getgid())
+
+	tmpDir, err := utiltesting.MkTmpdir("volume_linux_checkpoint")
+	if err != nil {
+		t.Fatalf("error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	volumeDir := filepath.Join(tmpDir, "vol")
+	for _, subdir := range []string{"done", "pending"} {
+		if err := os.MkdirAll(filepath.Join(volumeDir, subdir), 0755); err != nil {
+			t.Fatalf("error creating subdir: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(volumeDir, subdir, "file.txt"), []byte("data"), 0600); err != nil {
+			t.Fatalf("error creating file: %v", err)
+		}
+	}
+
+	// A previous, interrupted ownership change already completed "done".
+	checkpointPath := ownershipCheckpointPath(volumeDir)
+	recordOwnershipCheckpoint(checkpointPath, "done")
+	if loaded := loadOwnershipCheckpoint(checkpointPath); !loaded.Has("done") || loaded.Len() != 1 {
+		t.Fatalf("expected checkpoint to contain exactly [done], got %v", loaded)
+	}
+
+	mounter := &localFakeMounter{path: "FAKE_DIR_DOESNT_EXIST"}
+	if err := SetVolumeOwnership(mounter, volumeDir, &fsGroup, &always, nil); err != nil {
+		t.Fatalf("error changing ownership: %v", err)
+	}
+
+	// The checkpointed entry must have been skipped, the other one changed.
+	doneInfo, err := os.Lstat(filepath.Join(volumeDir, "done", "file.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doneInfo.Mode().Perm() != 0600 {
+		t.Errorf("expected checkpointed entry to be skipped, got mode %v", doneInfo.Mode().Perm())
+	}
+	pendingInfo, err := os.Lstat(filepath.Join(volumeDir, "pending", "file.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pendingInfo.Mode().Perm()&rwMask != rwMask {
+		t.Errorf("expected pending entry to get group permissions, got mode %v", pendingInfo.Mode().Perm())
+	}
+
+	// A finished change removes its checkpoint.
+	if _, err := os.Stat(checkpointPath); !os.IsNotExist(err) {
+		t.Errorf("expected checkpoint to be removed after a finished change, got err: %v", err)
+	}
+}
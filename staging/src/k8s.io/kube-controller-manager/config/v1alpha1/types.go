@@ -62,6 +62,11 @@ type VolumeConfiguration struct {
 	// provisioning is not supported in any way, won't work in a multi-node cluster, and
 	// should not be used for anything other than testing or development.
 	EnableHostPathProvisioning *bool
+	// hostPathProvisioningRoot is the directory under which HostPath PVs are
+	// dynamically provisioned when enableHostPathProvisioning is set. Volumes
+	// are created as per-PV subdirectories of this root and removed again on
+	// reclaim. Defaults to /tmp/hostpath_pv.
+	HostPathProvisioningRoot string
 	// enableDynamicProvisioning enables the provisioning of volumes when running within an environment
 	// that supports dynamic provisioning. Defaults to true.
 	EnableDynamicProvisioning *bool
@@ -70,6 +75,10 @@ type VolumeConfiguration struct {
 	// volumePluginDir is the full path of the directory in which the flex
 	// volume plugin should search for additional third party volume plugins
 	FlexVolumePluginDir string
+	// retroactiveDefaultStorageClassExcludedNamespaces is a comma-separated
+	// list of namespaces whose claims are excluded from retroactive default
+	// storage class assignment.
+	RetroactiveDefaultStorageClassExcludedNamespaces string
 }
 
 // GroupResource describes an group resource.
@@ -145,6 +154,9 @@ type KubeControllerManagerConfiguration struct {
 	// PersistentVolumeBinderControllerConfiguration holds configuration for
 	// PersistentVolumeBinderController related features.
 	PersistentVolumeBinderController PersistentVolumeBinderControllerConfiguration
+	// PVCProtectionControllerConfiguration holds configuration for
+	// PVCProtectionController related features.
+	PVCProtectionController PVCProtectionControllerConfiguration
 	// PodGCControllerConfiguration holds configuration for PodGCController
 	// related features.
 	PodGCController PodGCControllerConfiguration
@@ -183,6 +195,15 @@ type AttachDetachControllerConfiguration struct {
 	// time is exceeded. Is false by default, and thus force detach on unmount is
 	// enabled.
 	DisableForceDetachOnTimeout bool `json:"disableForceDetachOnTimeout"`
+	// ReconcilerDryRun makes the reconciler log the attach and detach
+	// operations it would execute without starting them and without updating
+	// node status. Is false by default.
+	ReconcilerDryRun bool `json:"reconcilerDryRun"`
+	// DriverRateLimits is a comma-separated list of <driver>=<qps>/<burst>
+	// entries limiting how fast attach and detach operations are started per
+	// driver. The entry "*" applies to drivers without an explicit entry;
+	// drivers without a matching entry are not limited.
+	DriverRateLimits string `json:"driverRateLimits"`
 }
 
 // CSRSigningControllerConfiguration contains elements describing CSRSigningController.
@@ -425,6 +446,14 @@ type PersistentVolumeBinderControllerConfiguration struct {
 	VolumeConfiguration VolumeConfiguration
 }
 
+// PVCProtectionControllerConfiguration contains elements describing PVCProtectionController.
+type PVCProtectionControllerConfiguration struct {
+	// ConcurrentPVCProtectionSyncs is the number of PVC protection workers that
+	// process namespaces concurrently. Larger number = faster finalizer removal
+	// when many pods are deleted at once, but more CPU (and network) load.
+	ConcurrentPVCProtectionSyncs int32
+}
+
 // PodGCControllerConfiguration contains elements describing PodGCController.
 type PodGCControllerConfiguration struct {
 	// terminatedPodGCThreshold is the number of terminated pods that can exist
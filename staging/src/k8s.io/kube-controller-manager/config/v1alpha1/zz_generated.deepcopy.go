@@ -313,6 +313,7 @@ func (in *KubeControllerManagerConfiguration) DeepCopyInto(out *KubeControllerMa
 	out.NodeIPAMController = in.NodeIPAMController
 	out.NodeLifecycleController = in.NodeLifecycleController
 	in.PersistentVolumeBinderController.DeepCopyInto(&out.PersistentVolumeBinderController)
+	out.PVCProtectionController = in.PVCProtectionController
 	out.PodGCController = in.PodGCController
 	out.ReplicaSetController = in.ReplicaSetController
 	out.ReplicationController = in.ReplicationController
@@ -411,6 +412,22 @@ func (in *NodeLifecycleControllerConfiguration) DeepCopy() *NodeLifecycleControl
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PVCProtectionControllerConfiguration) DeepCopyInto(out *PVCProtectionControllerConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PVCProtectionControllerConfiguration.
+func (in *PVCProtectionControllerConfiguration) DeepCopy() *PVCProtectionControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PVCProtectionControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PersistentVolumeBinderControllerConfiguration) DeepCopyInto(out *PersistentVolumeBinderControllerConfiguration) {
 	*out = *in
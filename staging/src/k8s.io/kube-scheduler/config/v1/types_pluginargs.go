@@ -173,6 +173,15 @@ type VolumeBindingArgs struct {
 	// +optional
 	// +listType=atomic
 	Shape []UtilizationShapePoint `json:"shape,omitempty"`
+
+	// AttachedVolumesWeight is the weight of scoring nodes by the fraction of
+	// the pod's bound persistent volumes that are already attached to them,
+	// according to VolumeAttachment objects. Preferring such nodes reduces
+	// attach churn and startup time for rescheduled stateful pods.
+	// Value must be non-negative. If this value is nil, the default value (0)
+	// will be used, which disables the scoring.
+	// +optional
+	AttachedVolumesWeight *int64 `json:"attachedVolumesWeight,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
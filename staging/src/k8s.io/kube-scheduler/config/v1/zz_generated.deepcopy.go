@@ -587,6 +587,11 @@ func (in *VolumeBindingArgs) DeepCopyInto(out *VolumeBindingArgs) {
 		*out = make([]UtilizationShapePoint, len(*in))
 		copy(*out, *in)
 	}
+	if in.AttachedVolumesWeight != nil {
+		in, out := &in.AttachedVolumesWeight, &out.AttachedVolumesWeight
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -870,6 +870,34 @@ type KubeletConfiguration struct {
 	// Default: false
 	// +optional
 	FailCgroupV1 *bool `json:"failCgroupV1,omitempty"`
+
+	// maxCSIInlineVolumesPerPod is the maximum number of CSI ephemeral
+	// inline volumes a single pod may declare. Pods declaring more volumes
+	// are rejected at admission. Zero means no limit.
+	// Default: 0
+	// +optional
+	MaxCSIInlineVolumesPerPod int32 `json:"maxCSIInlineVolumesPerPod,omitempty"`
+	// maxCSIInlineVolumesPerNode is the maximum total number of CSI
+	// ephemeral inline volumes across all pods on the node. Zero means no
+	// limit.
+	// Default: 0
+	// +optional
+	MaxCSIInlineVolumesPerNode int32 `json:"maxCSIInlineVolumesPerNode,omitempty"`
+	// maxCSIInlineVolumeSizePerPod bounds the aggregate capacity a single
+	// pod may request through CSI ephemeral inline volumes, as a resource
+	// quantity (e.g. "10Gi"). Empty means no limit.
+	// Default: ""
+	// +optional
+	MaxCSIInlineVolumeSizePerPod string `json:"maxCSIInlineVolumeSizePerPod,omitempty"`
+	// volumeTelemetryWebhookConfigFile is the path to a kubeconfig file
+	// naming the webhook that receives volume lifecycle telemetry events
+	// (attach, staged, published, torn down, reconstructed) and the TLS and
+	// authentication credentials the kubelet presents to it. Events are
+	// POSTed to the server named in the kubeconfig as JSON, best effort.
+	// Empty disables the webhook sink.
+	// Default: ""
+	// +optional
+	VolumeTelemetryWebhookConfigFile string `json:"volumeTelemetryWebhookConfigFile,omitempty"`
 }
 
 type KubeletAuthorizationMode string